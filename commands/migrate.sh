@@ -115,7 +115,7 @@ echo "  User: $DB_USER"
 # Run migration (skip if optimize-only)
 if [ "$OPTIMIZE_ONLY" = false ]; then
     print_status "Running database migration..."
-    if go run main.go -migrate; then
+    if go run main.go -migrate up; then
         print_success "✅ Database migration completed successfully!"
     else
         print_error "❌ Database migration failed!"
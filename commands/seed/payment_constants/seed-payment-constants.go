@@ -46,6 +46,30 @@ func main() {
 			Description: stringPtr("Default hourly wage rate"),
 			IsActive:    true,
 		},
+		{
+			Name:        "SATURDAY_PENALTY",
+			Value:       150, // time and a half
+			Description: stringPtr("Saturday pay as a percentage of the hourly rate"),
+			IsActive:    true,
+		},
+		{
+			Name:        "SUNDAY_PENALTY",
+			Value:       200, // double time
+			Description: stringPtr("Sunday pay as a percentage of the hourly rate"),
+			IsActive:    true,
+		},
+		{
+			Name:        "OVERTIME_PENALTY",
+			Value:       150, // time and a half
+			Description: stringPtr("Overtime pay as a percentage of the hourly rate, for jobs without an overtime rate"),
+			IsActive:    true,
+		},
+		{
+			Name:        "ORDINARY_HOURS_PER_DAY",
+			Value:       8, // 8 hours
+			Description: stringPtr("Weekday hours paid at the hourly rate before overtime"),
+			IsActive:    true,
+		},
 	}
 
 	// Seed payment constants
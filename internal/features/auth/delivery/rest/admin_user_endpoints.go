@@ -0,0 +1,247 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/features/auth/user/payload"
+	auth_user_usecase "github.com/yakka-backend/internal/features/auth/user/usecase"
+	"github.com/yakka-backend/internal/infrastructure/http/middleware"
+	"github.com/yakka-backend/internal/shared/response"
+	"github.com/yakka-backend/internal/shared/validation"
+)
+
+// AdminUserHandler handles the admin user management endpoints
+type AdminUserHandler struct {
+	adminUserUsecase auth_user_usecase.AdminUserUsecase
+}
+
+// NewAdminUserHandler creates a new admin user handler
+func NewAdminUserHandler(adminUserUsecase auth_user_usecase.AdminUserUsecase) *AdminUserHandler {
+	return &AdminUserHandler{
+		adminUserUsecase: adminUserUsecase,
+	}
+}
+
+// SearchUsers handles GET /admin/users
+func (h *AdminUserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := payload.AdminSearchUsersRequest{
+		Query: query.Get("q"),
+		Page:  queryInt(r, "page", 1),
+		Limit: queryInt(r, "limit", 20),
+	}
+	if status := query.Get("status"); status != "" {
+		req.Status = &status
+	}
+	if role := query.Get("role"); role != "" {
+		req.Role = &role
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	users, total, err := h.adminUserUsecase.SearchUsers(r.Context(), req)
+	if err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "Failed to search users")
+		return
+	}
+
+	resp := payload.AdminUserListResponse{
+		Users:      make([]payload.UserResponse, 0, len(users)),
+		Total:      total,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalPages: int((total + int64(req.Limit) - 1) / int64(req.Limit)),
+	}
+	for _, user := range users {
+		resp.Users = append(resp.Users, toUserResponse(user))
+	}
+
+	response.WriteJSON(w, http.StatusOK, resp)
+}
+
+// GetUser handles GET /admin/users/{id}
+func (h *AdminUserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	user, err := h.adminUserUsecase.GetUser(r.Context(), userID)
+	if err != nil {
+		response.WriteError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, toUserResponse(user))
+}
+
+// UpdateUserStatus handles PATCH /admin/users/{id}/status
+func (h *AdminUserHandler) UpdateUserStatus(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := adminAndTargetIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req payload.AdminUpdateUserStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, err := h.adminUserUsecase.UpdateUserStatus(r.Context(), adminID, userID, models.UserStatus(req.Status), req.Reason)
+	if err != nil {
+		writeAdminError(w, err, "Failed to update user status")
+		return
+	}
+
+	middleware.InvalidatePrincipal(userID)
+
+	userResp := toUserResponse(user)
+	response.WriteJSON(w, http.StatusOK, payload.AdminActionResponse{
+		Message: "User status updated",
+		User:    &userResp,
+	})
+}
+
+// UpdateUserRole handles PATCH /admin/users/{id}/role
+func (h *AdminUserHandler) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := adminAndTargetIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req payload.AdminUpdateUserRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, err := h.adminUserUsecase.UpdateUserRole(r.Context(), adminID, userID, models.UserRole(req.Role))
+	if err != nil {
+		writeAdminError(w, err, "Failed to update user role")
+		return
+	}
+
+	middleware.InvalidatePrincipal(userID)
+
+	userResp := toUserResponse(user)
+	response.WriteJSON(w, http.StatusOK, payload.AdminActionResponse{
+		Message: "User role updated",
+		User:    &userResp,
+	})
+}
+
+// ForcePasswordReset handles POST /admin/users/{id}/password-reset
+func (h *AdminUserHandler) ForcePasswordReset(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := adminAndTargetIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.adminUserUsecase.ForcePasswordReset(r.Context(), adminID, userID); err != nil {
+		writeAdminError(w, err, "Failed to force password reset")
+		return
+	}
+
+	middleware.InvalidatePrincipal(userID)
+
+	response.WriteJSON(w, http.StatusOK, payload.AdminActionResponse{
+		Message: "Password reset email sent and sessions revoked",
+	})
+}
+
+// RevokeUserSessions handles DELETE /admin/users/{id}/sessions
+func (h *AdminUserHandler) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := adminAndTargetIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.adminUserUsecase.RevokeUserSessions(r.Context(), adminID, userID); err != nil {
+		writeAdminError(w, err, "Failed to revoke sessions")
+		return
+	}
+
+	middleware.InvalidatePrincipal(userID)
+
+	response.WriteJSON(w, http.StatusOK, payload.AdminActionResponse{
+		Message: "All sessions revoked",
+	})
+}
+
+// adminAndTargetIDs reads the acting admin from the context and the target user from the path
+func adminAndTargetIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return principal.UserID, userID, true
+}
+
+// writeAdminError maps admin usecase errors to HTTP responses
+func writeAdminError(w http.ResponseWriter, err error, fallback string) {
+	switch err.Error() {
+	case "Resource not found":
+		response.WriteError(w, http.StatusNotFound, "User not found")
+	case "Forbidden":
+		response.WriteError(w, http.StatusForbidden, "Admins cannot change their own account")
+	default:
+		response.WriteError(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// toUserResponse converts a user model to its response
+func toUserResponse(user *models.User) payload.UserResponse {
+	return payload.UserResponse{
+		ID:              user.ID.String(),
+		Email:           user.Email,
+		Phone:           user.Phone,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Address:         user.Address,
+		Photo:           user.Photo,
+		Status:          string(user.Status),
+		Role:            string(user.Role),
+		LastLoginAt:     user.LastLoginAt,
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
+		RoleChangedAt:   user.RoleChangedAt,
+	}
+}
+
+// queryInt reads an integer query parameter with a default value
+func queryInt(r *http.Request, key string, defaultValue int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
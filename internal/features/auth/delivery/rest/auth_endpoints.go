@@ -2,15 +2,24 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/yakka-backend/internal/features/auth/email_verification/usecase"
+	throttle_usecase "github.com/yakka-backend/internal/features/auth/login_throttle/usecase"
+	mfa_payload "github.com/yakka-backend/internal/features/auth/mfa/payload"
+	mfa_usecase "github.com/yakka-backend/internal/features/auth/mfa/usecase"
+	oidc_payload "github.com/yakka-backend/internal/features/auth/oidc/payload"
+	oidc_usecase "github.com/yakka-backend/internal/features/auth/oidc/usecase"
 	"github.com/yakka-backend/internal/features/auth/user/models"
 	"github.com/yakka-backend/internal/features/auth/user/payload"
 	auth_user_usecase "github.com/yakka-backend/internal/features/auth/user/usecase"
+	session_usecase "github.com/yakka-backend/internal/features/auth/user_session/usecase"
 	builder_usecase "github.com/yakka-backend/internal/features/builder_profiles/usecase"
 	labour_usecase "github.com/yakka-backend/internal/features/labour_profiles/usecase"
 	"github.com/yakka-backend/internal/infrastructure/http/middleware"
@@ -25,6 +34,10 @@ type AuthHandler struct {
 	emailVerificationUsecase usecase.EmailVerificationUsecase
 	builderProfileUsecase    builder_usecase.BuilderProfileUsecase
 	labourProfileUsecase     labour_usecase.LabourProfileUsecase
+	sessionUsecase           session_usecase.SessionUsecase
+	loginThrottleUsecase     throttle_usecase.LoginThrottleUsecase
+	mfaUsecase               mfa_usecase.MFAUsecase
+	oidcUsecase              oidc_usecase.OIDCUsecase
 }
 
 // NewAuthHandler creates a new auth handler
@@ -33,12 +46,20 @@ func NewAuthHandler(
 	emailVerificationUsecase usecase.EmailVerificationUsecase,
 	builderProfileUsecase builder_usecase.BuilderProfileUsecase,
 	labourProfileUsecase labour_usecase.LabourProfileUsecase,
+	sessionUsecase session_usecase.SessionUsecase,
+	loginThrottleUsecase throttle_usecase.LoginThrottleUsecase,
+	mfaUsecase mfa_usecase.MFAUsecase,
+	oidcUsecase oidc_usecase.OIDCUsecase,
 ) *AuthHandler {
 	return &AuthHandler{
 		authUsecase:              authUsecase,
 		emailVerificationUsecase: emailVerificationUsecase,
 		builderProfileUsecase:    builderProfileUsecase,
 		labourProfileUsecase:     labourProfileUsecase,
+		sessionUsecase:           sessionUsecase,
+		loginThrottleUsecase:     loginThrottleUsecase,
+		mfaUsecase:               mfaUsecase,
+		oidcUsecase:              oidcUsecase,
 	}
 }
 
@@ -84,15 +105,19 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Send email verification only if not auto-verified
+	// Send email verification, or the welcome email if already verified
 	var emailSent bool
 	if !isAutoVerified {
-		token, err := h.emailVerificationUsecase.RequestEmailVerification(r.Context(), user.ID)
-		if err != nil {
-			log.Printf("⚠️ Failed to send email verification to %s: %v", user.Email, err)
-			emailSent = false
+		if err := h.emailVerificationUsecase.RequestEmailVerification(r.Context(), user.ID); err != nil {
+			log.Printf("⚠️ Failed to send email verification to user %s: %v", user.ID, err)
+		} else {
+			log.Printf("📧 Email verification sent to user %s", user.ID)
+			emailSent = true
+		}
+	} else {
+		if err := h.emailVerificationUsecase.SendWelcomeEmail(r.Context(), user.ID); err != nil {
+			log.Printf("⚠️ Failed to send welcome email to user %s: %v", user.ID, err)
 		} else {
-			log.Printf("📧 Email verification sent to %s with token: %s", user.Email, token)
 			emailSent = true
 		}
 	}
@@ -145,9 +170,23 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	clientIP := middleware.ClientIP(r)
+
+	// Reject attempts while the account or IP is backing off or locked
+	if err := h.loginThrottleUsecase.Check(r.Context(), req.Email, clientIP, r.UserAgent()); err != nil {
+		writeThrottleError(w, err)
+		return
+	}
+
 	// Login user
 	user, err := h.authUsecase.Login(r.Context(), req.Email, req.Password)
 	if err != nil {
+		if err.Error() == "Unauthorized" {
+			if err := h.loginThrottleUsecase.RecordFailure(r.Context(), req.Email, clientIP, r.UserAgent()); err != nil {
+				log.Printf("⚠️ Failed to record login failure: %v", err)
+			}
+		}
+
 		// Handle specific error types
 		switch err.Error() {
 		case "Forbidden":
@@ -162,25 +201,146 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to response
-	userResp := payload.UserResponse{
-		ID:            user.ID.String(),
-		Email:         user.Email,
-		Phone:         user.Phone,
-		FirstName:     user.FirstName,
-		LastName:      user.LastName,
-		Address:       user.Address,
-		Photo:         user.Photo,
-		Status:        string(user.Status),
-		Role:          string(user.Role),
-		LastLoginAt:   user.LastLoginAt,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
-		RoleChangedAt: user.RoleChangedAt,
+	// Success is recorded by completeLogin, after any second factor has been checked
+	h.finishLogin(w, r, user)
+}
+
+// OIDCLogin handles login with an ID token from an OpenID Connect provider
+func (h *AuthHandler) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	var req oidc_payload.OIDCLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
 	}
 
-	// Generate JWT access token
-	accessToken, err := middleware.GenerateJWTToken(user.ID.String())
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, err := h.oidcUsecase.Login(r.Context(), mux.Vars(r)["provider"], req)
+	if err != nil {
+		switch err.Error() {
+		case "Resource not found":
+			response.WriteError(w, http.StatusNotFound, "Login provider not supported")
+		case "Unauthorized":
+			response.WriteError(w, http.StatusUnauthorized, "Invalid ID token")
+		case "Bad request":
+			response.WriteError(w, http.StatusBadRequest, "The provider did not share an email address")
+		case "Conflict":
+			response.WriteError(w, http.StatusConflict, "An account with this email already exists. Log in with your password to continue.")
+		case "Forbidden":
+			response.WriteError(w, http.StatusForbidden, "Email verification required. Please check your email and click the verification link before logging in.")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to log in")
+		}
+		return
+	}
+
+	h.finishLogin(w, r, user)
+}
+
+// finishLogin issues tokens, or an MFA challenge when the user has a second factor
+func (h *AuthHandler) finishLogin(w http.ResponseWriter, r *http.Request, user *models.User) {
+	// Builders with MFA get a challenge instead of tokens until the second factor is verified
+	mfaEnabled, err := h.mfaUsecase.IsEnabled(r.Context(), user.ID)
+	if err != nil {
+		log.Printf("❌ Failed to check MFA for user %s: %v", user.ID, err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to log in")
+		return
+	}
+	if mfaEnabled {
+		challengeToken, ttl, err := h.mfaUsecase.CreateChallenge(r.Context(), user.ID)
+		if err != nil {
+			response.WriteError(w, http.StatusInternalServerError, "Failed to create MFA challenge")
+			return
+		}
+
+		response.WriteJSON(w, http.StatusOK, mfa_payload.MFAChallengeResponse{
+			MFARequired:    true,
+			ChallengeToken: challengeToken,
+			ExpiresIn:      int64(ttl.Seconds()),
+			Methods:        []string{"totp", "recovery_code"},
+		})
+		return
+	}
+
+	h.completeLogin(w, r, user)
+}
+
+// VerifyMFA exchanges an MFA challenge and a TOTP or recovery code for tokens
+func (h *AuthHandler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req mfa_payload.MFAVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	challengeUserID, err := h.mfaUsecase.GetChallengeUser(r.Context(), req.ChallengeToken)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid code or expired challenge")
+		return
+	}
+
+	user, err := h.authUsecase.GetUserByID(r.Context(), challengeUserID)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "User not found")
+		return
+	}
+
+	// Wrong codes count toward the same account throttle as wrong passwords,
+	// so fresh challenges from repeated password logins cannot be used to keep guessing
+	clientIP := middleware.ClientIP(r)
+	if err := h.loginThrottleUsecase.Check(r.Context(), user.Email, clientIP, r.UserAgent()); err != nil {
+		writeThrottleError(w, err)
+		return
+	}
+
+	if _, err := h.mfaUsecase.VerifyChallenge(r.Context(), req.ChallengeToken, req.Code); err != nil {
+		switch err.Error() {
+		case "Unauthorized":
+			if err := h.loginThrottleUsecase.RecordFailure(r.Context(), user.Email, clientIP, r.UserAgent()); err != nil {
+				log.Printf("⚠️ Failed to record MFA failure: %v", err)
+			}
+			response.WriteError(w, http.StatusUnauthorized, "Invalid code or expired challenge")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to verify code")
+		}
+		return
+	}
+
+	if user.Status != models.UserStatusActive {
+		response.WriteError(w, http.StatusForbidden, "Account is "+string(user.Status))
+		return
+	}
+
+	h.completeLogin(w, r, user)
+}
+
+// completeLogin creates the session and writes the tokens of an authenticated user
+func (h *AuthHandler) completeLogin(w http.ResponseWriter, r *http.Request, user *models.User) {
+	// Only a fully authenticated login resets the account failure count
+	if err := h.loginThrottleUsecase.RecordSuccess(r.Context(), user.ID, user.Email, middleware.ClientIP(r), r.UserAgent()); err != nil {
+		log.Printf("⚠️ Failed to record login success: %v", err)
+	}
+
+	// Create session to back the refresh token
+	session, refreshToken, err := h.sessionUsecase.CreateSession(r.Context(), user.ID, r.UserAgent(), middleware.ClientIP(r))
+	if err != nil {
+		log.Printf("❌ Failed to create session: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to create session")
+		return
+	}
+
+	// Generate JWT access token bound to the session
+	accessToken, err := middleware.GenerateJWTToken(user.ID.String(), session.ID.String())
 	if err != nil {
 		log.Printf("❌ Failed to generate JWT token: %v", err)
 		response.WriteError(w, http.StatusInternalServerError, "Failed to generate token")
@@ -200,15 +360,11 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// TODO: Generate refresh token (for now using placeholder)
-	refreshToken := "refresh_token_here"
-	expiresIn := int64(3600) // 1 hour
-
 	resp := payload.LoginResponse{
-		User:         userResp,
+		User:         toUserResponse(user),
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
-		ExpiresIn:    expiresIn,
+		ExpiresIn:    int64(middleware.AccessTokenTTL.Seconds()),
 		Profiles:     profileInfo,
 	}
 
@@ -240,19 +396,20 @@ func (h *AuthHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 
 	// Convert user to response
 	userResp := payload.UserResponse{
-		ID:            user.ID.String(),
-		Email:         user.Email,
-		Phone:         user.Phone,
-		FirstName:     user.FirstName,
-		LastName:      user.LastName,
-		Address:       user.Address,
-		Photo:         user.Photo,
-		Status:        string(user.Status),
-		Role:          string(user.Role),
-		LastLoginAt:   user.LastLoginAt,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
-		RoleChangedAt: user.RoleChangedAt,
+		ID:              user.ID.String(),
+		Email:           user.Email,
+		Phone:           user.Phone,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Address:         user.Address,
+		Photo:           user.Photo,
+		Status:          string(user.Status),
+		Role:            string(user.Role),
+		LastLoginAt:     user.LastLoginAt,
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
+		RoleChangedAt:   user.RoleChangedAt,
 	}
 
 	// Initialize response
@@ -317,7 +474,7 @@ func (h *AuthHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 // UpdateProfile handles updating user profile
 func (h *AuthHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context
-	userIDStr := r.Context().Value("user_id").(string)
+	userIDStr := r.Context().Value(middleware.UserIDKey).(string)
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
@@ -345,7 +502,7 @@ func (h *AuthHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 
 	// Update fields
 	if req.Phone != nil {
-		user.Phone = req.Phone
+		user.SetPhone(req.Phone)
 	}
 	if req.FirstName != nil {
 		user.FirstName = req.FirstName
@@ -377,19 +534,20 @@ func (h *AuthHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 
 	// Convert to response
 	userResp := payload.UserResponse{
-		ID:            user.ID.String(),
-		Email:         user.Email,
-		Phone:         user.Phone,
-		FirstName:     user.FirstName,
-		LastName:      user.LastName,
-		Address:       user.Address,
-		Photo:         user.Photo,
-		Status:        string(user.Status),
-		Role:          string(user.Role),
-		LastLoginAt:   user.LastLoginAt,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
-		RoleChangedAt: user.RoleChangedAt,
+		ID:              user.ID.String(),
+		Email:           user.Email,
+		Phone:           user.Phone,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Address:         user.Address,
+		Photo:           user.Photo,
+		Status:          string(user.Status),
+		Role:            string(user.Role),
+		LastLoginAt:     user.LastLoginAt,
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
+		RoleChangedAt:   user.RoleChangedAt,
 	}
 
 	response.WriteJSON(w, http.StatusOK, userResp)
@@ -398,7 +556,7 @@ func (h *AuthHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 // ChangePassword handles password change
 func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context
-	userIDStr := r.Context().Value("user_id").(string)
+	userIDStr := r.Context().Value(middleware.UserIDKey).(string)
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
@@ -436,3 +594,52 @@ func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 
 	response.WriteJSON(w, http.StatusOK, map[string]string{"message": "Password changed successfully"})
 }
+
+// UnlockAccount handles account unlock from the lockout email link
+func (h *AuthHandler) UnlockAccount(w http.ResponseWriter, r *http.Request) {
+	var req payload.UnlockAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.loginThrottleUsecase.Unlock(r.Context(), req.Token, middleware.ClientIP(r)); err != nil {
+		switch err.Error() {
+		case "Unauthorized":
+			response.WriteError(w, http.StatusBadRequest, "Invalid or expired unlock token")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to unlock account")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]string{
+		"message": "Account unlocked. You can log in again.",
+	})
+}
+
+// writeThrottleError responds to a throttled login with Retry-After
+func writeThrottleError(w http.ResponseWriter, err error) {
+	var throttleErr *throttle_usecase.ThrottleError
+	if !errors.As(err, &throttleErr) {
+		response.WriteError(w, http.StatusInternalServerError, "Failed to check login attempts")
+		return
+	}
+
+	retryAfter := int(throttleErr.RetryAfter.Round(time.Second).Seconds())
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+
+	if throttleErr.Locked {
+		response.WriteError(w, http.StatusLocked, "Account temporarily locked after too many failed login attempts. Check your email to unlock it.")
+		return
+	}
+	response.WriteError(w, http.StatusTooManyRequests, "Too many login attempts. Please try again later.")
+}
@@ -2,7 +2,6 @@ package rest
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -45,28 +44,21 @@ func (h *EmailHandler) RequestEmailVerification(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Request email verification
-	token, err := h.emailVerificationUsecase.RequestEmailVerification(r.Context(), userID)
+	// Request email verification; the link is delivered by email only
+	err = h.emailVerificationUsecase.RequestEmailVerification(r.Context(), userID)
 	if err != nil {
 		// Handle specific error types
 		switch err.Error() {
-		case "Not found":
+		case "Resource not found":
 			response.WriteError(w, http.StatusNotFound, "User not found")
 		case "Conflict":
-			response.WriteError(w, http.StatusConflict, "Email verification already requested")
-		case "Bad request":
-			response.WriteError(w, http.StatusBadRequest, "Invalid user data")
+			response.WriteError(w, http.StatusConflict, "Email already verified")
 		default:
 			response.WriteError(w, http.StatusInternalServerError, "Failed to request email verification")
 		}
 		return
 	}
 
-	// Send email with verification token
-	// TODO: Implement actual email sending service
-	// For now, assume all emails are verified automatically
-	log.Printf("📧 Email verification sent to user %s with token: %s", req.UserID, token)
-
 	resp := payload.RequestEmailVerificationResponse{
 		Message: "Verification email sent",
 	}
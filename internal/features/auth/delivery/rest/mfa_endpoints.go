@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yakka-backend/internal/features/auth/mfa/payload"
+	mfa_usecase "github.com/yakka-backend/internal/features/auth/mfa/usecase"
+	"github.com/yakka-backend/internal/infrastructure/http/middleware"
+	"github.com/yakka-backend/internal/shared/response"
+	"github.com/yakka-backend/internal/shared/validation"
+)
+
+// MFAHandler handles two-factor authentication enrolment endpoints
+type MFAHandler struct {
+	mfaUsecase mfa_usecase.MFAUsecase
+}
+
+// NewMFAHandler creates a new MFA handler
+func NewMFAHandler(mfaUsecase mfa_usecase.MFAUsecase) *MFAHandler {
+	return &MFAHandler{
+		mfaUsecase: mfaUsecase,
+	}
+}
+
+// GetStatus handles GET /auth/mfa
+func (h *MFAHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	status, err := h.mfaUsecase.GetStatus(r.Context(), principal.UserID)
+	if err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "Failed to get MFA status")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, status)
+}
+
+// BeginSetup handles POST /auth/mfa/setup
+func (h *MFAHandler) BeginSetup(w http.ResponseWriter, r *http.Request) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	enrollment, err := h.mfaUsecase.BeginEnrollment(r.Context(), principal.UserID)
+	if err != nil {
+		switch err.Error() {
+		case "Forbidden":
+			response.WriteError(w, http.StatusForbidden, "Two-factor authentication is only available for builder accounts")
+		case "Conflict":
+			response.WriteError(w, http.StatusConflict, "Two-factor authentication is already enabled")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to start MFA setup")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, enrollment)
+}
+
+// ConfirmSetup handles POST /auth/mfa/setup/confirm
+func (h *MFAHandler) ConfirmSetup(w http.ResponseWriter, r *http.Request) {
+	principal, req, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+
+	codes, err := h.mfaUsecase.ConfirmEnrollment(r.Context(), principal.UserID, req.Code)
+	if err != nil {
+		writeMFAError(w, err, "Failed to enable MFA")
+		return
+	}
+
+	middleware.InvalidatePrincipal(principal.UserID)
+
+	response.WriteJSON(w, http.StatusOK, payload.MFARecoveryCodesResponse{
+		Message:       "Two-factor authentication enabled. Store these recovery codes somewhere safe, they are only shown once.",
+		RecoveryCodes: codes,
+	})
+}
+
+// Disable handles POST /auth/mfa/disable
+func (h *MFAHandler) Disable(w http.ResponseWriter, r *http.Request) {
+	principal, req, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.mfaUsecase.Disable(r.Context(), principal.UserID, req.Code); err != nil {
+		writeMFAError(w, err, "Failed to disable MFA")
+		return
+	}
+
+	middleware.InvalidatePrincipal(principal.UserID)
+
+	response.WriteJSON(w, http.StatusOK, payload.MFAMessageResponse{
+		Message: "Two-factor authentication disabled",
+	})
+}
+
+// RegenerateRecoveryCodes handles POST /auth/mfa/recovery-codes
+func (h *MFAHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	principal, req, ok := decodeMFACode(w, r)
+	if !ok {
+		return
+	}
+
+	codes, err := h.mfaUsecase.RegenerateRecoveryCodes(r.Context(), principal.UserID, req.Code)
+	if err != nil {
+		writeMFAError(w, err, "Failed to regenerate recovery codes")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, payload.MFARecoveryCodesResponse{
+		Message:       "New recovery codes generated. Previous codes no longer work.",
+		RecoveryCodes: codes,
+	})
+}
+
+// decodeMFACode reads the principal and the code request shared by the MFA endpoints
+func decodeMFACode(w http.ResponseWriter, r *http.Request) (*middleware.Principal, payload.MFACodeRequest, bool) {
+	var req payload.MFACodeRequest
+
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return nil, req, false
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return nil, req, false
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return nil, req, false
+	}
+
+	return principal, req, true
+}
+
+// writeMFAError maps MFA usecase errors to HTTP responses
+func writeMFAError(w http.ResponseWriter, err error, fallback string) {
+	switch err.Error() {
+	case "Unauthorized":
+		response.WriteError(w, http.StatusUnauthorized, "Invalid code")
+	case "Resource not found":
+		response.WriteError(w, http.StatusNotFound, "Two-factor authentication is not set up")
+	case "Conflict":
+		response.WriteError(w, http.StatusConflict, "Two-factor authentication is already enabled")
+	case "Forbidden":
+		response.WriteError(w, http.StatusForbidden, "Your company requires two-factor authentication")
+	default:
+		response.WriteError(w, http.StatusInternalServerError, fallback)
+	}
+}
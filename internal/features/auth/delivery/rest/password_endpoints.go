@@ -2,11 +2,11 @@ package rest
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/yakka-backend/internal/features/auth/password_reset/payload"
 	"github.com/yakka-backend/internal/features/auth/password_reset/usecase"
+	"github.com/yakka-backend/internal/infrastructure/http/middleware"
 	"github.com/yakka-backend/internal/shared/response"
 	"github.com/yakka-backend/internal/shared/validation"
 )
@@ -37,28 +37,15 @@ func (h *PasswordHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// Request password reset
-	token, err := h.passwordResetUsecase.RequestPasswordReset(r.Context(), req.Email)
+	// Request password reset; the link is delivered by email only
+	err := h.passwordResetUsecase.RequestPasswordReset(r.Context(), req.Email)
 	if err != nil {
-		// Handle specific error types
-		switch err.Error() {
-		case "Not found":
-			response.WriteError(w, http.StatusNotFound, "User with this email not found")
-		case "Bad request":
-			response.WriteError(w, http.StatusBadRequest, "Invalid email address")
-		default:
-			response.WriteError(w, http.StatusInternalServerError, "Failed to request password reset")
-		}
+		response.WriteError(w, http.StatusInternalServerError, "Failed to request password reset")
 		return
 	}
 
-	// Send email with reset token
-	// TODO: Implement actual email sending service
-	// For now, assume all emails are sent successfully
-	log.Printf("📧 Password reset email sent to %s with token: %s", req.Email, token)
-
 	resp := payload.RequestPasswordResetResponse{
-		Message: "Password reset email sent",
+		Message: "If an account exists for this email, a password reset link has been sent",
 	}
 
 	response.WriteJSON(w, http.StatusOK, resp)
@@ -79,7 +66,7 @@ func (h *PasswordHandler) ResetPassword(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Reset password
-	err := h.passwordResetUsecase.ResetPassword(r.Context(), req.Token, req.NewPassword)
+	userID, err := h.passwordResetUsecase.ResetPassword(r.Context(), req.Token, req.NewPassword)
 	if err != nil {
 		// Handle specific error types
 		switch err.Error() {
@@ -95,6 +82,9 @@ func (h *PasswordHandler) ResetPassword(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Its sessions were revoked; stop serving them from the cache
+	middleware.InvalidatePrincipal(userID)
+
 	resp := payload.PasswordResetResponse{
 		Message: "Password reset successfully",
 	}
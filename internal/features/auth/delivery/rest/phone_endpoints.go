@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yakka-backend/internal/features/auth/phone_verification/payload"
+	phone_usecase "github.com/yakka-backend/internal/features/auth/phone_verification/usecase"
+	"github.com/yakka-backend/internal/infrastructure/http/middleware"
+	"github.com/yakka-backend/internal/shared/response"
+	"github.com/yakka-backend/internal/shared/validation"
+)
+
+// PhoneHandler handles phone verification endpoints
+type PhoneHandler struct {
+	phoneUsecase phone_usecase.PhoneVerificationUsecase
+}
+
+// NewPhoneHandler creates a new phone handler
+func NewPhoneHandler(phoneUsecase phone_usecase.PhoneVerificationUsecase) *PhoneHandler {
+	return &PhoneHandler{
+		phoneUsecase: phoneUsecase,
+	}
+}
+
+// RequestVerification handles POST /auth/phone/verification
+func (h *PhoneHandler) RequestVerification(w http.ResponseWriter, r *http.Request) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req payload.RequestPhoneVerificationRequest
+	// The body is optional: without a phone the code goes to the number on the profile
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	phone, err := h.phoneUsecase.RequestPhoneVerification(r.Context(), principal.UserID, req.Phone)
+	if err != nil {
+		switch err.Error() {
+		case "Bad request":
+			response.WriteError(w, http.StatusBadRequest, "A phone number in international format (e.g. +61412345678) is required")
+		case "Conflict":
+			response.WriteError(w, http.StatusConflict, "Phone number is already verified")
+		case "Too many requests":
+			response.WriteError(w, http.StatusTooManyRequests, "Too many codes requested, please wait before trying again")
+		case "Resource not found":
+			response.WriteError(w, http.StatusNotFound, "User not found")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to send verification code")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, payload.RequestPhoneVerificationResponse{
+		Message:   "Verification code sent",
+		Phone:     phone,
+		ExpiresIn: int64(h.phoneUsecase.CodeTTL().Seconds()),
+	})
+}
+
+// Verify handles POST /auth/phone/verify
+func (h *PhoneHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req payload.VerifyPhoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, err := h.phoneUsecase.VerifyPhone(r.Context(), principal.UserID, req.Code)
+	if err != nil {
+		switch err.Error() {
+		case "Unauthorized":
+			response.WriteError(w, http.StatusUnauthorized, "Invalid code")
+		case "Resource not found":
+			response.WriteError(w, http.StatusNotFound, "No valid code found, please request a new one")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to verify phone number")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, payload.VerifyPhoneResponse{
+		Message:         "Phone number verified",
+		Phone:           *user.Phone,
+		PhoneVerifiedAt: *user.PhoneVerifiedAt,
+	})
+}
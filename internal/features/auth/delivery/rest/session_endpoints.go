@@ -2,9 +2,12 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/yakka-backend/internal/infrastructure/http/middleware"
 	"github.com/yakka-backend/internal/features/auth/user_session/payload"
 	"github.com/yakka-backend/internal/features/auth/user_session/usecase"
@@ -39,34 +42,35 @@ func (h *SessionHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Refresh session
-	_, newRefreshToken, err := h.sessionUsecase.RefreshSession(r.Context(), req.RefreshToken)
+	session, newRefreshToken, err := h.sessionUsecase.RefreshSession(r.Context(), req.RefreshToken)
 	if err != nil {
+		// A replayed refresh token revoked its session; stop serving it from the cache
+		var reuseErr *usecase.TokenReuseError
+		if errors.As(err, &reuseErr) {
+			middleware.InvalidatePrincipal(reuseErr.UserID)
+		}
+
 		// Handle specific error types
 		switch err.Error() {
 		case "Unauthorized":
 			response.WriteError(w, http.StatusUnauthorized, "Invalid or expired refresh token")
-		case "Not found":
-			response.WriteError(w, http.StatusNotFound, "Session not found")
 		default:
 			response.WriteError(w, http.StatusUnauthorized, "Invalid refresh token")
 		}
 		return
 	}
 
-	// Generate new JWT access token
-	// TODO: Extract user ID from refresh token validation
-	userID := "user_id_from_refresh_token" // This should come from refresh token validation
-	accessToken, err := middleware.GenerateJWTToken(userID)
+	// Generate new JWT access token for the session owner
+	accessToken, err := middleware.GenerateJWTToken(session.UserID.String(), session.ID.String())
 	if err != nil {
 		response.WriteError(w, http.StatusInternalServerError, "Failed to generate access token")
 		return
 	}
-	expiresIn := int64(3600) // 1 hour
 
 	resp := payload.RefreshTokenResponse{
 		AccessToken:  accessToken,
 		RefreshToken: newRefreshToken,
-		ExpiresIn:    expiresIn,
+		ExpiresIn:    int64(middleware.AccessTokenTTL.Seconds()),
 	}
 
 	response.WriteJSON(w, http.StatusOK, resp)
@@ -75,42 +79,142 @@ func (h *SessionHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 // Logout handles user logout
 func (h *SessionHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	var req payload.LogoutRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Get user ID from context (set by auth middleware)
-	userIDStr := r.Context().Value("user_id").(string)
+	userIDStr := r.Context().Value(middleware.UserIDKey).(string)
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
 		return
 	}
 
-	// If specific session ID provided, revoke that session
-	if req.SessionID != nil {
-		// TODO: Implement session-specific logout
-		response.WriteJSON(w, http.StatusOK, payload.LogoutResponse{
-			Message: "Session revoked successfully",
-		})
-		return
+	switch {
+	case req.RefreshToken != nil:
+		// Revoke the session that owns the refresh token
+		err = h.sessionUsecase.RevokeSessionByRefreshToken(r.Context(), userID, *req.RefreshToken)
+	case req.SessionID != nil:
+		// Revoke a specific session
+		sessionID, parseErr := uuid.Parse(*req.SessionID)
+		if parseErr != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid session ID")
+			return
+		}
+		err = h.sessionUsecase.RevokeUserSession(r.Context(), userID, sessionID)
+	default:
+		// Revoke the session the access token belongs to
+		currentSessionID, parseErr := uuid.Parse(r.Context().Value(middleware.SessionIDKey).(string))
+		if parseErr != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid session ID")
+			return
+		}
+		err = h.sessionUsecase.RevokeUserSession(r.Context(), userID, currentSessionID)
 	}
 
-	// Revoke all user sessions
-	err = h.sessionUsecase.RevokeAllUserSessions(r.Context(), userID)
 	if err != nil {
 		// Handle specific error types
 		switch err.Error() {
-		case "Not found":
-			response.WriteError(w, http.StatusNotFound, "User sessions not found")
+		case "Resource not found":
+			response.WriteError(w, http.StatusNotFound, "Session not found")
 		default:
 			response.WriteError(w, http.StatusInternalServerError, "Failed to logout")
 		}
 		return
 	}
 
+	middleware.InvalidatePrincipal(userID)
+
 	response.WriteJSON(w, http.StatusOK, payload.LogoutResponse{
 		Message: "Logged out successfully",
 	})
 }
+
+// ListSessions handles listing the caller's active sessions
+func (h *SessionHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	userIDStr := r.Context().Value(middleware.UserIDKey).(string)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+	currentSessionID, _ := r.Context().Value(middleware.SessionIDKey).(string)
+
+	sessions, err := h.sessionUsecase.ListUserSessions(r.Context(), userID)
+	if err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "Failed to retrieve sessions")
+		return
+	}
+
+	resp := payload.SessionListResponse{
+		Sessions: make([]payload.SessionResponse, 0, len(sessions)),
+		Total:    len(sessions),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, payload.SessionResponse{
+			ID:        session.ID.String(),
+			UserAgent: session.UserAgent,
+			IPAddress: session.IPAddress,
+			CreatedAt: session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+			Current:   session.ID.String() == currentSessionID,
+		})
+	}
+
+	response.WriteJSON(w, http.StatusOK, resp)
+}
+
+// RevokeSession handles revoking one of the caller's sessions
+func (h *SessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	userIDStr := r.Context().Value(middleware.UserIDKey).(string)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	sessionID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid session ID")
+		return
+	}
+
+	if err := h.sessionUsecase.RevokeUserSession(r.Context(), userID, sessionID); err != nil {
+		switch err.Error() {
+		case "Resource not found":
+			response.WriteError(w, http.StatusNotFound, "Session not found")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to revoke session")
+		}
+		return
+	}
+
+	middleware.InvalidatePrincipal(userID)
+
+	response.WriteJSON(w, http.StatusOK, payload.LogoutResponse{
+		Message: "Session revoked successfully",
+	})
+}
+
+// RevokeAllSessions handles logging the caller out of every device
+func (h *SessionHandler) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	userIDStr := r.Context().Value(middleware.UserIDKey).(string)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if err := h.sessionUsecase.RevokeAllUserSessions(r.Context(), userID); err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "Failed to revoke sessions")
+		return
+	}
+
+	middleware.InvalidatePrincipal(userID)
+
+	response.WriteJSON(w, http.StatusOK, payload.LogoutResponse{
+		Message: "Logged out from all devices",
+	})
+}
@@ -2,20 +2,27 @@ package usecase
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
+	"log"
+	"net/url"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/yakka-backend/internal/features/auth/email_verification/entity/database"
 	"github.com/yakka-backend/internal/features/auth/email_verification/models"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/mailer"
 	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
 )
 
+// verificationTokenTTL is how long an email verification link stays valid
+const verificationTokenTTL = 24 * time.Hour
+
 // EmailVerificationUsecase defines the interface for email verification operations
 type EmailVerificationUsecase interface {
-	RequestEmailVerification(ctx context.Context, userID uuid.UUID) (string, error)
+	RequestEmailVerification(ctx context.Context, userID uuid.UUID) error
 	VerifyEmail(ctx context.Context, token string) error
+	SendWelcomeEmail(ctx context.Context, userID uuid.UUID) error
 	ValidateVerificationToken(ctx context.Context, token string) (*models.EmailVerification, error)
 	CleanupExpiredVerifications(ctx context.Context) error
 }
@@ -24,53 +31,79 @@ type EmailVerificationUsecase interface {
 type emailVerificationUsecase struct {
 	emailVerificationRepo database.EmailVerificationRepository
 	userRepo              UserRepository
+	mailer                mailer.Mailer
+	appBaseURL            string
 }
 
-// UserRepository interface for updating user status
+// UserRepository interface for looking up users and updating their status
 type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*userModels.User, error)
 	UpdateUserStatus(ctx context.Context, userID uuid.UUID, status string) error
 }
 
 // NewEmailVerificationUsecase creates a new email verification usecase
-func NewEmailVerificationUsecase(emailVerificationRepo database.EmailVerificationRepository, userRepo UserRepository) EmailVerificationUsecase {
+func NewEmailVerificationUsecase(emailVerificationRepo database.EmailVerificationRepository, userRepo UserRepository, mail mailer.Mailer, appBaseURL string) EmailVerificationUsecase {
 	return &emailVerificationUsecase{
 		emailVerificationRepo: emailVerificationRepo,
 		userRepo:              userRepo,
+		mailer:                mail,
+		appBaseURL:            appBaseURL,
 	}
 }
 
-// RequestEmailVerification creates an email verification request
-func (u *emailVerificationUsecase) RequestEmailVerification(ctx context.Context, userID uuid.UUID) (string, error) {
-	// Generate verification token
-	verificationToken, err := generateVerificationToken()
+// RequestEmailVerification creates an email verification request and emails the link
+func (u *emailVerificationUsecase) RequestEmailVerification(ctx context.Context, userID uuid.UUID) error {
+	user, err := u.userRepo.GetByID(ctx, userID)
 	if err != nil {
-		return "", errors.ErrInternal
+		return errors.ErrNotFound
+	}
+	if user.Status != userModels.UserStatusPending {
+		return errors.ErrConflict
 	}
 
-	// Hash the token for storage
-	tokenHash := hashToken(verificationToken)
+	// Generate verification token
+	verificationToken, err := utils.GenerateToken()
+	if err != nil {
+		return errors.ErrInternal
+	}
 
 	// Create email verification request
 	verification := &models.EmailVerification{
 		ID:        uuid.New(),
 		UserID:    userID,
-		TokenHash: tokenHash,
-		ExpiresAt: time.Now().Add(24 * time.Hour), // 24 hours expiry
+		TokenHash: utils.HashToken(verificationToken),
+		ExpiresAt: time.Now().Add(verificationTokenTTL),
 		CreatedAt: time.Now(),
 	}
 
 	err = u.emailVerificationRepo.Create(ctx, verification)
 	if err != nil {
-		return "", errors.ErrInternal
+		return errors.ErrInternal
 	}
 
-	return verificationToken, nil
+	msg, err := mailer.Compose(mailer.TemplateVerifyEmail, user.Email, mailer.TemplateData{
+		Name:      utils.StringValue(user.FirstName),
+		ActionURL: u.appBaseURL + "/verify-email?token=" + url.QueryEscape(verificationToken),
+		ExpiresIn: "24 hours",
+		AppURL:    u.appBaseURL,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to compose verification email: %v", err)
+		return errors.ErrInternal
+	}
+
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		log.Printf("❌ Failed to send verification email to user %s: %v", user.ID, err)
+		return errors.ErrInternal
+	}
+
+	return nil
 }
 
 // VerifyEmail verifies a user's email using a verification token
 func (u *emailVerificationUsecase) VerifyEmail(ctx context.Context, token string) error {
 	// Hash the token to find the verification request
-	tokenHash := hashToken(token)
+	tokenHash := utils.HashToken(token)
 
 	// Get verification request by token hash
 	verification, err := u.emailVerificationRepo.GetByTokenHash(ctx, tokenHash)
@@ -95,12 +128,39 @@ func (u *emailVerificationUsecase) VerifyEmail(ctx context.Context, token string
 		return errors.ErrInternal
 	}
 
+	// The account is verified even if the welcome email can't be delivered
+	if err := u.SendWelcomeEmail(ctx, verification.UserID); err != nil {
+		log.Printf("⚠️ Failed to send welcome email to user %s: %v", verification.UserID, err)
+	}
+
+	return nil
+}
+
+// SendWelcomeEmail sends the welcome email to an active user
+func (u *emailVerificationUsecase) SendWelcomeEmail(ctx context.Context, userID uuid.UUID) error {
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.ErrNotFound
+	}
+
+	msg, err := mailer.Compose(mailer.TemplateWelcome, user.Email, mailer.TemplateData{
+		Name:   utils.StringValue(user.FirstName),
+		AppURL: u.appBaseURL,
+	})
+	if err != nil {
+		return errors.ErrInternal
+	}
+
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		return errors.ErrInternal
+	}
+
 	return nil
 }
 
 // ValidateVerificationToken validates an email verification token
 func (u *emailVerificationUsecase) ValidateVerificationToken(ctx context.Context, token string) (*models.EmailVerification, error) {
-	tokenHash := hashToken(token)
+	tokenHash := utils.HashToken(token)
 
 	verification, err := u.emailVerificationRepo.GetByTokenHash(ctx, tokenHash)
 	if err != nil {
@@ -114,20 +174,3 @@ func (u *emailVerificationUsecase) ValidateVerificationToken(ctx context.Context
 func (u *emailVerificationUsecase) CleanupExpiredVerifications(ctx context.Context) error {
 	return u.emailVerificationRepo.DeleteExpired(ctx)
 }
-
-// generateVerificationToken generates a secure random verification token
-func generateVerificationToken() (string, error) {
-	bytes := make([]byte, 32)
-	_, err := rand.Read(bytes)
-	if err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(bytes), nil
-}
-
-// hashToken hashes a token for secure storage
-func hashToken(token string) string {
-	// In a real implementation, you would use a proper hash function
-	// For now, we'll use a simple approach
-	return token // This should be replaced with proper hashing
-}
@@ -0,0 +1,23 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/login_throttle/models"
+)
+
+// LoginThrottleRepository defines the interface for login event and lockout data operations
+type LoginThrottleRepository interface {
+	CreateEvent(ctx context.Context, event *models.LoginEvent) error
+	CountEmailEventsSince(ctx context.Context, email string, eventType models.LoginEventType, since time.Time) (int64, error)
+	CountIPEventsSince(ctx context.Context, ip string, eventType models.LoginEventType, since time.Time) (int64, error)
+	GetLastEmailEvent(ctx context.Context, email string, eventTypes []models.LoginEventType) (*models.LoginEvent, error)
+	GetLastIPEvent(ctx context.Context, ip string, eventTypes []models.LoginEventType) (*models.LoginEvent, error)
+
+	CreateLockout(ctx context.Context, lockout *models.AccountLockout) error
+	GetActiveLockout(ctx context.Context, email string, now time.Time) (*models.AccountLockout, error)
+	GetLockoutByTokenHash(ctx context.Context, tokenHash string) (*models.AccountLockout, error)
+	MarkUnlocked(ctx context.Context, id uuid.UUID, unlockedAt time.Time) error
+}
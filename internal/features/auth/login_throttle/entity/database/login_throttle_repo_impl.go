@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/login_throttle/models"
+	"gorm.io/gorm"
+)
+
+// loginThrottleRepository implements LoginThrottleRepository
+type loginThrottleRepository struct {
+	db *gorm.DB
+}
+
+// NewLoginThrottleRepository creates a new login throttle repository
+func NewLoginThrottleRepository(db *gorm.DB) LoginThrottleRepository {
+	return &loginThrottleRepository{db: db}
+}
+
+// CreateEvent records a login event
+func (r *loginThrottleRepository) CreateEvent(ctx context.Context, event *models.LoginEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// CountEmailEventsSince counts events of a type for an email after the given time
+func (r *loginThrottleRepository) CountEmailEventsSince(ctx context.Context, email string, eventType models.LoginEventType, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.LoginEvent{}).
+		Where("email = ? AND type = ? AND created_at > ?", email, eventType, since).
+		Count(&count).Error
+	return count, err
+}
+
+// CountIPEventsSince counts events of a type from an IP after the given time
+func (r *loginThrottleRepository) CountIPEventsSince(ctx context.Context, ip string, eventType models.LoginEventType, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.LoginEvent{}).
+		Where("ip_address = ? AND type = ? AND created_at > ?", ip, eventType, since).
+		Count(&count).Error
+	return count, err
+}
+
+// GetLastEmailEvent retrieves the most recent event of the given types for an email
+func (r *loginThrottleRepository) GetLastEmailEvent(ctx context.Context, email string, eventTypes []models.LoginEventType) (*models.LoginEvent, error) {
+	var event models.LoginEvent
+	err := r.db.WithContext(ctx).
+		Where("email = ? AND type IN ?", email, eventTypes).
+		Order("created_at DESC").
+		First(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// GetLastIPEvent retrieves the most recent event of the given types from an IP
+func (r *loginThrottleRepository) GetLastIPEvent(ctx context.Context, ip string, eventTypes []models.LoginEventType) (*models.LoginEvent, error) {
+	var event models.LoginEvent
+	err := r.db.WithContext(ctx).
+		Where("ip_address = ? AND type IN ?", ip, eventTypes).
+		Order("created_at DESC").
+		First(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// CreateLockout creates an account lockout
+func (r *loginThrottleRepository) CreateLockout(ctx context.Context, lockout *models.AccountLockout) error {
+	return r.db.WithContext(ctx).Create(lockout).Error
+}
+
+// GetActiveLockout retrieves the lockout currently blocking an email, if any
+func (r *loginThrottleRepository) GetActiveLockout(ctx context.Context, email string, now time.Time) (*models.AccountLockout, error) {
+	var lockout models.AccountLockout
+	err := r.db.WithContext(ctx).
+		Where("email = ? AND unlocked_at IS NULL AND locked_until > ?", email, now).
+		Order("locked_until DESC").
+		First(&lockout).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// GetLockoutByTokenHash retrieves a lockout by its unlock token hash
+func (r *loginThrottleRepository) GetLockoutByTokenHash(ctx context.Context, tokenHash string) (*models.AccountLockout, error) {
+	var lockout models.AccountLockout
+	err := r.db.WithContext(ctx).Where("unlock_token_hash = ?", tokenHash).First(&lockout).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// MarkUnlocked lifts a lockout
+func (r *loginThrottleRepository) MarkUnlocked(ctx context.Context, id uuid.UUID, unlockedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.AccountLockout{}).Where("id = ?", id).Update("unlocked_at", unlockedAt).Error
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountLockout temporarily blocks logins to an account after repeated failures
+type AccountLockout struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID          uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index"`
+	Email           string     `json:"email" gorm:"size:255;not null;index"`
+	LockedUntil     time.Time  `json:"locked_until" gorm:"not null;type:timestamptz"`
+	UnlockTokenHash string     `json:"-" gorm:"not null;type:text;uniqueIndex"`
+	UnlockedAt      *time.Time `json:"unlocked_at" gorm:"type:timestamptz"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the AccountLockout model
+func (AccountLockout) TableName() string {
+	return "account_lockouts"
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginEventType represents what happened during a login attempt
+type LoginEventType string
+
+const (
+	LoginEventFailure   LoginEventType = "FAILURE"
+	LoginEventSuccess   LoginEventType = "SUCCESS"
+	LoginEventThrottled LoginEventType = "THROTTLED"
+	LoginEventLockout   LoginEventType = "LOCKOUT"
+	LoginEventUnlock    LoginEventType = "UNLOCK"
+)
+
+// LoginEvent is an audit record of a login attempt or lockout change
+type LoginEvent struct {
+	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Email     string         `json:"email" gorm:"size:255;not null;index:idx_login_events_email_created"`
+	UserID    *uuid.UUID     `json:"user_id" gorm:"type:uuid;index"`
+	IPAddress string         `json:"ip_address" gorm:"size:45;not null;index:idx_login_events_ip_created"`
+	UserAgent *string        `json:"user_agent" gorm:"size:255"`
+	Type      LoginEventType `json:"type" gorm:"type:varchar(20);not null"`
+	CreatedAt time.Time      `json:"created_at" gorm:"not null;type:timestamptz;index:idx_login_events_email_created;index:idx_login_events_ip_created"`
+}
+
+// TableName returns the table name for the LoginEvent model
+func (LoginEvent) TableName() string {
+	return "login_events"
+}
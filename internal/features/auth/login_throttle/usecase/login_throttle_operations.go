@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/login_throttle/entity/database"
+	"github.com/yakka-backend/internal/features/auth/login_throttle/models"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/config"
+	"github.com/yakka-backend/internal/infrastructure/mailer"
+	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
+)
+
+// Clock returns the current time; injected so limits can be exercised without waiting
+type Clock func() time.Time
+
+// ThrottleError is returned when a login attempt is not allowed yet
+type ThrottleError struct {
+	RetryAfter time.Duration
+	Locked     bool
+}
+
+// Error implements the error interface
+func (e *ThrottleError) Error() string {
+	if e.Locked {
+		return "Account locked"
+	}
+	return "Too many login attempts"
+}
+
+// LoginThrottleUsecase defines the interface for login brute-force protection
+type LoginThrottleUsecase interface {
+	Check(ctx context.Context, email, ipAddress, userAgent string) error
+	RecordFailure(ctx context.Context, email, ipAddress, userAgent string) error
+	RecordSuccess(ctx context.Context, userID uuid.UUID, email, ipAddress, userAgent string) error
+	Unlock(ctx context.Context, token, ipAddress string) error
+}
+
+// UserRepository interface for looking up the account behind an email
+type UserRepository interface {
+	GetByEmail(ctx context.Context, email string) (*userModels.User, error)
+}
+
+// loginThrottleUsecase implements LoginThrottleUsecase
+type loginThrottleUsecase struct {
+	repo       database.LoginThrottleRepository
+	userRepo   UserRepository
+	mailer     mailer.Mailer
+	appBaseURL string
+	limits     config.LoginThrottleConfig
+	now        Clock
+}
+
+// NewLoginThrottleUsecase creates a new login throttle usecase
+func NewLoginThrottleUsecase(repo database.LoginThrottleRepository, userRepo UserRepository, mail mailer.Mailer, appBaseURL string, limits config.LoginThrottleConfig, clock Clock) LoginThrottleUsecase {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &loginThrottleUsecase{
+		repo:       repo,
+		userRepo:   userRepo,
+		mailer:     mail,
+		appBaseURL: appBaseURL,
+		limits:     limits,
+		now:        clock,
+	}
+}
+
+// Check returns a ThrottleError when the account is locked or the account or IP
+// is still inside its backoff delay. Storage errors fail open so logins keep working.
+func (u *loginThrottleUsecase) Check(ctx context.Context, email, ipAddress, userAgent string) error {
+	email = normalizeEmail(email)
+	now := u.now()
+
+	// Locked accounts wait for the lockout to expire or for the unlock link
+	lockout, err := u.repo.GetActiveLockout(ctx, email, now)
+	if err == nil {
+		u.recordEvent(ctx, email, &lockout.UserID, ipAddress, userAgent, models.LoginEventThrottled)
+		return &ThrottleError{RetryAfter: lockout.LockedUntil.Sub(now), Locked: true}
+	}
+
+	wait := maxDuration(u.accountWait(ctx, email, now), u.ipWait(ctx, ipAddress, now))
+	if wait > 0 {
+		u.recordEvent(ctx, email, nil, ipAddress, userAgent, models.LoginEventThrottled)
+		return &ThrottleError{RetryAfter: wait}
+	}
+
+	return nil
+}
+
+// RecordFailure records a failed login and locks the account once it reaches the limit
+func (u *loginThrottleUsecase) RecordFailure(ctx context.Context, email, ipAddress, userAgent string) error {
+	email = normalizeEmail(email)
+	now := u.now()
+
+	var userID *uuid.UUID
+	user, err := u.userRepo.GetByEmail(ctx, email)
+	if err == nil {
+		userID = &user.ID
+	}
+
+	if err := u.repo.CreateEvent(ctx, u.newEvent(email, userID, ipAddress, userAgent, models.LoginEventFailure)); err != nil {
+		return errors.ErrInternal
+	}
+
+	// Unknown emails still get backoff, but there is no account to lock
+	if user == nil {
+		return nil
+	}
+
+	failures, err := u.repo.CountEmailEventsSince(ctx, email, models.LoginEventFailure, u.resetPoint(ctx, email, now))
+	if err != nil {
+		return errors.ErrInternal
+	}
+	if int(failures) < u.limits.LockoutAfter {
+		return nil
+	}
+
+	return u.lock(ctx, user, ipAddress, userAgent, now)
+}
+
+// RecordSuccess records a successful login, which resets the account failure count
+func (u *loginThrottleUsecase) RecordSuccess(ctx context.Context, userID uuid.UUID, email, ipAddress, userAgent string) error {
+	if err := u.repo.CreateEvent(ctx, u.newEvent(normalizeEmail(email), &userID, ipAddress, userAgent, models.LoginEventSuccess)); err != nil {
+		return errors.ErrInternal
+	}
+	return nil
+}
+
+// Unlock lifts a lockout using the token from the unlock email
+func (u *loginThrottleUsecase) Unlock(ctx context.Context, token, ipAddress string) error {
+	lockout, err := u.repo.GetLockoutByTokenHash(ctx, utils.HashToken(token))
+	if err != nil {
+		return errors.ErrUnauthorized
+	}
+	if lockout.UnlockedAt != nil {
+		return nil
+	}
+
+	now := u.now()
+	if err := u.repo.MarkUnlocked(ctx, lockout.ID, now); err != nil {
+		return errors.ErrInternal
+	}
+	u.recordEvent(ctx, lockout.Email, &lockout.UserID, ipAddress, "", models.LoginEventUnlock)
+
+	log.Printf("🔓 Account %s unlocked via email link", lockout.UserID)
+	return nil
+}
+
+// lock creates a lockout for the user and emails the unlock link
+func (u *loginThrottleUsecase) lock(ctx context.Context, user *userModels.User, ipAddress, userAgent string, now time.Time) error {
+	token, err := utils.GenerateToken()
+	if err != nil {
+		return errors.ErrInternal
+	}
+
+	lockout := &models.AccountLockout{
+		ID:              uuid.New(),
+		UserID:          user.ID,
+		Email:           normalizeEmail(user.Email),
+		LockedUntil:     now.Add(u.limits.LockoutDuration),
+		UnlockTokenHash: utils.HashToken(token),
+		CreatedAt:       now,
+	}
+	if err := u.repo.CreateLockout(ctx, lockout); err != nil {
+		return errors.ErrInternal
+	}
+	u.recordEvent(ctx, lockout.Email, &user.ID, ipAddress, userAgent, models.LoginEventLockout)
+
+	log.Printf("🔒 Account %s locked until %s after repeated login failures", user.ID, lockout.LockedUntil.Format(time.RFC3339))
+
+	msg, err := mailer.Compose(mailer.TemplateAccountLocked, user.Email, mailer.TemplateData{
+		Name:      utils.StringValue(user.FirstName),
+		ActionURL: u.appBaseURL + "/unlock-account?token=" + url.QueryEscape(token),
+		ExpiresIn: formatDuration(u.limits.LockoutDuration),
+		AppURL:    u.appBaseURL,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to compose unlock email: %v", err)
+		return nil
+	}
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		log.Printf("❌ Failed to send unlock email to user %s: %v", user.ID, err)
+	}
+
+	return nil
+}
+
+// accountWait returns how long the account must wait before the next attempt
+func (u *loginThrottleUsecase) accountWait(ctx context.Context, email string, now time.Time) time.Duration {
+	failures, err := u.repo.CountEmailEventsSince(ctx, email, models.LoginEventFailure, u.resetPoint(ctx, email, now))
+	if err != nil {
+		log.Printf("⚠️ Failed to count login failures: %v", err)
+		return 0
+	}
+
+	delay := backoffDelay(int(failures), u.limits.BackoffAfter, u.limits.BackoffBase, u.limits.BackoffMax)
+	if delay == 0 {
+		return 0
+	}
+
+	last, err := u.repo.GetLastEmailEvent(ctx, email, []models.LoginEventType{models.LoginEventFailure})
+	if err != nil {
+		return 0
+	}
+	return last.CreatedAt.Add(delay).Sub(now)
+}
+
+// ipWait returns how long the IP must wait before the next attempt
+func (u *loginThrottleUsecase) ipWait(ctx context.Context, ipAddress string, now time.Time) time.Duration {
+	failures, err := u.repo.CountIPEventsSince(ctx, ipAddress, models.LoginEventFailure, now.Add(-u.limits.FailureWindow))
+	if err != nil {
+		log.Printf("⚠️ Failed to count login failures: %v", err)
+		return 0
+	}
+
+	var delay time.Duration
+	if int(failures) >= u.limits.IPMaxFailures {
+		delay = u.limits.FailureWindow
+	} else {
+		delay = backoffDelay(int(failures), u.limits.IPBackoffAfter, u.limits.BackoffBase, u.limits.BackoffMax)
+	}
+	if delay == 0 {
+		return 0
+	}
+
+	last, err := u.repo.GetLastIPEvent(ctx, ipAddress, []models.LoginEventType{models.LoginEventFailure})
+	if err != nil {
+		return 0
+	}
+	return last.CreatedAt.Add(delay).Sub(now)
+}
+
+// resetPoint is the start of the failure window, moved forward by the last
+// success, lockout or unlock so those start a fresh count
+func (u *loginThrottleUsecase) resetPoint(ctx context.Context, email string, now time.Time) time.Time {
+	since := now.Add(-u.limits.FailureWindow)
+
+	last, err := u.repo.GetLastEmailEvent(ctx, email, []models.LoginEventType{
+		models.LoginEventSuccess,
+		models.LoginEventLockout,
+		models.LoginEventUnlock,
+	})
+	if err == nil && last.CreatedAt.After(since) {
+		since = last.CreatedAt
+	}
+	return since
+}
+
+// recordEvent stores an audit event, logging instead of failing the request
+func (u *loginThrottleUsecase) recordEvent(ctx context.Context, email string, userID *uuid.UUID, ipAddress, userAgent string, eventType models.LoginEventType) {
+	if err := u.repo.CreateEvent(ctx, u.newEvent(email, userID, ipAddress, userAgent, eventType)); err != nil {
+		log.Printf("⚠️ Failed to record login event %s: %v", eventType, err)
+	}
+}
+
+// newEvent builds a login event stamped with the injected clock
+func (u *loginThrottleUsecase) newEvent(email string, userID *uuid.UUID, ipAddress, userAgent string, eventType models.LoginEventType) *models.LoginEvent {
+	event := &models.LoginEvent{
+		ID:        uuid.New(),
+		Email:     email,
+		UserID:    userID,
+		IPAddress: utils.Truncate(ipAddress, 45),
+		Type:      eventType,
+		CreatedAt: u.now(),
+	}
+	if userAgent != "" {
+		ua := utils.Truncate(userAgent, 255)
+		event.UserAgent = &ua
+	}
+	return event
+}
+
+// backoffDelay doubles the base delay for every failure past the threshold, up to max
+func backoffDelay(failures, threshold int, base, max time.Duration) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+	shift := failures - threshold
+	if shift > 30 {
+		return max
+	}
+	delay := base << uint(shift)
+	if delay <= 0 || delay > max {
+		return max
+	}
+	return delay
+}
+
+// maxDuration returns the larger of two durations
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// formatDuration renders a lockout duration for emails, e.g. "30 minutes"
+func formatDuration(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d hour(s)", int(d.Hours()))
+	}
+	return fmt.Sprintf("%d minutes", int(d.Minutes()))
+}
+
+// normalizeEmail makes throttling case-insensitive
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,424 @@
+package usecase
+
+import (
+	"context"
+	stdErrors "errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/login_throttle/models"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/config"
+	"github.com/yakka-backend/internal/infrastructure/mailer"
+)
+
+var errNotFound = stdErrors.New("not found")
+
+// fakeClock is a Clock that only moves when the test advances it
+type fakeClock struct {
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// memoryThrottleRepo keeps login events and lockouts in memory
+type memoryThrottleRepo struct {
+	events   []*models.LoginEvent
+	lockouts []*models.AccountLockout
+}
+
+func (r *memoryThrottleRepo) CreateEvent(ctx context.Context, event *models.LoginEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memoryThrottleRepo) CountEmailEventsSince(ctx context.Context, email string, eventType models.LoginEventType, since time.Time) (int64, error) {
+	var count int64
+	for _, e := range r.events {
+		if e.Email == email && e.Type == eventType && e.CreatedAt.After(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryThrottleRepo) CountIPEventsSince(ctx context.Context, ip string, eventType models.LoginEventType, since time.Time) (int64, error) {
+	var count int64
+	for _, e := range r.events {
+		if e.IPAddress == ip && e.Type == eventType && e.CreatedAt.After(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryThrottleRepo) GetLastEmailEvent(ctx context.Context, email string, eventTypes []models.LoginEventType) (*models.LoginEvent, error) {
+	return r.last(func(e *models.LoginEvent) bool { return e.Email == email }, eventTypes)
+}
+
+func (r *memoryThrottleRepo) GetLastIPEvent(ctx context.Context, ip string, eventTypes []models.LoginEventType) (*models.LoginEvent, error) {
+	return r.last(func(e *models.LoginEvent) bool { return e.IPAddress == ip }, eventTypes)
+}
+
+func (r *memoryThrottleRepo) last(match func(*models.LoginEvent) bool, eventTypes []models.LoginEventType) (*models.LoginEvent, error) {
+	for i := len(r.events) - 1; i >= 0; i-- {
+		e := r.events[i]
+		if !match(e) {
+			continue
+		}
+		for _, t := range eventTypes {
+			if e.Type == t {
+				return e, nil
+			}
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *memoryThrottleRepo) CreateLockout(ctx context.Context, lockout *models.AccountLockout) error {
+	r.lockouts = append(r.lockouts, lockout)
+	return nil
+}
+
+func (r *memoryThrottleRepo) GetActiveLockout(ctx context.Context, email string, now time.Time) (*models.AccountLockout, error) {
+	for _, l := range r.lockouts {
+		if l.Email == email && l.UnlockedAt == nil && l.LockedUntil.After(now) {
+			return l, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *memoryThrottleRepo) GetLockoutByTokenHash(ctx context.Context, tokenHash string) (*models.AccountLockout, error) {
+	for _, l := range r.lockouts {
+		if l.UnlockTokenHash == tokenHash {
+			return l, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *memoryThrottleRepo) MarkUnlocked(ctx context.Context, id uuid.UUID, unlockedAt time.Time) error {
+	for _, l := range r.lockouts {
+		if l.ID == id {
+			l.UnlockedAt = &unlockedAt
+			return nil
+		}
+	}
+	return errNotFound
+}
+
+// memoryUserRepo looks up a fixed set of users
+type memoryUserRepo map[string]*userModels.User
+
+func (r memoryUserRepo) GetByEmail(ctx context.Context, email string) (*userModels.User, error) {
+	if user, ok := r[email]; ok {
+		return user, nil
+	}
+	return nil, errNotFound
+}
+
+// capturingMailer keeps every message it is asked to send
+type capturingMailer struct {
+	sent []mailer.Message
+}
+
+func (m *capturingMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+var unlockTokenPattern = regexp.MustCompile(`token=([^\s"&<]+)`)
+
+// unlockToken pulls the unlock token out of the last email sent
+func (m *capturingMailer) unlockToken(t *testing.T) string {
+	t.Helper()
+	if len(m.sent) == 0 {
+		t.Fatal("no unlock email was sent")
+	}
+	match := unlockTokenPattern.FindStringSubmatch(m.sent[len(m.sent)-1].Text)
+	if match == nil {
+		t.Fatal("unlock email has no token")
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("unescape token: %v", err)
+	}
+	return token
+}
+
+const (
+	testEmail = "worker@example.com"
+	testIP    = "203.0.113.7"
+	testUA    = "test-agent"
+)
+
+func testLimits() config.LoginThrottleConfig {
+	return config.LoginThrottleConfig{
+		FailureWindow:   15 * time.Minute,
+		BackoffAfter:    3,
+		BackoffBase:     time.Second,
+		BackoffMax:      time.Minute,
+		LockoutAfter:    5,
+		LockoutDuration: 30 * time.Minute,
+		IPBackoffAfter:  100,
+		IPMaxFailures:   1000,
+	}
+}
+
+type throttleFixture struct {
+	usecase LoginThrottleUsecase
+	repo    *memoryThrottleRepo
+	mailer  *capturingMailer
+	clock   *fakeClock
+	user    *userModels.User
+}
+
+func newThrottleFixture(limits config.LoginThrottleConfig) *throttleFixture {
+	clock := newFakeClock()
+	repo := &memoryThrottleRepo{}
+	mail := &capturingMailer{}
+	user := &userModels.User{ID: uuid.New(), Email: testEmail}
+	users := memoryUserRepo{testEmail: user}
+	return &throttleFixture{
+		usecase: NewLoginThrottleUsecase(repo, users, mail, "https://app.example.com", limits, clock.Now),
+		repo:    repo,
+		mailer:  mail,
+		clock:   clock,
+		user:    user,
+	}
+}
+
+// fail records n failed logins one second apart
+func (f *throttleFixture) fail(t *testing.T, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		if err := f.usecase.RecordFailure(context.Background(), testEmail, testIP, testUA); err != nil {
+			t.Fatalf("RecordFailure: %v", err)
+		}
+		f.clock.Advance(time.Second)
+	}
+}
+
+// throttleError returns the ThrottleError from Check, or nil when the login is allowed
+func (f *throttleFixture) throttleError(t *testing.T) *ThrottleError {
+	t.Helper()
+	err := f.usecase.Check(context.Background(), testEmail, testIP, testUA)
+	if err == nil {
+		return nil
+	}
+	var throttleErr *ThrottleError
+	if !stdErrors.As(err, &throttleErr) {
+		t.Fatalf("Check returned %v, want a ThrottleError", err)
+	}
+	return throttleErr
+}
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		want     time.Duration
+	}{
+		{"below threshold", 2, 0},
+		{"at threshold", 3, time.Second},
+		{"one past threshold", 4, 2 * time.Second},
+		{"three past threshold", 6, 8 * time.Second},
+		{"capped at max", 10, time.Minute},
+		{"large shift stays capped", 100, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := backoffDelay(tt.failures, 3, time.Second, time.Minute); got != tt.want {
+				t.Errorf("backoffDelay(%d) = %s, want %s", tt.failures, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckBackoffGrowth(t *testing.T) {
+	limits := testLimits()
+	limits.LockoutAfter = 100
+
+	tests := []struct {
+		name      string
+		failures  int
+		wantRetry time.Duration
+	}{
+		{"no failures", 0, 0},
+		{"below threshold", 2, 0},
+		{"first backoff", 3, time.Second},
+		{"doubles", 4, 2 * time.Second},
+		{"doubles again", 5, 4 * time.Second},
+		{"capped", 20, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newThrottleFixture(limits)
+			for i := 0; i < tt.failures; i++ {
+				if err := f.usecase.RecordFailure(context.Background(), testEmail, testIP, testUA); err != nil {
+					t.Fatalf("RecordFailure: %v", err)
+				}
+			}
+
+			throttleErr := f.throttleError(t)
+			if tt.wantRetry == 0 {
+				if throttleErr != nil {
+					t.Fatalf("Check throttled with retry %s, want allowed", throttleErr.RetryAfter)
+				}
+				return
+			}
+			if throttleErr == nil {
+				t.Fatal("Check allowed the login, want throttled")
+			}
+			if throttleErr.Locked {
+				t.Error("Check reported a lockout, want backoff only")
+			}
+			if throttleErr.RetryAfter != tt.wantRetry {
+				t.Errorf("RetryAfter = %s, want %s", throttleErr.RetryAfter, tt.wantRetry)
+			}
+
+			f.clock.Advance(tt.wantRetry)
+			if throttleErr := f.throttleError(t); throttleErr != nil {
+				t.Errorf("Check still throttled after waiting, retry %s", throttleErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestRecordFailureLockout(t *testing.T) {
+	tests := []struct {
+		name       string
+		failures   int
+		wantLocked bool
+	}{
+		{"one short of the limit", 4, false},
+		{"at the limit", 5, true},
+		{"past the limit", 6, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newThrottleFixture(testLimits())
+			f.fail(t, tt.failures)
+			// Wait out any backoff so only the lockout remains
+			f.clock.Advance(time.Minute)
+
+			throttleErr := f.throttleError(t)
+			locked := throttleErr != nil && throttleErr.Locked
+			if locked != tt.wantLocked {
+				t.Fatalf("locked = %v, want %v", locked, tt.wantLocked)
+			}
+			if !tt.wantLocked {
+				if len(f.mailer.sent) != 0 {
+					t.Errorf("sent %d emails, want none", len(f.mailer.sent))
+				}
+				return
+			}
+			if len(f.mailer.sent) == 0 || f.mailer.sent[0].To != testEmail {
+				t.Errorf("no unlock email sent to %s", testEmail)
+			}
+
+			// The lockout expires on its own
+			f.clock.Advance(testLimits().LockoutDuration)
+			if throttleErr := f.throttleError(t); throttleErr != nil {
+				t.Errorf("Check still throttled after the lockout expired, retry %s", throttleErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestUnknownEmailIsNeverLocked(t *testing.T) {
+	f := newThrottleFixture(testLimits())
+	for i := 0; i < 10; i++ {
+		if err := f.usecase.RecordFailure(context.Background(), "nobody@example.com", testIP, testUA); err != nil {
+			t.Fatalf("RecordFailure: %v", err)
+		}
+	}
+	if len(f.repo.lockouts) != 0 {
+		t.Errorf("created %d lockouts for an unknown email, want none", len(f.repo.lockouts))
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      func(f *throttleFixture, t *testing.T) string
+		wantErr    bool
+		wantLocked bool
+	}{
+		{"token from the email", func(f *throttleFixture, t *testing.T) string { return f.mailer.unlockToken(t) }, false, false},
+		{"wrong token", func(f *throttleFixture, t *testing.T) string { return "not-the-token" }, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newThrottleFixture(testLimits())
+			f.fail(t, testLimits().LockoutAfter)
+
+			err := f.usecase.Unlock(context.Background(), tt.token(f, t), testIP)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unlock error = %v, want error %v", err, tt.wantErr)
+			}
+
+			throttleErr := f.throttleError(t)
+			locked := throttleErr != nil && throttleErr.Locked
+			if locked != tt.wantLocked {
+				t.Fatalf("locked = %v, want %v", locked, tt.wantLocked)
+			}
+			if tt.wantLocked {
+				return
+			}
+
+			// Unlocking starts a fresh count, so one more failure does not lock again
+			if throttleErr != nil {
+				t.Errorf("Check still throttled after unlock, retry %s", throttleErr.RetryAfter)
+			}
+			f.fail(t, 1)
+			f.clock.Advance(time.Minute)
+			if throttleErr := f.throttleError(t); throttleErr != nil {
+				t.Errorf("Check throttled after one failure past unlock, retry %s", throttleErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestRecordSuccessResetsFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after int
+		wantLocked    bool
+	}{
+		{"success clears the count", 4, 4, false},
+		{"count restarts after success", 4, 5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newThrottleFixture(testLimits())
+			f.fail(t, tt.before)
+			f.clock.Advance(time.Minute)
+
+			if err := f.usecase.RecordSuccess(context.Background(), f.user.ID, testEmail, testIP, testUA); err != nil {
+				t.Fatalf("RecordSuccess: %v", err)
+			}
+			f.clock.Advance(time.Second)
+			if throttleErr := f.throttleError(t); throttleErr != nil {
+				t.Fatalf("Check throttled right after a success, retry %s", throttleErr.RetryAfter)
+			}
+
+			f.fail(t, tt.after)
+			f.clock.Advance(time.Minute)
+			throttleErr := f.throttleError(t)
+			locked := throttleErr != nil && throttleErr.Locked
+			if locked != tt.wantLocked {
+				t.Errorf("locked = %v, want %v", locked, tt.wantLocked)
+			}
+		})
+	}
+}
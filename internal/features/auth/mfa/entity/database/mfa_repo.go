@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/mfa/models"
+	"gorm.io/gorm"
+)
+
+// MFARepository defines the interface for two-factor authentication data operations
+type MFARepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*models.UserMFA, error)
+	Save(ctx context.Context, mfa *models.UserMFA) error
+	Enable(ctx context.Context, mfa *models.UserMFA, codes []*models.RecoveryCode) error
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+	AdvanceStep(ctx context.Context, id uuid.UUID, step int64) (bool, error)
+
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codes []*models.RecoveryCode) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string, usedAt time.Time) (bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	CreateChallenge(ctx context.Context, challenge *models.MFAChallenge) error
+	GetChallengeByTokenHash(ctx context.Context, tokenHash string, now time.Time) (*models.MFAChallenge, error)
+	IncrementChallengeAttempts(ctx context.Context, id uuid.UUID) error
+	ConsumeChallenge(ctx context.Context, id uuid.UUID, consumedAt time.Time) (bool, error)
+}
+
+// mfaRepository implements MFARepository
+type mfaRepository struct {
+	db *gorm.DB
+}
+
+// NewMFARepository creates a new MFA repository
+func NewMFARepository(db *gorm.DB) MFARepository {
+	return &mfaRepository{db: db}
+}
+
+// GetByUserID retrieves the MFA enrolment of a user
+func (r *mfaRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.UserMFA, error) {
+	var mfa models.UserMFA
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&mfa).Error
+	if err != nil {
+		return nil, err
+	}
+	return &mfa, nil
+}
+
+// Save creates or updates an MFA enrolment
+func (r *mfaRepository) Save(ctx context.Context, mfa *models.UserMFA) error {
+	return r.db.WithContext(ctx).Save(mfa).Error
+}
+
+// Enable confirms an enrolment and stores its first recovery codes in one transaction
+func (r *mfaRepository) Enable(ctx context.Context, mfa *models.UserMFA, codes []*models.RecoveryCode) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(mfa).Error; err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(tx, mfa.UserID, codes)
+	})
+}
+
+// DeleteByUserID removes the enrolment and recovery codes of a user
+func (r *mfaRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&models.UserMFA{}).Error
+	})
+}
+
+// AdvanceStep records the TOTP time step just used. It returns false when the
+// step, or a later one, was already used so the same code cannot be replayed.
+func (r *mfaRepository) AdvanceStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.UserMFA{}).
+		Where("id = ? AND last_used_step < ?", id, step).
+		Updates(map[string]interface{}{"last_used_step": step, "updated_at": time.Now()})
+	return result.RowsAffected == 1, result.Error
+}
+
+// ReplaceRecoveryCodes swaps all recovery codes of a user for a new set
+func (r *mfaRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codes []*models.RecoveryCode) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codes)
+	})
+}
+
+// UseRecoveryCode marks an unused recovery code as used, returning false when none matched
+func (r *mfaRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string, usedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", usedAt)
+	return result.RowsAffected == 1, result.Error
+}
+
+// CountUnusedRecoveryCodes counts the recovery codes a user has left
+func (r *mfaRepository) CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// CreateChallenge stores a login challenge
+func (r *mfaRepository) CreateChallenge(ctx context.Context, challenge *models.MFAChallenge) error {
+	return r.db.WithContext(ctx).Create(challenge).Error
+}
+
+// GetChallengeByTokenHash retrieves an unconsumed, unexpired challenge
+func (r *mfaRepository) GetChallengeByTokenHash(ctx context.Context, tokenHash string, now time.Time) (*models.MFAChallenge, error) {
+	var challenge models.MFAChallenge
+	err := r.db.WithContext(ctx).
+		Where("token_hash = ? AND consumed_at IS NULL AND expires_at > ?", tokenHash, now).
+		First(&challenge).Error
+	if err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// IncrementChallengeAttempts counts a wrong code against a challenge
+func (r *mfaRepository) IncrementChallengeAttempts(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.MFAChallenge{}).
+		Where("id = ?", id).
+		Update("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// ConsumeChallenge marks a challenge as used, returning false if it was consumed concurrently
+func (r *mfaRepository) ConsumeChallenge(ctx context.Context, id uuid.UUID, consumedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.MFAChallenge{}).
+		Where("id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", consumedAt)
+	return result.RowsAffected == 1, result.Error
+}
+
+// replaceRecoveryCodes deletes the existing codes of a user and inserts the new ones
+func replaceRecoveryCodes(tx *gorm.DB, userID uuid.UUID, codes []*models.RecoveryCode) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	return tx.Create(&codes).Error
+}
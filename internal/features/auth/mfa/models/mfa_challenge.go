@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MFAChallenge is issued after a correct password and exchanged for tokens with a second factor
+type MFAChallenge struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID     uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index"`
+	TokenHash  string     `json:"-" gorm:"not null;size:64;uniqueIndex"`
+	Attempts   int        `json:"attempts" gorm:"not null;default:0"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null;type:timestamptz"`
+	ConsumedAt *time.Time `json:"consumed_at" gorm:"type:timestamptz"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the MFAChallenge model
+func (MFAChallenge) TableName() string {
+	return "mfa_challenges"
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCode is a single-use code that replaces a TOTP code when the device is lost
+type RecoveryCode struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID    uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index"`
+	CodeHash  string     `json:"-" gorm:"not null;size:64;uniqueIndex"`
+	UsedAt    *time.Time `json:"used_at" gorm:"type:timestamptz"`
+	CreatedAt time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the RecoveryCode model
+func (RecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
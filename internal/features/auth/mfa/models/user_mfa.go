@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserMFA holds the TOTP secret of a user. Enrolment is pending until EnabledAt is set.
+type UserMFA struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID          uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;uniqueIndex"`
+	SecretEncrypted string     `json:"-" gorm:"not null;type:text"`
+	LastUsedStep    int64      `json:"-" gorm:"not null;default:0"` // last accepted TOTP time step, rejects code replay
+	EnabledAt       *time.Time `json:"enabled_at" gorm:"type:timestamptz"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
+	UpdatedAt       time.Time  `json:"updated_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the UserMFA model
+func (UserMFA) TableName() string {
+	return "user_mfa"
+}
+
+// IsEnabled reports whether enrolment has been confirmed
+func (m *UserMFA) IsEnabled() bool {
+	return m.EnabledAt != nil
+}
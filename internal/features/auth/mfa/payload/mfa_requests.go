@@ -0,0 +1,12 @@
+package payload
+
+// MFACodeRequest carries a TOTP code or a recovery code
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required,min=6,max=32"`
+}
+
+// MFAVerifyRequest completes a login that returned an MFA challenge
+type MFAVerifyRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,min=6,max=32"`
+}
@@ -0,0 +1,37 @@
+package payload
+
+import "time"
+
+// MFAStatusResponse describes the MFA state of the current user
+type MFAStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	Pending                bool       `json:"pending"`
+	RequiredByCompany      bool       `json:"required_by_company"`
+	RecoveryCodesRemaining int64      `json:"recovery_codes_remaining"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+}
+
+// MFAEnrollmentResponse carries the secret to add to an authenticator app
+type MFAEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // render as a QR code
+}
+
+// MFARecoveryCodesResponse returns freshly generated recovery codes, shown only once
+type MFARecoveryCodesResponse struct {
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MFAChallengeResponse is returned by login instead of tokens when a second factor is needed
+type MFAChallengeResponse struct {
+	MFARequired    bool     `json:"mfa_required"`
+	ChallengeToken string   `json:"challenge_token"`
+	ExpiresIn      int64    `json:"expires_in"`
+	Methods        []string `json:"methods"`
+}
+
+// MFAMessageResponse represents a simple MFA response
+type MFAMessageResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,407 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/mfa/entity/database"
+	"github.com/yakka-backend/internal/features/auth/mfa/models"
+	"github.com/yakka-backend/internal/features/auth/mfa/payload"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	builderModels "github.com/yakka-backend/internal/features/builder_profiles/models"
+	"github.com/yakka-backend/internal/infrastructure/config"
+	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// recoveryCodeCount is how many recovery codes are issued at a time
+	recoveryCodeCount = 10
+	// maxChallengeAttempts is how many wrong codes a login challenge accepts before it is burnt
+	maxChallengeAttempts = 5
+)
+
+// Clock returns the current time; injected so TOTP steps and expiry can be controlled
+type Clock func() time.Time
+
+// MFAUsecase defines the interface for two-factor authentication operations
+type MFAUsecase interface {
+	GetStatus(ctx context.Context, userID uuid.UUID) (*payload.MFAStatusResponse, error)
+	BeginEnrollment(ctx context.Context, userID uuid.UUID) (*payload.MFAEnrollmentResponse, error)
+	ConfirmEnrollment(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	Disable(ctx context.Context, userID uuid.UUID, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+
+	IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error)
+	CreateChallenge(ctx context.Context, userID uuid.UUID) (string, time.Duration, error)
+	GetChallengeUser(ctx context.Context, challengeToken string) (uuid.UUID, error)
+	VerifyChallenge(ctx context.Context, challengeToken, code string) (uuid.UUID, error)
+}
+
+// UserRepository interface for looking up the enrolling user
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*userModels.User, error)
+}
+
+// BuilderProfileRepository interface for reading the company MFA policy of a builder
+type BuilderProfileRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*builderModels.BuilderProfile, error)
+}
+
+// mfaUsecase implements MFAUsecase
+type mfaUsecase struct {
+	repo         database.MFARepository
+	userRepo     UserRepository
+	builderRepo  BuilderProfileRepository
+	secrets      *secretBox
+	issuer       string
+	challengeTTL time.Duration
+	now          Clock
+}
+
+// NewMFAUsecase creates a new MFA usecase
+func NewMFAUsecase(repo database.MFARepository, userRepo UserRepository, builderRepo BuilderProfileRepository, cfg config.MFAConfig, clock Clock) (MFAUsecase, error) {
+	secrets, err := newSecretBox(cfg.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+	return &mfaUsecase{
+		repo:         repo,
+		userRepo:     userRepo,
+		builderRepo:  builderRepo,
+		secrets:      secrets,
+		issuer:       cfg.Issuer,
+		challengeTTL: cfg.ChallengeTTL,
+		now:          clock,
+	}, nil
+}
+
+// GetStatus returns the MFA state of a user
+func (u *mfaUsecase) GetStatus(ctx context.Context, userID uuid.UUID) (*payload.MFAStatusResponse, error) {
+	status := &payload.MFAStatusResponse{
+		RequiredByCompany: u.companyRequiresMFA(ctx, userID),
+	}
+
+	mfa, err := u.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return status, nil
+		}
+		return nil, errors.ErrInternal
+	}
+
+	status.Enabled = mfa.IsEnabled()
+	status.Pending = !mfa.IsEnabled()
+	status.EnabledAt = mfa.EnabledAt
+	if mfa.IsEnabled() {
+		remaining, err := u.repo.CountUnusedRecoveryCodes(ctx, userID)
+		if err != nil {
+			return nil, errors.ErrInternal
+		}
+		status.RecoveryCodesRemaining = remaining
+	}
+	return status, nil
+}
+
+// BeginEnrollment generates a new TOTP secret for a builder. Enrolment stays
+// pending until ConfirmEnrollment proves the authenticator app has it.
+func (u *mfaUsecase) BeginEnrollment(ctx context.Context, userID uuid.UUID) (*payload.MFAEnrollmentResponse, error) {
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+	if user.Role != userModels.UserRoleBuilder {
+		return nil, errors.ErrForbidden
+	}
+
+	mfa, err := u.repo.GetByUserID(ctx, userID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, errors.ErrInternal
+	}
+	if mfa != nil && mfa.IsEnabled() {
+		return nil, errors.ErrConflict
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, errors.ErrInternal
+	}
+	encrypted, err := u.secrets.seal(secret)
+	if err != nil {
+		return nil, errors.ErrInternal
+	}
+
+	now := u.now()
+	if mfa == nil {
+		mfa = &models.UserMFA{
+			ID:        uuid.New(),
+			UserID:    userID,
+			CreatedAt: now,
+		}
+	}
+	mfa.SecretEncrypted = encrypted
+	mfa.LastUsedStep = 0
+	mfa.UpdatedAt = now
+
+	if err := u.repo.Save(ctx, mfa); err != nil {
+		return nil, errors.ErrInternal
+	}
+
+	return &payload.MFAEnrollmentResponse{
+		Secret:          secret,
+		ProvisioningURI: provisioningURI(u.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables MFA once the first TOTP code checks out and returns the recovery codes
+func (u *mfaUsecase) ConfirmEnrollment(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	mfa, err := u.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrNotFound
+		}
+		return nil, errors.ErrInternal
+	}
+	if mfa.IsEnabled() {
+		return nil, errors.ErrConflict
+	}
+
+	step, ok := u.checkTOTP(mfa, code)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	codes, records, err := u.newRecoveryCodes(userID)
+	if err != nil {
+		return nil, errors.ErrInternal
+	}
+
+	now := u.now()
+	mfa.EnabledAt = &now
+	mfa.LastUsedStep = step
+	mfa.UpdatedAt = now
+	if err := u.repo.Enable(ctx, mfa, records); err != nil {
+		return nil, errors.ErrInternal
+	}
+
+	log.Printf("🔐 MFA enabled for user %s", userID)
+	return codes, nil
+}
+
+// Disable turns MFA off after checking a second factor, unless the company requires it
+func (u *mfaUsecase) Disable(ctx context.Context, userID uuid.UUID, code string) error {
+	mfa, err := u.enabledMFA(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.companyRequiresMFA(ctx, userID) {
+		return errors.ErrForbidden
+	}
+	if err := u.verifySecondFactor(ctx, mfa, code); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteByUserID(ctx, userID); err != nil {
+		return errors.ErrInternal
+	}
+
+	log.Printf("🔓 MFA disabled for user %s", userID)
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes after checking a second factor
+func (u *mfaUsecase) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	mfa, err := u.enabledMFA(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.verifySecondFactor(ctx, mfa, code); err != nil {
+		return nil, err
+	}
+
+	codes, records, err := u.newRecoveryCodes(userID)
+	if err != nil {
+		return nil, errors.ErrInternal
+	}
+	if err := u.repo.ReplaceRecoveryCodes(ctx, userID, records); err != nil {
+		return nil, errors.ErrInternal
+	}
+	return codes, nil
+}
+
+// IsEnabled reports whether the user must pass a second factor to log in
+func (u *mfaUsecase) IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	mfa, err := u.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, errors.ErrInternal
+	}
+	return mfa.IsEnabled(), nil
+}
+
+// CreateChallenge issues the short-lived token a login exchanges for access tokens with a second factor
+func (u *mfaUsecase) CreateChallenge(ctx context.Context, userID uuid.UUID) (string, time.Duration, error) {
+	token, err := utils.GenerateToken()
+	if err != nil {
+		return "", 0, errors.ErrInternal
+	}
+
+	now := u.now()
+	challenge := &models.MFAChallenge{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(u.challengeTTL),
+		CreatedAt: now,
+	}
+	if err := u.repo.CreateChallenge(ctx, challenge); err != nil {
+		return "", 0, errors.ErrInternal
+	}
+	return token, u.challengeTTL, nil
+}
+
+// GetChallengeUser returns the user a live login challenge belongs to, so the
+// caller can apply the account login throttle before a code is checked
+func (u *mfaUsecase) GetChallengeUser(ctx context.Context, challengeToken string) (uuid.UUID, error) {
+	challenge, err := u.repo.GetChallengeByTokenHash(ctx, utils.HashToken(challengeToken), u.now())
+	if err != nil || challenge.Attempts >= maxChallengeAttempts {
+		return uuid.Nil, errors.ErrUnauthorized
+	}
+	return challenge.UserID, nil
+}
+
+// VerifyChallenge checks the second factor of a login challenge and returns the user it belongs to
+func (u *mfaUsecase) VerifyChallenge(ctx context.Context, challengeToken, code string) (uuid.UUID, error) {
+	challenge, err := u.repo.GetChallengeByTokenHash(ctx, utils.HashToken(challengeToken), u.now())
+	if err != nil || challenge.Attempts >= maxChallengeAttempts {
+		return uuid.Nil, errors.ErrUnauthorized
+	}
+
+	mfa, err := u.enabledMFA(ctx, challenge.UserID)
+	if err != nil {
+		return uuid.Nil, errors.ErrUnauthorized
+	}
+
+	if err := u.verifySecondFactor(ctx, mfa, code); err != nil {
+		if err := u.repo.IncrementChallengeAttempts(ctx, challenge.ID); err != nil {
+			log.Printf("⚠️ Failed to count MFA challenge attempt: %v", err)
+		}
+		log.Printf("🚫 Invalid MFA code for user %s", challenge.UserID)
+		return uuid.Nil, err
+	}
+
+	consumed, err := u.repo.ConsumeChallenge(ctx, challenge.ID, u.now())
+	if err != nil {
+		return uuid.Nil, errors.ErrInternal
+	}
+	if !consumed {
+		return uuid.Nil, errors.ErrUnauthorized
+	}
+
+	return challenge.UserID, nil
+}
+
+// enabledMFA returns the confirmed enrolment of a user
+func (u *mfaUsecase) enabledMFA(ctx context.Context, userID uuid.UUID) (*models.UserMFA, error) {
+	mfa, err := u.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrNotFound
+		}
+		return nil, errors.ErrInternal
+	}
+	if !mfa.IsEnabled() {
+		return nil, errors.ErrNotFound
+	}
+	return mfa, nil
+}
+
+// verifySecondFactor accepts a TOTP code or an unused recovery code
+func (u *mfaUsecase) verifySecondFactor(ctx context.Context, mfa *models.UserMFA, code string) error {
+	code = strings.TrimSpace(code)
+
+	if isTOTPCode(code) {
+		step, ok := u.checkTOTP(mfa, code)
+		if !ok {
+			return errors.ErrUnauthorized
+		}
+		advanced, err := u.repo.AdvanceStep(ctx, mfa.ID, step)
+		if err != nil {
+			return errors.ErrInternal
+		}
+		if !advanced {
+			return errors.ErrUnauthorized
+		}
+		return nil
+	}
+
+	used, err := u.repo.UseRecoveryCode(ctx, mfa.UserID, utils.HashToken(normalizeRecoveryCode(code)), u.now())
+	if err != nil {
+		return errors.ErrInternal
+	}
+	if !used {
+		return errors.ErrUnauthorized
+	}
+	log.Printf("⚠️ Recovery code used by user %s", mfa.UserID)
+	return nil
+}
+
+// checkTOTP decrypts the secret and checks a code, returning the matched time step
+func (u *mfaUsecase) checkTOTP(mfa *models.UserMFA, code string) (int64, bool) {
+	secret, err := u.secrets.open(mfa.SecretEncrypted)
+	if err != nil {
+		log.Printf("❌ Failed to decrypt MFA secret for user %s: %v", mfa.UserID, err)
+		return 0, false
+	}
+	return verifyTOTP(secret, strings.TrimSpace(code), u.now())
+}
+
+// companyRequiresMFA reports whether the builder company of a user requires MFA
+func (u *mfaUsecase) companyRequiresMFA(ctx context.Context, userID uuid.UUID) bool {
+	profile, err := u.builderRepo.GetByUserID(ctx, userID)
+	if err != nil || profile.Company == nil {
+		return false
+	}
+	return profile.Company.RequireMFA
+}
+
+// newRecoveryCodes generates recovery codes and the hashed records to store
+func (u *mfaUsecase) newRecoveryCodes(userID uuid.UUID) ([]string, []*models.RecoveryCode, error) {
+	now := u.now()
+	codes := make([]string, 0, recoveryCodeCount)
+	records := make([]*models.RecoveryCode, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw := make([]byte, 6)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		encoded := strings.ToLower(base32NoPadding.EncodeToString(raw))[:10]
+		code := encoded[:5] + "-" + encoded[5:]
+
+		codes = append(codes, code)
+		records = append(records, &models.RecoveryCode{
+			ID:        uuid.New(),
+			UserID:    userID,
+			CodeHash:  utils.HashToken(normalizeRecoveryCode(code)),
+			CreatedAt: now,
+		})
+	}
+	return codes, records, nil
+}
+
+// normalizeRecoveryCode ignores case, spaces and dashes when comparing recovery codes
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/mfa/entity/database"
+	"github.com/yakka-backend/internal/features/auth/mfa/models"
+	"github.com/yakka-backend/internal/shared/errors"
+)
+
+// recoveryCodes keeps a user's recovery codes in memory, marking them used like the SQL update does
+type recoveryCodes struct {
+	database.MFARepository
+	codes []*models.RecoveryCode
+}
+
+func (r *recoveryCodes) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string, usedAt time.Time) (bool, error) {
+	for _, code := range r.codes {
+		if code.UserID == userID && code.CodeHash == codeHash && code.UsedAt == nil {
+			code.UsedAt = &usedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestRecoveryCodesAreSingleUse(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	repo := &recoveryCodes{}
+	u := &mfaUsecase{repo: repo, now: func() time.Time { return now }}
+
+	codes, records, err := u.newRecoveryCodes(userID)
+	if err != nil {
+		t.Fatalf("newRecoveryCodes: %v", err)
+	}
+	if len(codes) != recoveryCodeCount {
+		t.Fatalf("got %d recovery codes, want %d", len(codes), recoveryCodeCount)
+	}
+	repo.codes = records
+	mfa := &models.UserMFA{ID: uuid.New(), UserID: userID, EnabledAt: &now}
+
+	ctx := context.Background()
+	// Typed in upper case without the dash, as read off a printout
+	typed := strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))
+	if err := u.verifySecondFactor(ctx, mfa, typed); err != nil {
+		t.Fatalf("first use of a recovery code = %v, want accepted", err)
+	}
+	if err := u.verifySecondFactor(ctx, mfa, codes[0]); err != errors.ErrUnauthorized {
+		t.Errorf("second use of a recovery code = %v, want ErrUnauthorized", err)
+	}
+	if records[0].UsedAt == nil || !records[0].UsedAt.Equal(now) {
+		t.Errorf("UsedAt = %v, want %v", records[0].UsedAt, now)
+	}
+
+	// The other codes are untouched
+	if err := u.verifySecondFactor(ctx, mfa, codes[1]); err != nil {
+		t.Errorf("another recovery code = %v, want accepted", err)
+	}
+	if err := u.verifySecondFactor(ctx, mfa, "zzzzz-zzzzz"); err != errors.ErrUnauthorized {
+		t.Errorf("unknown recovery code = %v, want ErrUnauthorized", err)
+	}
+
+	// Another user's code does not work for this user
+	otherUser := &models.UserMFA{ID: uuid.New(), UserID: uuid.New(), EnabledAt: &now}
+	if err := u.verifySecondFactor(ctx, otherUser, codes[2]); err != errors.ErrUnauthorized {
+		t.Errorf("recovery code of another user = %v, want ErrUnauthorized", err)
+	}
+}
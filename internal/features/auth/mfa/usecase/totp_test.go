@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA1 seed of the RFC 6238 Appendix B test vectors
+const rfc6238Secret = "12345678901234567890"
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	// RFC 6238 Appendix B, SHA1 column. The RFC prints eight digits; six-digit
+	// codes are their last six.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	secret := base32NoPadding.EncodeToString([]byte(rfc6238Secret))
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			now := time.Unix(tt.unix, 0)
+			want := tt.want[len(tt.want)-totpDigits:]
+
+			if got := totpCode([]byte(rfc6238Secret), totpStep(now)); got != want {
+				t.Errorf("totpCode at %d = %s, want %s", tt.unix, got, want)
+			}
+			if step, ok := verifyTOTP(secret, want, now); !ok || step != totpStep(now) {
+				t.Errorf("verifyTOTP at %d = %d, %v, want %d, true", tt.unix, step, ok, totpStep(now))
+			}
+		})
+	}
+}
+
+func TestVerifyTOTPWindow(t *testing.T) {
+	secret := base32NoPadding.EncodeToString([]byte(rfc6238Secret))
+	now := time.Unix(1111111111, 0)
+	current := totpStep(now)
+
+	tests := []struct {
+		name   string
+		offset int64
+		wantOK bool
+	}{
+		{"two steps behind", -2, false},
+		{"one step behind", -1, true},
+		{"current step", 0, true},
+		{"one step ahead", 1, true},
+		{"two steps ahead", 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := totpCode([]byte(rfc6238Secret), current+tt.offset)
+			step, ok := verifyTOTP(secret, code, now)
+			if ok != tt.wantOK {
+				t.Fatalf("verifyTOTP() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != current+tt.offset {
+				t.Errorf("verifyTOTP() step = %d, want %d", step, current+tt.offset)
+			}
+		})
+	}
+
+	// Lowercase secrets, as some clients store them, decode the same
+	if _, ok := verifyTOTP(strings.ToLower(secret), totpCode([]byte(rfc6238Secret), current), now); !ok {
+		t.Error("verifyTOTP rejected a lowercase secret")
+	}
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/oidc/models"
+	"gorm.io/gorm"
+)
+
+// IdentityRepository defines the interface for linked identity data operations
+type IdentityRepository interface {
+	Create(ctx context.Context, identity *models.UserIdentity) error
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserIdentity, error)
+	UpdateLastLogin(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// identityRepository implements IdentityRepository
+type identityRepository struct {
+	db *gorm.DB
+}
+
+// NewIdentityRepository creates a new identity repository
+func NewIdentityRepository(db *gorm.DB) IdentityRepository {
+	return &identityRepository{db: db}
+}
+
+// Create links a new identity
+func (r *identityRepository) Create(ctx context.Context, identity *models.UserIdentity) error {
+	return r.db.WithContext(ctx).Create(identity).Error
+}
+
+// GetByProviderSubject retrieves the identity for a provider account
+func (r *identityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	err := r.db.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// GetByUserID retrieves all identities linked to a user
+func (r *identityRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserIdentity, error) {
+	var identities []*models.UserIdentity
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at ASC").Find(&identities).Error
+	return identities, err
+}
+
+// UpdateLastLogin records when an identity was last used to log in
+func (r *identityRepository) UpdateLastLogin(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.UserIdentity{}).Where("id = ?", id).Update("last_login_at", at).Error
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity links an external OpenID Connect account to a user
+type UserIdentity struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID      uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index"`
+	Provider    string     `json:"provider" gorm:"size:32;not null;uniqueIndex:idx_user_identities_provider_subject"`
+	Subject     string     `json:"-" gorm:"size:255;not null;uniqueIndex:idx_user_identities_provider_subject"`
+	Email       string     `json:"email" gorm:"size:255"`
+	LastLoginAt *time.Time `json:"last_login_at" gorm:"type:timestamptz"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the UserIdentity model
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
@@ -0,0 +1,9 @@
+package payload
+
+// OIDCLoginRequest carries the ID token obtained by the app from the provider
+type OIDCLoginRequest struct {
+	IDToken   string  `json:"id_token" validate:"required"`
+	Nonce     string  `json:"nonce" validate:"required,max=255"`
+	FirstName *string `json:"first_name,omitempty" validate:"omitempty,max=120"` // Apple only shares the name with the app, not in the token
+	LastName  *string `json:"last_name,omitempty" validate:"omitempty,max=120"`
+}
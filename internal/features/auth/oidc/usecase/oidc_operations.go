@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/oidc/entity/database"
+	"github.com/yakka-backend/internal/features/auth/oidc/models"
+	"github.com/yakka-backend/internal/features/auth/oidc/payload"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/oidc"
+	"github.com/yakka-backend/internal/shared/errors"
+	"gorm.io/gorm"
+)
+
+// OIDCUsecase defines the interface for OpenID Connect social login
+type OIDCUsecase interface {
+	Login(ctx context.Context, providerName string, req payload.OIDCLoginRequest) (*userModels.User, error)
+}
+
+// UserRepository interface for finding, creating and activating users
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*userModels.User, error)
+	GetByEmail(ctx context.Context, email string) (*userModels.User, error)
+	Create(ctx context.Context, user *userModels.User) error
+	UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
+	UpdateLastLogin(ctx context.Context, id uuid.UUID) error
+}
+
+// SessionRevoker interface for ending the sessions of an account taken over by a verified identity
+type SessionRevoker interface {
+	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) error
+}
+
+// EmailVerifier interface for the emails sent to users created by social login
+type EmailVerifier interface {
+	RequestEmailVerification(ctx context.Context, userID uuid.UUID) error
+}
+
+// oidcUsecase implements OIDCUsecase
+type oidcUsecase struct {
+	providers     map[string]oidc.Provider
+	identityRepo  database.IdentityRepository
+	userRepo      UserRepository
+	sessions      SessionRevoker
+	emailVerifier EmailVerifier
+}
+
+// NewOIDCUsecase creates a new OIDC usecase
+func NewOIDCUsecase(providers map[string]oidc.Provider, identityRepo database.IdentityRepository, userRepo UserRepository, sessions SessionRevoker, emailVerifier EmailVerifier) OIDCUsecase {
+	return &oidcUsecase{
+		providers:     providers,
+		identityRepo:  identityRepo,
+		userRepo:      userRepo,
+		sessions:      sessions,
+		emailVerifier: emailVerifier,
+	}
+}
+
+// Login verifies an ID token and returns the user it belongs to. Unknown identities are
+// linked to the user with the same email when the provider verified it, otherwise a new
+// user is created: active when the provider verified the email, pending until the normal
+// email verification otherwise.
+func (u *oidcUsecase) Login(ctx context.Context, providerName string, req payload.OIDCLoginRequest) (*userModels.User, error) {
+	provider, ok := u.providers[providerName]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	identity, err := provider.Verify(ctx, req.IDToken, req.Nonce)
+	if err != nil {
+		log.Printf("🚫 Rejected %s ID token: %v", providerName, err)
+		return nil, errors.ErrUnauthorized
+	}
+
+	// Returning identity
+	linked, err := u.identityRepo.GetByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		user, err := u.userRepo.GetByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, errors.ErrUnauthorized
+		}
+		if err := u.identityRepo.UpdateLastLogin(ctx, linked.ID, time.Now()); err != nil {
+			log.Printf("⚠️ Failed to update identity last login: %v", err)
+		}
+		return u.loggedIn(ctx, user)
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, errors.ErrInternal
+	}
+
+	if identity.Email == "" {
+		return nil, errors.ErrBadRequest
+	}
+
+	// Existing account with the same email: only link when the provider vouches for the email
+	user, err := u.userRepo.GetByEmail(ctx, identity.Email)
+	if err == nil {
+		if !identity.EmailVerified {
+			return nil, errors.ErrConflict
+		}
+		if user.Status == userModels.UserStatusPending {
+			if err := u.takeOverPending(ctx, user); err != nil {
+				return nil, err
+			}
+		}
+		if err := u.link(ctx, user.ID, identity); err != nil {
+			return nil, err
+		}
+		log.Printf("🔗 Linked %s identity to existing user %s", identity.Provider, user.ID)
+		return u.loggedIn(ctx, user)
+	}
+
+	return u.register(ctx, identity, req)
+}
+
+// takeOverPending activates a pending account whose email the provider just proved.
+// Whoever registered it never verified the email, so their password and sessions are
+// discarded; otherwise someone could pre-register a victim's email and keep access.
+func (u *oidcUsecase) takeOverPending(ctx context.Context, user *userModels.User) error {
+	err := u.userRepo.UpdateSpecificFields(ctx, user.ID, map[string]interface{}{
+		"password_hash": "",
+		"status":        userModels.UserStatusActive,
+		"updated_at":    time.Now(),
+	})
+	if err != nil {
+		return errors.ErrInternal
+	}
+	if err := u.sessions.RevokeAllUserSessions(ctx, user.ID); err != nil {
+		return errors.ErrInternal
+	}
+
+	user.PasswordHash = ""
+	user.Status = userModels.UserStatusActive
+	log.Printf("🔐 Cleared password of pending user %s before linking a verified identity", user.ID)
+	return nil
+}
+
+// register creates a user for a new identity. When the provider vouches for the email the
+// user is active and logged in right away; otherwise it stays pending until the email is
+// verified through the link sent to it.
+func (u *oidcUsecase) register(ctx context.Context, identity *oidc.Identity, req payload.OIDCLoginRequest) (*userModels.User, error) {
+	now := time.Now()
+	status := userModels.UserStatusPending
+	if identity.EmailVerified {
+		status = userModels.UserStatusActive
+	}
+	user := &userModels.User{
+		ID:        uuid.New(),
+		Email:     identity.Email,
+		FirstName: firstNonEmpty(req.FirstName, identity.FirstName),
+		LastName:  firstNonEmpty(req.LastName, identity.LastName),
+		Photo:     optionalString(identity.Picture),
+		Status:    status,
+		Role:      userModels.UserRoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := u.userRepo.Create(ctx, user); err != nil {
+		return nil, errors.ErrInternal
+	}
+	if err := u.link(ctx, user.ID, identity); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Created %s user %s from %s login", user.Status, user.ID, identity.Provider)
+
+	if identity.EmailVerified {
+		return u.loggedIn(ctx, user)
+	}
+	if err := u.emailVerifier.RequestEmailVerification(ctx, user.ID); err != nil {
+		log.Printf("❌ Failed to send verification email: %v", err)
+	}
+	return nil, errors.ErrForbidden
+}
+
+// link stores the identity for a user
+func (u *oidcUsecase) link(ctx context.Context, userID uuid.UUID, identity *oidc.Identity) error {
+	now := time.Now()
+	err := u.identityRepo.Create(ctx, &models.UserIdentity{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		LastLoginAt: &now,
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return errors.ErrInternal
+	}
+	return nil
+}
+
+// loggedIn applies the same status rule as password login and records the login
+func (u *oidcUsecase) loggedIn(ctx context.Context, user *userModels.User) (*userModels.User, error) {
+	if user.Status != userModels.UserStatusActive {
+		return nil, errors.ErrForbidden
+	}
+	if err := u.userRepo.UpdateLastLogin(ctx, user.ID); err != nil {
+		log.Printf("⚠️ Failed to update last login: %v", err)
+	}
+	return user, nil
+}
+
+// firstNonEmpty prefers the value sent by the app over the one in the token
+func firstNonEmpty(value *string, fallback string) *string {
+	if value != nil && *value != "" {
+		return value
+	}
+	return optionalString(fallback)
+}
+
+// optionalString returns nil for empty strings
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
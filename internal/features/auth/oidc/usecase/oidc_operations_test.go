@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/oidc/models"
+	"github.com/yakka-backend/internal/features/auth/oidc/payload"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/config"
+	"github.com/yakka-backend/internal/infrastructure/oidc"
+	"github.com/yakka-backend/internal/infrastructure/oidc/oidctest"
+	"github.com/yakka-backend/internal/shared/errors"
+	"gorm.io/gorm"
+)
+
+// memoryIdentityRepo keeps linked identities in memory
+type memoryIdentityRepo struct {
+	identities []*models.UserIdentity
+}
+
+func (r *memoryIdentityRepo) Create(ctx context.Context, identity *models.UserIdentity) error {
+	r.identities = append(r.identities, identity)
+	return nil
+}
+
+func (r *memoryIdentityRepo) GetByProviderSubject(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryIdentityRepo) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserIdentity, error) {
+	var identities []*models.UserIdentity
+	for _, identity := range r.identities {
+		if identity.UserID == userID {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+func (r *memoryIdentityRepo) UpdateLastLogin(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return nil
+}
+
+// memoryUserRepo keeps users in memory and applies field updates the way the gorm repo does
+type memoryUserRepo struct {
+	users map[uuid.UUID]*userModels.User
+}
+
+func (r *memoryUserRepo) GetByID(ctx context.Context, id uuid.UUID) (*userModels.User, error) {
+	if user, ok := r.users[id]; ok {
+		copied := *user
+		return &copied, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryUserRepo) GetByEmail(ctx context.Context, email string) (*userModels.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryUserRepo) Create(ctx context.Context, user *userModels.User) error {
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+func (r *memoryUserRepo) UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error {
+	user, ok := r.users[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	if hash, ok := updates["password_hash"].(string); ok {
+		user.PasswordHash = hash
+	}
+	if status, ok := updates["status"].(userModels.UserStatus); ok {
+		user.Status = status
+	}
+	return nil
+}
+
+func (r *memoryUserRepo) UpdateLastLogin(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+// recordingSessions remembers whose sessions were revoked
+type recordingSessions struct {
+	revoked []uuid.UUID
+}
+
+func (s *recordingSessions) RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) error {
+	s.revoked = append(s.revoked, userID)
+	return nil
+}
+
+// recordingVerifier remembers who was sent a verification email
+type recordingVerifier struct {
+	requested []uuid.UUID
+}
+
+func (v *recordingVerifier) RequestEmailVerification(ctx context.Context, userID uuid.UUID) error {
+	v.requested = append(v.requested, userID)
+	return nil
+}
+
+const (
+	testNonce   = "n-0S6_WzA2Mj"
+	testEmail   = "worker@example.com"
+	testSubject = "google-subject-1"
+)
+
+type oidcFixture struct {
+	usecase    OIDCUsecase
+	issuer     *oidctest.Issuer
+	identities *memoryIdentityRepo
+	users      *memoryUserRepo
+	sessions   *recordingSessions
+	verifier   *recordingVerifier
+}
+
+func newOIDCFixture(t *testing.T, existing ...*userModels.User) *oidcFixture {
+	t.Helper()
+	issuer := oidctest.NewIssuer(t)
+	f := &oidcFixture{
+		issuer:     issuer,
+		identities: &memoryIdentityRepo{},
+		users:      &memoryUserRepo{users: make(map[uuid.UUID]*userModels.User)},
+		sessions:   &recordingSessions{},
+		verifier:   &recordingVerifier{},
+	}
+	for _, user := range existing {
+		f.users.users[user.ID] = user
+	}
+	providers := oidc.NewProviders(config.OIDCConfig{
+		Providers: []config.OIDCProviderConfig{issuer.ProviderConfig("google")},
+	})
+	f.usecase = NewOIDCUsecase(providers, f.identities, f.users, f.sessions, f.verifier)
+	return f
+}
+
+// login signs the claims with the stand-in issuer and logs in with them
+func (f *oidcFixture) login(t *testing.T, claims jwt.MapClaims) (*userModels.User, error) {
+	t.Helper()
+	return f.usecase.Login(context.Background(), "google", payload.OIDCLoginRequest{
+		IDToken: f.issuer.Sign(t, claims),
+		Nonce:   testNonce,
+	})
+}
+
+func existingUser(status userModels.UserStatus) *userModels.User {
+	return &userModels.User{
+		ID:           uuid.New(),
+		Email:        testEmail,
+		PasswordHash: "$2a$10$chosen-by-whoever-registered",
+		Status:       status,
+		Role:         userModels.UserRoleUser,
+	}
+}
+
+func TestLoginRejectsInvalidTokens(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims func(jwt.MapClaims)
+	}{
+		{"bad audience", func(c jwt.MapClaims) { c["aud"] = "another-app" }},
+		{"expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{"bad nonce", func(c jwt.MapClaims) { c["nonce"] = "replayed-nonce" }},
+		{"no nonce", func(c jwt.MapClaims) { delete(c, "nonce") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOIDCFixture(t)
+			claims := oidctest.Claims(testSubject, testEmail, testNonce)
+			tt.claims(claims)
+
+			if _, err := f.login(t, claims); err != errors.ErrUnauthorized {
+				t.Fatalf("Login error = %v, want ErrUnauthorized", err)
+			}
+			if len(f.users.users) != 0 || len(f.identities.identities) != 0 {
+				t.Error("an invalid token created a user or identity")
+			}
+		})
+	}
+}
+
+func TestLoginUnknownProvider(t *testing.T) {
+	f := newOIDCFixture(t)
+	_, err := f.usecase.Login(context.Background(), "facebook", payload.OIDCLoginRequest{IDToken: "x", Nonce: testNonce})
+	if err != errors.ErrNotFound {
+		t.Fatalf("Login error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLoginRegistersNewUser(t *testing.T) {
+	tests := []struct {
+		name          string
+		emailVerified bool
+		wantErr       error
+		wantStatus    userModels.UserStatus
+		wantEmails    int
+	}{
+		{"verified email logs in", true, nil, userModels.UserStatusActive, 0},
+		{"unverified email waits for verification", false, errors.ErrForbidden, userModels.UserStatusPending, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOIDCFixture(t)
+			claims := oidctest.Claims(testSubject, testEmail, testNonce)
+			claims["email_verified"] = tt.emailVerified
+
+			loggedIn, err := f.login(t, claims)
+			if err != tt.wantErr {
+				t.Fatalf("Login error = %v, want %v", err, tt.wantErr)
+			}
+
+			user, err := f.users.GetByEmail(context.Background(), testEmail)
+			if err != nil {
+				t.Fatalf("no user was created: %v", err)
+			}
+			if user.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", user.Status, tt.wantStatus)
+			}
+			if tt.wantErr == nil && (loggedIn == nil || loggedIn.ID != user.ID) {
+				t.Errorf("logged in as %v, want the new user %s", loggedIn, user.ID)
+			}
+			if len(f.verifier.requested) != tt.wantEmails {
+				t.Errorf("sent %d verification emails, want %d", len(f.verifier.requested), tt.wantEmails)
+			}
+			if len(f.identities.identities) != 1 || f.identities.identities[0].UserID != user.ID {
+				t.Error("identity was not linked to the new user")
+			}
+		})
+	}
+}
+
+func TestLoginLinksExistingUser(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        userModels.UserStatus
+		emailVerified bool
+		wantErr       error
+		wantLinked    bool
+		wantCleared   bool
+	}{
+		{"active account with verified email", userModels.UserStatusActive, true, nil, true, false},
+		{"pending account is taken over", userModels.UserStatusPending, true, nil, true, true},
+		{"unverified email is not linked", userModels.UserStatusActive, false, errors.ErrConflict, false, false},
+		{"unverified email on pending account", userModels.UserStatusPending, false, errors.ErrConflict, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := existingUser(tt.status)
+			password := existing.PasswordHash
+			f := newOIDCFixture(t, existing)
+
+			claims := oidctest.Claims(testSubject, testEmail, testNonce)
+			claims["email_verified"] = tt.emailVerified
+
+			user, err := f.login(t, claims)
+			if err != tt.wantErr {
+				t.Fatalf("Login error = %v, want %v", err, tt.wantErr)
+			}
+			if linked := len(f.identities.identities) == 1; linked != tt.wantLinked {
+				t.Fatalf("linked = %v, want %v", linked, tt.wantLinked)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if user.ID != existing.ID {
+				t.Errorf("logged in as %s, want existing user %s", user.ID, existing.ID)
+			}
+
+			stored := f.users.users[existing.ID]
+			if stored.Status != userModels.UserStatusActive {
+				t.Errorf("status = %s, want active", stored.Status)
+			}
+			if cleared := stored.PasswordHash == ""; cleared != tt.wantCleared {
+				t.Errorf("password cleared = %v, want %v", cleared, tt.wantCleared)
+			}
+			if tt.wantCleared {
+				if len(f.sessions.revoked) != 1 || f.sessions.revoked[0] != existing.ID {
+					t.Errorf("revoked sessions of %v, want %s", f.sessions.revoked, existing.ID)
+				}
+			} else if stored.PasswordHash != password || len(f.sessions.revoked) != 0 {
+				t.Error("an active account lost its password or sessions")
+			}
+		})
+	}
+}
+
+func TestLoginReturningIdentity(t *testing.T) {
+	existing := existingUser(userModels.UserStatusActive)
+	f := newOIDCFixture(t, existing)
+	claims := oidctest.Claims(testSubject, testEmail, testNonce)
+
+	if _, err := f.login(t, claims); err != nil {
+		t.Fatalf("first Login: %v", err)
+	}
+
+	// The provider email may change; the subject keeps pointing at the same user
+	claims = oidctest.Claims(testSubject, "renamed@example.com", testNonce)
+	user, err := f.login(t, claims)
+	if err != nil {
+		t.Fatalf("second Login: %v", err)
+	}
+	if user.ID != existing.ID {
+		t.Errorf("logged in as %s, want %s", user.ID, existing.ID)
+	}
+	if len(f.identities.identities) != 1 {
+		t.Errorf("linked %d identities, want 1", len(f.identities.identities))
+	}
+}
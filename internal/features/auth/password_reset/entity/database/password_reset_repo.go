@@ -15,7 +15,7 @@ type PasswordResetRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*models.PasswordReset, error)
 	GetByTokenHash(ctx context.Context, tokenHash string) (*models.PasswordReset, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.PasswordReset, error)
-	MarkAsUsed(ctx context.Context, id uuid.UUID) error
+	MarkAsUsed(ctx context.Context, id uuid.UUID) (bool, error)
 	DeleteExpired(ctx context.Context) error
 	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
 }
@@ -62,9 +62,12 @@ func (r *passwordResetRepository) GetByUserID(ctx context.Context, userID uuid.U
 	return resets, err
 }
 
-// MarkAsUsed marks a password reset as used
-func (r *passwordResetRepository) MarkAsUsed(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Model(&models.PasswordReset{}).Where("id = ?", id).Update("used_at", time.Now()).Error
+// MarkAsUsed marks an unused password reset as used, returning false when it was already used
+func (r *passwordResetRepository) MarkAsUsed(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.PasswordReset{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
 }
 
 // DeleteExpired deletes all expired password resets
@@ -0,0 +1,26 @@
+package database
+
+import (
+	auth_user_db "github.com/yakka-backend/internal/features/auth/user/entity/database"
+	dbInfra "github.com/yakka-backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// PasswordResetTxRepositories are the repositories written together when a password is reset
+type PasswordResetTxRepositories struct {
+	Resets PasswordResetRepository
+	Users  auth_user_db.UserRepository
+}
+
+// PasswordResetUnitOfWork runs a password reset in a single transaction
+type PasswordResetUnitOfWork = dbInfra.UnitOfWork[PasswordResetTxRepositories]
+
+// NewPasswordResetUnitOfWork creates a unit of work for the password reset repositories
+func NewPasswordResetUnitOfWork(db *gorm.DB) PasswordResetUnitOfWork {
+	return dbInfra.NewUnitOfWork(db, func(tx *gorm.DB) PasswordResetTxRepositories {
+		return PasswordResetTxRepositories{
+			Resets: NewPasswordResetRepository(tx),
+			Users:  auth_user_db.NewUserRepository(tx),
+		}
+	})
+}
@@ -2,96 +2,169 @@ package usecase
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
+	"log"
+	"net/url"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/yakka-backend/internal/features/auth/password_reset/entity/database"
 	"github.com/yakka-backend/internal/features/auth/password_reset/models"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/mailer"
 	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
+	"golang.org/x/crypto/bcrypt"
 )
 
+// resetTokenTTL is how long a password reset link stays valid
+const resetTokenTTL = 1 * time.Hour
+
 // PasswordResetUsecase defines the interface for password reset operations
 type PasswordResetUsecase interface {
-	RequestPasswordReset(ctx context.Context, email string) (string, error)
-	ResetPassword(ctx context.Context, token, newPassword string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) (uuid.UUID, error)
 	ValidateResetToken(ctx context.Context, token string) (*models.PasswordReset, error)
 	CleanupExpiredResets(ctx context.Context) error
 }
 
+// UserRepository interface for looking up users and updating their password
+type UserRepository interface {
+	GetByEmail(ctx context.Context, email string) (*userModels.User, error)
+	UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
+}
+
+// SessionRepository interface for revoking sessions after a password change
+type SessionRepository interface {
+	RevokeByUserID(ctx context.Context, userID uuid.UUID) error
+}
+
 // passwordResetUsecase implements PasswordResetUsecase
 type passwordResetUsecase struct {
 	passwordResetRepo database.PasswordResetRepository
+	userRepo          UserRepository
+	sessionRepo       SessionRepository
+	uow               database.PasswordResetUnitOfWork
+	mailer            mailer.Mailer
+	appBaseURL        string
 }
 
 // NewPasswordResetUsecase creates a new password reset usecase
-func NewPasswordResetUsecase(passwordResetRepo database.PasswordResetRepository) PasswordResetUsecase {
+func NewPasswordResetUsecase(passwordResetRepo database.PasswordResetRepository, userRepo UserRepository, sessionRepo SessionRepository, uow database.PasswordResetUnitOfWork, mail mailer.Mailer, appBaseURL string) PasswordResetUsecase {
 	return &passwordResetUsecase{
 		passwordResetRepo: passwordResetRepo,
+		userRepo:          userRepo,
+		sessionRepo:       sessionRepo,
+		uow:               uow,
+		mailer:            mail,
+		appBaseURL:        appBaseURL,
 	}
 }
 
-// RequestPasswordReset creates a password reset request
-func (u *passwordResetUsecase) RequestPasswordReset(ctx context.Context, email string) (string, error) {
-	// Generate reset token
-	resetToken, err := generateResetToken()
+// RequestPasswordReset creates a password reset request and emails the link.
+// Unknown emails succeed silently so the endpoint can't be used to probe accounts.
+func (u *passwordResetUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := u.userRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return "", errors.ErrInternal
+		log.Printf("📧 Password reset requested for unknown email")
+		return nil
 	}
 
-	// Hash the token for storage
-	tokenHash := hashToken(resetToken)
+	// Generate reset token
+	resetToken, err := utils.GenerateToken()
+	if err != nil {
+		return errors.ErrInternal
+	}
 
 	// Create password reset request
 	reset := &models.PasswordReset{
 		ID:        uuid.New(),
-		UserID:    uuid.New(), // This should be the actual user ID from email lookup
-		TokenHash: tokenHash,
-		ExpiresAt: time.Now().Add(1 * time.Hour), // 1 hour expiry
+		UserID:    user.ID,
+		TokenHash: utils.HashToken(resetToken),
+		ExpiresAt: time.Now().Add(resetTokenTTL),
 		CreatedAt: time.Now(),
 	}
 
 	err = u.passwordResetRepo.Create(ctx, reset)
 	if err != nil {
-		return "", errors.ErrInternal
+		return errors.ErrInternal
+	}
+
+	msg, err := mailer.Compose(mailer.TemplatePasswordReset, user.Email, mailer.TemplateData{
+		Name:      utils.StringValue(user.FirstName),
+		ActionURL: u.appBaseURL + "/reset-password?token=" + url.QueryEscape(resetToken),
+		ExpiresIn: "1 hour",
+		AppURL:    u.appBaseURL,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to compose password reset email: %v", err)
+		return errors.ErrInternal
 	}
 
-	return resetToken, nil
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		log.Printf("❌ Failed to send password reset email to user %s: %v", user.ID, err)
+		return errors.ErrInternal
+	}
+
+	return nil
 }
 
-// ResetPassword resets a user's password using a reset token
-func (u *passwordResetUsecase) ResetPassword(ctx context.Context, token, newPassword string) error {
+// ResetPassword resets a user's password using a reset token and signs the user out
+// everywhere. It returns the user whose password changed.
+func (u *passwordResetUsecase) ResetPassword(ctx context.Context, token, newPassword string) (uuid.UUID, error) {
 	// Hash the token to find the reset request
-	tokenHash := hashToken(token)
+	tokenHash := utils.HashToken(token)
 
 	// Get reset request by token hash
 	reset, err := u.passwordResetRepo.GetByTokenHash(ctx, tokenHash)
 	if err != nil {
-		return errors.ErrUnauthorized
+		return uuid.Nil, errors.ErrUnauthorized
 	}
 
 	// Check if token is already used
 	if reset.UsedAt != nil {
-		return errors.ErrConflict
+		return uuid.Nil, errors.ErrConflict
 	}
 
-	// Mark token as used
-	err = u.passwordResetRepo.MarkAsUsed(ctx, reset.ID)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
 	if err != nil {
-		return errors.ErrInternal
+		return uuid.Nil, errors.ErrInternal
 	}
 
-	// Here you would update the user's password
-	// This requires access to the user repository
-	// For now, we'll just return success
+	// Change the password and use up the token together: a failed update leaves the token
+	// usable, and a token another request used first rolls the update back
+	err = u.uow.Do(ctx, func(repos database.PasswordResetTxRepositories) error {
+		err := repos.Users.UpdateSpecificFields(ctx, reset.UserID, map[string]interface{}{
+			"password_hash": string(hashedPassword),
+			"updated_at":    time.Now(),
+		})
+		if err != nil {
+			return errors.ErrInternal
+		}
+
+		used, err := repos.Resets.MarkAsUsed(ctx, reset.ID)
+		if err != nil {
+			return errors.ErrInternal
+		}
+		if !used {
+			return errors.ErrConflict
+		}
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
 
-	return nil
+	// Sign out every device that used the old password
+	if err := u.sessionRepo.RevokeByUserID(ctx, reset.UserID); err != nil {
+		log.Printf("⚠️ Failed to revoke sessions after password reset for user %s: %v", reset.UserID, err)
+	}
+
+	return reset.UserID, nil
 }
 
 // ValidateResetToken validates a password reset token
 func (u *passwordResetUsecase) ValidateResetToken(ctx context.Context, token string) (*models.PasswordReset, error) {
-	tokenHash := hashToken(token)
+	tokenHash := utils.HashToken(token)
 
 	reset, err := u.passwordResetRepo.GetByTokenHash(ctx, tokenHash)
 	if err != nil {
@@ -105,20 +178,3 @@ func (u *passwordResetUsecase) ValidateResetToken(ctx context.Context, token str
 func (u *passwordResetUsecase) CleanupExpiredResets(ctx context.Context) error {
 	return u.passwordResetRepo.DeleteExpired(ctx)
 }
-
-// generateResetToken generates a secure random reset token
-func generateResetToken() (string, error) {
-	bytes := make([]byte, 32)
-	_, err := rand.Read(bytes)
-	if err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(bytes), nil
-}
-
-// hashToken hashes a token for secure storage
-func hashToken(token string) string {
-	// In a real implementation, you would use a proper hash function
-	// For now, we'll use a simple approach
-	return token // This should be replaced with proper hashing
-}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/password_reset/entity/database"
+	"github.com/yakka-backend/internal/features/auth/password_reset/models"
+	auth_user_db "github.com/yakka-backend/internal/features/auth/user/entity/database"
+	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
+	"gorm.io/gorm"
+)
+
+// resetStore holds one reset request and records the writes of a password reset in order
+type resetStore struct {
+	database.PasswordResetRepository
+	reset       *models.PasswordReset
+	usedByOther bool // another request marks the token used first
+	writes      []string
+	revoked     []uuid.UUID
+}
+
+func (s *resetStore) GetByTokenHash(ctx context.Context, tokenHash string) (*models.PasswordReset, error) {
+	if s.reset == nil || s.reset.TokenHash != tokenHash {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.reset, nil
+}
+
+func (s *resetStore) MarkAsUsed(ctx context.Context, id uuid.UUID) (bool, error) {
+	if s.usedByOther {
+		return false, nil
+	}
+	s.writes = append(s.writes, "token")
+	return true, nil
+}
+
+func (s *resetStore) RevokeByUserID(ctx context.Context, userID uuid.UUID) error {
+	s.revoked = append(s.revoked, userID)
+	return nil
+}
+
+// Do runs the reset against the store; the real unit of work rolls back on error
+func (s *resetStore) Do(ctx context.Context, fn func(repos database.PasswordResetTxRepositories) error) error {
+	return fn(database.PasswordResetTxRepositories{Resets: s, Users: storeUsers{store: s}})
+}
+
+// storeUsers writes passwords into the resetStore's log
+type storeUsers struct {
+	auth_user_db.UserRepository
+	store *resetStore
+}
+
+func (u storeUsers) UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error {
+	u.store.writes = append(u.store.writes, "password")
+	return nil
+}
+
+func TestResetPassword(t *testing.T) {
+	userID := uuid.New()
+	tests := []struct {
+		name        string
+		token       string
+		usedByOther bool
+		wantErr     error
+		wantWrites  []string
+		wantRevoked bool
+	}{
+		{"password then token", "reset-token", false, nil, []string{"password", "token"}, true},
+		{"token used by a concurrent request", "reset-token", true, errors.ErrConflict, []string{"password"}, false},
+		{"unknown token", "other-token", false, errors.ErrUnauthorized, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &resetStore{
+				reset:       &models.PasswordReset{ID: uuid.New(), UserID: userID, TokenHash: utils.HashToken("reset-token")},
+				usedByOther: tt.usedByOther,
+			}
+			u := NewPasswordResetUsecase(store, nil, store, store, nil, "")
+
+			gotUserID, err := u.ResetPassword(context.Background(), tt.token, "N3w-password!")
+			if err != tt.wantErr {
+				t.Fatalf("ResetPassword() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && gotUserID != userID {
+				t.Errorf("ResetPassword() user = %s, want %s", gotUserID, userID)
+			}
+			if len(store.writes) != len(tt.wantWrites) {
+				t.Fatalf("writes = %v, want %v", store.writes, tt.wantWrites)
+			}
+			for i := range tt.wantWrites {
+				if store.writes[i] != tt.wantWrites[i] {
+					t.Fatalf("writes = %v, want %v", store.writes, tt.wantWrites)
+				}
+			}
+			if revoked := len(store.revoked) == 1 && store.revoked[0] == userID; revoked != tt.wantRevoked {
+				t.Errorf("revoked sessions of %v, want revoked = %v", store.revoked, tt.wantRevoked)
+			}
+		})
+	}
+}
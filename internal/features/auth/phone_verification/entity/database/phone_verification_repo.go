@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/phone_verification/models"
+	"gorm.io/gorm"
+)
+
+// PhoneVerificationRepository defines the interface for phone verification data operations
+type PhoneVerificationRepository interface {
+	Create(ctx context.Context, verification *models.PhoneVerification) error
+	GetLatestPending(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error)
+	GetLatest(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error)
+	CountSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
+	IncrementAttempts(ctx context.Context, id uuid.UUID) error
+	MarkAsVerified(ctx context.Context, id uuid.UUID) error
+	DeleteExpired(ctx context.Context) error
+}
+
+// phoneVerificationRepository implements PhoneVerificationRepository
+type phoneVerificationRepository struct {
+	db *gorm.DB
+}
+
+// NewPhoneVerificationRepository creates a new phone verification repository
+func NewPhoneVerificationRepository(db *gorm.DB) PhoneVerificationRepository {
+	return &phoneVerificationRepository{db: db}
+}
+
+// Create creates a new phone verification request
+func (r *phoneVerificationRepository) Create(ctx context.Context, verification *models.PhoneVerification) error {
+	return r.db.WithContext(ctx).Create(verification).Error
+}
+
+// GetLatestPending retrieves the newest unverified, unexpired code of a user
+func (r *phoneVerificationRepository) GetLatestPending(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error) {
+	var verification models.PhoneVerification
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND verified_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("created_at DESC").
+		First(&verification).Error
+	if err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
+
+// GetLatest retrieves the newest code sent to a user
+func (r *phoneVerificationRepository) GetLatest(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error) {
+	var verification models.PhoneVerification
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").First(&verification).Error
+	if err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
+
+// CountSince counts the codes sent to a user after the given time
+func (r *phoneVerificationRepository) CountSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.PhoneVerification{}).
+		Where("user_id = ? AND created_at > ?", userID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// IncrementAttempts counts a wrong code against a verification
+func (r *phoneVerificationRepository) IncrementAttempts(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.PhoneVerification{}).
+		Where("id = ?", id).
+		Update("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// MarkAsVerified marks a phone verification as used
+func (r *phoneVerificationRepository) MarkAsVerified(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.PhoneVerification{}).Where("id = ?", id).Update("verified_at", time.Now()).Error
+}
+
+// DeleteExpired deletes all expired, unverified codes
+func (r *phoneVerificationRepository) DeleteExpired(ctx context.Context) error {
+	return r.db.WithContext(ctx).Where("expires_at < ? AND verified_at IS NULL", time.Now()).Delete(&models.PhoneVerification{}).Error
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PhoneVerification represents a one-time code sent by SMS to verify a phone number
+type PhoneVerification struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID     uuid.UUID  `json:"user_id" gorm:"not null;type:uuid;index"`
+	Phone      string     `json:"phone" gorm:"not null;size:32"`
+	CodeHash   string     `json:"-" gorm:"not null;type:text"`
+	Attempts   int        `json:"attempts" gorm:"not null;default:0"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null;type:timestamptz"`
+	VerifiedAt *time.Time `json:"verified_at" gorm:"type:timestamptz"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the PhoneVerification model
+func (PhoneVerification) TableName() string {
+	return "phone_verifications"
+}
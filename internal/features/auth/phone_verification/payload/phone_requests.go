@@ -0,0 +1,11 @@
+package payload
+
+// RequestPhoneVerificationRequest asks for a code, optionally for a new phone number
+type RequestPhoneVerificationRequest struct {
+	Phone *string `json:"phone,omitempty" validate:"omitempty,max=32"`
+}
+
+// VerifyPhoneRequest submits the code received by SMS
+type VerifyPhoneRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
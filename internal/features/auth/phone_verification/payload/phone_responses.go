@@ -0,0 +1,17 @@
+package payload
+
+import "time"
+
+// RequestPhoneVerificationResponse represents a phone verification request response
+type RequestPhoneVerificationResponse struct {
+	Message   string `json:"message"`
+	Phone     string `json:"phone"`
+	ExpiresIn int64  `json:"expires_in"`
+}
+
+// VerifyPhoneResponse represents a phone verification response
+type VerifyPhoneResponse struct {
+	Message         string    `json:"message"`
+	Phone           string    `json:"phone"`
+	PhoneVerifiedAt time.Time `json:"phone_verified_at"`
+}
@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/phone_verification/entity/database"
+	"github.com/yakka-backend/internal/features/auth/phone_verification/models"
+	userModels "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/sms"
+	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// phoneCodeTTL is how long an SMS code stays valid
+	phoneCodeTTL = 10 * time.Minute
+	// phoneCodeResendInterval is the minimum wait between two codes
+	phoneCodeResendInterval = time.Minute
+	// phoneCodesPerHour caps how many texts one user can trigger
+	phoneCodesPerHour = 5
+	// phoneCodeMaxAttempts is how many wrong guesses burn a code
+	phoneCodeMaxAttempts = 5
+)
+
+// PhoneVerificationUsecase defines the interface for phone verification operations
+type PhoneVerificationUsecase interface {
+	RequestPhoneVerification(ctx context.Context, userID uuid.UUID, phone *string) (string, error)
+	VerifyPhone(ctx context.Context, userID uuid.UUID, code string) (*userModels.User, error)
+	CodeTTL() time.Duration
+	CleanupExpiredVerifications(ctx context.Context) error
+}
+
+// UserRepository interface for reading and updating the phone of a user
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*userModels.User, error)
+	UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
+}
+
+// phoneVerificationUsecase implements PhoneVerificationUsecase
+type phoneVerificationUsecase struct {
+	phoneVerificationRepo database.PhoneVerificationRepository
+	userRepo              UserRepository
+	sender                sms.SMSSender
+}
+
+// NewPhoneVerificationUsecase creates a new phone verification usecase
+func NewPhoneVerificationUsecase(phoneVerificationRepo database.PhoneVerificationRepository, userRepo UserRepository, sender sms.SMSSender) PhoneVerificationUsecase {
+	return &phoneVerificationUsecase{
+		phoneVerificationRepo: phoneVerificationRepo,
+		userRepo:              userRepo,
+		sender:                sender,
+	}
+}
+
+// RequestPhoneVerification texts a one-time code to the phone of the user. When a phone is
+// given it replaces the current one first. Returns the number the code was sent to.
+func (u *phoneVerificationUsecase) RequestPhoneVerification(ctx context.Context, userID uuid.UUID, phone *string) (string, error) {
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", errors.ErrNotFound
+	}
+
+	if phone != nil {
+		normalized, ok := normalizePhone(*phone)
+		if !ok {
+			return "", errors.ErrBadRequest
+		}
+		if user.SetPhone(&normalized) {
+			updates := map[string]interface{}{
+				"phone":             normalized,
+				"phone_verified_at": nil,
+			}
+			if err := u.userRepo.UpdateSpecificFields(ctx, userID, updates); err != nil {
+				return "", errors.ErrInternal
+			}
+		}
+	}
+
+	if user.Phone == nil {
+		return "", errors.ErrBadRequest
+	}
+	normalized, ok := normalizePhone(*user.Phone)
+	if !ok {
+		return "", errors.ErrBadRequest
+	}
+	if user.PhoneVerifiedAt != nil && *user.Phone == normalized {
+		return "", errors.ErrConflict
+	}
+
+	if err := u.checkSendLimits(ctx, userID); err != nil {
+		return "", err
+	}
+
+	code, err := utils.GenerateNumericCode(6)
+	if err != nil {
+		return "", errors.ErrInternal
+	}
+
+	now := time.Now()
+	verification := &models.PhoneVerification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Phone:     normalized,
+		CodeHash:  hashPhoneCode(normalized, code),
+		ExpiresAt: now.Add(phoneCodeTTL),
+		CreatedAt: now,
+	}
+	if err := u.phoneVerificationRepo.Create(ctx, verification); err != nil {
+		return "", errors.ErrInternal
+	}
+
+	err = u.sender.Send(ctx, sms.Message{
+		To:   normalized,
+		Body: fmt.Sprintf("Your Yakka verification code is %s. It expires in %d minutes.", code, int(phoneCodeTTL.Minutes())),
+	})
+	if err != nil {
+		log.Printf("❌ Failed to send phone verification SMS: %v", err)
+		return "", errors.ErrInternal
+	}
+
+	log.Printf("📱 Phone verification code sent to user %s", userID)
+	return normalized, nil
+}
+
+// VerifyPhone checks the latest code sent to the user and marks the phone as verified
+func (u *phoneVerificationUsecase) VerifyPhone(ctx context.Context, userID uuid.UUID, code string) (*userModels.User, error) {
+	verification, err := u.phoneVerificationRepo.GetLatestPending(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrNotFound
+		}
+		return nil, errors.ErrInternal
+	}
+	if verification.Attempts >= phoneCodeMaxAttempts {
+		return nil, errors.ErrNotFound
+	}
+
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+	// The code only proves the number it was sent to
+	if user.Phone == nil {
+		return nil, errors.ErrNotFound
+	}
+	if current, ok := normalizePhone(*user.Phone); !ok || current != verification.Phone {
+		return nil, errors.ErrNotFound
+	}
+
+	expected := hashPhoneCode(verification.Phone, strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(verification.CodeHash)) != 1 {
+		if err := u.phoneVerificationRepo.IncrementAttempts(ctx, verification.ID); err != nil {
+			log.Printf("⚠️ Failed to count phone verification attempt: %v", err)
+		}
+		return nil, errors.ErrUnauthorized
+	}
+
+	if err := u.phoneVerificationRepo.MarkAsVerified(ctx, verification.ID); err != nil {
+		return nil, errors.ErrInternal
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"phone":             verification.Phone,
+		"phone_verified_at": now,
+	}
+	if err := u.userRepo.UpdateSpecificFields(ctx, userID, updates); err != nil {
+		return nil, errors.ErrInternal
+	}
+	user.Phone = &verification.Phone
+	user.PhoneVerifiedAt = &now
+
+	log.Printf("✅ Phone verified for user %s", userID)
+	return user, nil
+}
+
+// CodeTTL returns how long a code stays valid
+func (u *phoneVerificationUsecase) CodeTTL() time.Duration {
+	return phoneCodeTTL
+}
+
+// CleanupExpiredVerifications removes expired codes
+func (u *phoneVerificationUsecase) CleanupExpiredVerifications(ctx context.Context) error {
+	return u.phoneVerificationRepo.DeleteExpired(ctx)
+}
+
+// checkSendLimits enforces the resend interval and the hourly cap, since every text costs money
+func (u *phoneVerificationUsecase) checkSendLimits(ctx context.Context, userID uuid.UUID) error {
+	now := time.Now()
+
+	latest, err := u.phoneVerificationRepo.GetLatest(ctx, userID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.ErrInternal
+	}
+	if err == nil && now.Sub(latest.CreatedAt) < phoneCodeResendInterval {
+		return errors.ErrTooManyRequests
+	}
+
+	sent, err := u.phoneVerificationRepo.CountSince(ctx, userID, now.Add(-time.Hour))
+	if err != nil {
+		return errors.ErrInternal
+	}
+	if sent >= phoneCodesPerHour {
+		return errors.ErrTooManyRequests
+	}
+	return nil
+}
+
+// normalizePhone strips formatting and checks the number is in E.164 format
+func normalizePhone(phone string) (string, bool) {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(phone) {
+		switch {
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return "", false
+		}
+	}
+	normalized := b.String()
+	digits := strings.TrimPrefix(normalized, "+")
+	if digits == normalized || len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", false
+	}
+	return normalized, true
+}
+
+// hashPhoneCode binds a code to the number it was sent to before hashing it
+func hashPhoneCode(phone, code string) string {
+	hash := sha256.Sum256([]byte(phone + ":" + code))
+	return hex.EncodeToString(hash[:])
+}
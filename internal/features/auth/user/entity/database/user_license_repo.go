@@ -12,6 +12,7 @@ type UserLicenseRepository interface {
 	Create(ctx context.Context, license *models.UserLicense) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.UserLicense, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserLicense, error)
+	GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.UserLicense, error)
 	Update(ctx context.Context, license *models.UserLicense) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
@@ -39,6 +39,18 @@ func (r *userLicenseRepository) GetByUserID(ctx context.Context, userID uuid.UUI
 	return licenses, nil
 }
 
+func (r *userLicenseRepository) GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.UserLicense, error) {
+	var licenses []*models.UserLicense
+	if len(userIDs) == 0 {
+		return licenses, nil
+	}
+	err := r.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&licenses).Error
+	if err != nil {
+		return nil, err
+	}
+	return licenses, nil
+}
+
 func (r *userLicenseRepository) Update(ctx context.Context, license *models.UserLicense) error {
 	return r.db.WithContext(ctx).Save(license).Error
 }
@@ -12,6 +12,7 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *models.User) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.User, error)
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
 	UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
@@ -19,6 +20,7 @@ type UserRepository interface {
 	UpdateLastLogin(ctx context.Context, id uuid.UUID) error
 	UpdateRole(ctx context.Context, id uuid.UUID, role models.UserRole) error
 	UpdateUserStatus(ctx context.Context, id uuid.UUID, status string) error
+	Search(ctx context.Context, query string, status *models.UserStatus, role *models.UserRole, page, limit int) ([]*models.User, int64, error)
 }
 
 // userRepository implements UserRepository
@@ -46,6 +48,19 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Use
 	return &user, nil
 }
 
+// GetByIDs retrieves users by ID, in no particular order
+func (r *userRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.User, error) {
+	var users []*models.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 // GetByEmail retrieves a user by email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
@@ -88,3 +103,30 @@ func (r *userRepository) UpdateRole(ctx context.Context, id uuid.UUID, role mode
 func (r *userRepository) UpdateUserStatus(ctx context.Context, id uuid.UUID, status string) error {
 	return r.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", id).Update("status", status).Error
 }
+
+// Search retrieves users matching a free text query (email, name or phone) and optional filters
+func (r *userRepository) Search(ctx context.Context, query string, status *models.UserStatus, role *models.UserRole, page, limit int) ([]*models.User, int64, error) {
+	var users []*models.User
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&models.User{})
+
+	if query != "" {
+		like := "%" + query + "%"
+		db = db.Where("email ILIKE ? OR first_name ILIKE ? OR last_name ILIKE ? OR phone ILIKE ?", like, like, like, like)
+	}
+	if status != nil {
+		db = db.Where("status = ?", *status)
+	}
+	if role != nil {
+		db = db.Where("role = ?", *role)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := db.Offset(offset).Limit(limit).Order("created_at DESC").Find(&users).Error
+	return users, total, err
+}
@@ -10,10 +10,11 @@ import (
 type UserStatus string
 
 const (
-	UserStatusActive   UserStatus = "active"
-	UserStatusInactive UserStatus = "inactive"
-	UserStatusPending  UserStatus = "pending"
-	UserStatusBanned   UserStatus = "banned"
+	UserStatusActive    UserStatus = "active"
+	UserStatusInactive  UserStatus = "inactive"
+	UserStatusPending   UserStatus = "pending"
+	UserStatusBanned    UserStatus = "banned"
+	UserStatusSuspended UserStatus = "suspended"
 )
 
 // UserRole represents the role of a user
@@ -28,23 +29,35 @@ const (
 
 // User represents a user in the system
 type User struct {
-	ID            uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Email         string     `json:"email" gorm:"uniqueIndex;not null;size:255"`
-	Phone         *string    `json:"phone" gorm:"size:32"`
-	PasswordHash  string     `json:"-" gorm:"not null;type:text"`
-	FirstName     *string    `json:"first_name" gorm:"size:120"`
-	LastName      *string    `json:"last_name" gorm:"size:120"`
-	Address       *string    `json:"address" gorm:"type:text"`
-	Photo         *string    `json:"photo" gorm:"type:text"`
-	Status        UserStatus `json:"status" gorm:"not null;type:user_status"`
-	LastLoginAt   *time.Time `json:"last_login_at" gorm:"type:timestamptz"`
-	CreatedAt     time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
-	UpdatedAt     time.Time  `json:"updated_at" gorm:"not null;type:timestamptz"`
-	Role          UserRole   `json:"role" gorm:"not null;type:user_role"`
-	RoleChangedAt *time.Time `json:"role_changed_at" gorm:"type:timestamptz"`
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Email           string     `json:"email" gorm:"uniqueIndex;not null;size:255"`
+	Phone           *string    `json:"phone" gorm:"size:32"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at" gorm:"type:timestamptz"`
+	PasswordHash    string     `json:"-" gorm:"not null;type:text"`
+	FirstName       *string    `json:"first_name" gorm:"size:120"`
+	LastName        *string    `json:"last_name" gorm:"size:120"`
+	Address         *string    `json:"address" gorm:"type:text"`
+	Photo           *string    `json:"photo" gorm:"type:text"`
+	Status          UserStatus `json:"status" gorm:"not null;type:user_status"`
+	LastLoginAt     *time.Time `json:"last_login_at" gorm:"type:timestamptz"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"not null;type:timestamptz"`
+	UpdatedAt       time.Time  `json:"updated_at" gorm:"not null;type:timestamptz"`
+	Role            UserRole   `json:"role" gorm:"not null;type:user_role"`
+	RoleChangedAt   *time.Time `json:"role_changed_at" gorm:"type:timestamptz"`
 }
 
 // TableName returns the table name for the User model
 func (User) TableName() string {
 	return "users"
 }
+
+// SetPhone changes the phone number, clearing its verification when the number differs.
+// It reports whether the number changed.
+func (u *User) SetPhone(phone *string) bool {
+	if u.Phone != nil && phone != nil && *u.Phone == *phone {
+		return false
+	}
+	u.Phone = phone
+	u.PhoneVerifiedAt = nil
+	return true
+}
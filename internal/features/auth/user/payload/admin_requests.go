@@ -0,0 +1,21 @@
+package payload
+
+// AdminSearchUsersRequest represents the filters of the admin user search
+type AdminSearchUsersRequest struct {
+	Query  string  `json:"q"`
+	Status *string `json:"status" validate:"omitempty,oneof=active inactive pending banned suspended"`
+	Role   *string `json:"role" validate:"omitempty,oneof=admin user builder labour"`
+	Page   int     `json:"page" validate:"min=1"`
+	Limit  int     `json:"limit" validate:"min=1,max=100"`
+}
+
+// AdminUpdateUserStatusRequest represents an admin status change (ban, suspend, reactivate)
+type AdminUpdateUserStatusRequest struct {
+	Status string  `json:"status" validate:"required,oneof=active inactive banned suspended"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+// AdminUpdateUserRoleRequest represents an admin role change
+type AdminUpdateUserRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin user builder labour"`
+}
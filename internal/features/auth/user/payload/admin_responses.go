@@ -0,0 +1,16 @@
+package payload
+
+// AdminUserListResponse represents a page of users for the admin API
+type AdminUserListResponse struct {
+	Users      []UserResponse `json:"users"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	Limit      int            `json:"limit"`
+	TotalPages int            `json:"total_pages"`
+}
+
+// AdminActionResponse represents the result of an admin action on a user
+type AdminActionResponse struct {
+	Message string        `json:"message"`
+	User    *UserResponse `json:"user,omitempty"`
+}
@@ -28,3 +28,8 @@ type UpdateUserRequest struct {
 	Address   *string `json:"address,omitempty"`
 	Photo     *string `json:"photo,omitempty"`
 }
+
+// UnlockAccountRequest represents an account unlock request
+type UnlockAccountRequest struct {
+	Token string `json:"token" validate:"required"`
+}
@@ -4,19 +4,20 @@ import "time"
 
 // UserResponse represents a user response
 type UserResponse struct {
-	ID            string     `json:"id"`
-	Email         string     `json:"email"`
-	Phone         *string    `json:"phone"`
-	FirstName     *string    `json:"first_name"`
-	LastName      *string    `json:"last_name"`
-	Address       *string    `json:"address"`
-	Photo         *string    `json:"photo"`
-	Status        string     `json:"status"`
-	Role          string     `json:"role"`
-	LastLoginAt   *time.Time `json:"last_login_at"`
-	CreatedAt     time.Time  `json:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at"`
-	RoleChangedAt *time.Time `json:"role_changed_at"`
+	ID              string     `json:"id"`
+	Email           string     `json:"email"`
+	Phone           *string    `json:"phone"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at,omitempty"`
+	FirstName       *string    `json:"first_name"`
+	LastName        *string    `json:"last_name"`
+	Address         *string    `json:"address"`
+	Photo           *string    `json:"photo"`
+	Status          string     `json:"status"`
+	Role            string     `json:"role"`
+	LastLoginAt     *time.Time `json:"last_login_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	RoleChangedAt   *time.Time `json:"role_changed_at"`
 }
 
 // LoginResponse represents a login response
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/user/entity/database"
+	"github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/features/auth/user/payload"
+	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// AdminUserUsecase defines the interface for support/admin operations on users
+type AdminUserUsecase interface {
+	SearchUsers(ctx context.Context, req payload.AdminSearchUsersRequest) ([]*models.User, int64, error)
+	GetUser(ctx context.Context, userID uuid.UUID) (*models.User, error)
+	UpdateUserStatus(ctx context.Context, adminID, userID uuid.UUID, status models.UserStatus, reason *string) (*models.User, error)
+	UpdateUserRole(ctx context.Context, adminID, userID uuid.UUID, role models.UserRole) (*models.User, error)
+	ForcePasswordReset(ctx context.Context, adminID, userID uuid.UUID) error
+	RevokeUserSessions(ctx context.Context, adminID, userID uuid.UUID) error
+}
+
+// SessionRevoker revokes every session of a user
+type SessionRevoker interface {
+	RevokeByUserID(ctx context.Context, userID uuid.UUID) error
+}
+
+// PasswordResetRequester emails a password reset link
+type PasswordResetRequester interface {
+	RequestPasswordReset(ctx context.Context, email string) error
+}
+
+// adminUserUsecase implements AdminUserUsecase
+type adminUserUsecase struct {
+	userRepo       database.UserRepository
+	sessionRepo    SessionRevoker
+	passwordResets PasswordResetRequester
+}
+
+// NewAdminUserUsecase creates a new admin user usecase
+func NewAdminUserUsecase(userRepo database.UserRepository, sessionRepo SessionRevoker, passwordResets PasswordResetRequester) AdminUserUsecase {
+	return &adminUserUsecase{
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		passwordResets: passwordResets,
+	}
+}
+
+// SearchUsers searches users by email, name or phone with optional status and role filters
+func (u *adminUserUsecase) SearchUsers(ctx context.Context, req payload.AdminSearchUsersRequest) ([]*models.User, int64, error) {
+	var status *models.UserStatus
+	if req.Status != nil {
+		s := models.UserStatus(*req.Status)
+		status = &s
+	}
+	var role *models.UserRole
+	if req.Role != nil {
+		r := models.UserRole(*req.Role)
+		role = &r
+	}
+
+	users, total, err := u.userRepo.Search(ctx, req.Query, status, role, req.Page, req.Limit)
+	if err != nil {
+		return nil, 0, errors.ErrInternal
+	}
+	return users, total, nil
+}
+
+// GetUser retrieves a user by ID
+func (u *adminUserUsecase) GetUser(ctx context.Context, userID uuid.UUID) (*models.User, error) {
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+	return user, nil
+}
+
+// UpdateUserStatus bans, suspends or reactivates a user. Leaving the active status
+// revokes every session of the user.
+func (u *adminUserUsecase) UpdateUserStatus(ctx context.Context, adminID, userID uuid.UUID, status models.UserStatus, reason *string) (*models.User, error) {
+	if adminID == userID {
+		return nil, errors.ErrForbidden
+	}
+
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	if err := u.userRepo.UpdateUserStatus(ctx, userID, string(status)); err != nil {
+		return nil, errors.ErrInternal
+	}
+
+	if status != models.UserStatusActive {
+		if err := u.sessionRepo.RevokeByUserID(ctx, userID); err != nil {
+			return nil, errors.ErrInternal
+		}
+	}
+
+	log.Printf("🛡️ Admin %s changed status of user %s from %s to %s (reason: %s)", adminID, userID, user.Status, status, utils.StringValue(reason))
+
+	user.Status = status
+	return user, nil
+}
+
+// UpdateUserRole changes the role of a user and records when it changed
+func (u *adminUserUsecase) UpdateUserRole(ctx context.Context, adminID, userID uuid.UUID, role models.UserRole) (*models.User, error) {
+	if adminID == userID {
+		return nil, errors.ErrForbidden
+	}
+
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrNotFound
+	}
+
+	if user.Role == role {
+		return user, nil
+	}
+
+	now := time.Now()
+	err = u.userRepo.UpdateSpecificFields(ctx, userID, map[string]interface{}{
+		"role":            role,
+		"role_changed_at": now,
+		"updated_at":      now,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal
+	}
+
+	log.Printf("🛡️ Admin %s changed role of user %s from %s to %s", adminID, userID, user.Role, role)
+
+	user.Role = role
+	user.RoleChangedAt = &now
+	return user, nil
+}
+
+// ForcePasswordReset invalidates the current password, signs the user out everywhere
+// and emails a password reset link
+func (u *adminUserUsecase) ForcePasswordReset(ctx context.Context, adminID, userID uuid.UUID) error {
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.ErrNotFound
+	}
+
+	// Replace the password with a random one nobody knows
+	randomPassword := make([]byte, 32)
+	if _, err := rand.Read(randomPassword); err != nil {
+		return errors.ErrInternal
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(randomPassword)), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.ErrInternal
+	}
+	err = u.userRepo.UpdateSpecificFields(ctx, userID, map[string]interface{}{
+		"password_hash": string(hashedPassword),
+		"updated_at":    time.Now(),
+	})
+	if err != nil {
+		return errors.ErrInternal
+	}
+
+	if err := u.sessionRepo.RevokeByUserID(ctx, userID); err != nil {
+		return errors.ErrInternal
+	}
+
+	if err := u.passwordResets.RequestPasswordReset(ctx, user.Email); err != nil {
+		return errors.ErrInternal
+	}
+
+	log.Printf("🛡️ Admin %s forced a password reset for user %s", adminID, userID)
+	return nil
+}
+
+// RevokeUserSessions signs a user out of every device
+func (u *adminUserUsecase) RevokeUserSessions(ctx context.Context, adminID, userID uuid.UUID) error {
+	if _, err := u.userRepo.GetByID(ctx, userID); err != nil {
+		return errors.ErrNotFound
+	}
+
+	if err := u.sessionRepo.RevokeByUserID(ctx, userID); err != nil {
+		return errors.ErrInternal
+	}
+
+	log.Printf("🛡️ Admin %s revoked all sessions of user %s", adminID, userID)
+	return nil
+}
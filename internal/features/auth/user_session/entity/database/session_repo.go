@@ -20,6 +20,9 @@ type SessionRepository interface {
 	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
 	DeleteExpired(ctx context.Context) error
 	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeByUserID(ctx context.Context, userID uuid.UUID) error
+	Rotate(ctx context.Context, session *models.Session, oldTokenHash string) (bool, error)
+	GetByRotatedToken(ctx context.Context, tokenHash string) (*models.Session, error)
 }
 
 // sessionRepository implements SessionRepository
@@ -47,10 +50,13 @@ func (r *sessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.
 	return &session, nil
 }
 
-// GetByUserID retrieves all sessions for a user
+// GetByUserID retrieves all active sessions for a user, newest first
 func (r *sessionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Session, error) {
 	var sessions []*models.Session
-	err := r.db.WithContext(ctx).Where("user_id = ? AND revoked_at IS NULL", userID).Find(&sessions).Error
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("created_at DESC").
+		Find(&sessions).Error
 	return sessions, err
 }
 
@@ -81,10 +87,59 @@ func (r *sessionRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID
 
 // DeleteExpired deletes all expired sessions
 func (r *sessionRepository) DeleteExpired(ctx context.Context) error {
-	return r.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&models.Session{}).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&models.Session{}).Select("id").Where("expires_at < ?", time.Now())
+		if err := tx.Where("session_id IN (?)", expired).Delete(&models.RotatedRefreshToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("expires_at < ?", time.Now()).Delete(&models.Session{}).Error
+	})
 }
 
 // Revoke revokes a session
 func (r *sessionRepository) Revoke(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Model(&models.Session{}).Where("id = ?", id).Update("revoked_at", time.Now()).Error
+	return r.db.WithContext(ctx).Model(&models.Session{}).Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", time.Now()).Error
+}
+
+// RevokeByUserID revokes all active sessions for a user
+func (r *sessionRepository) RevokeByUserID(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Session{}).Where("user_id = ? AND revoked_at IS NULL", userID).Update("revoked_at", time.Now()).Error
+}
+
+// Rotate swaps the refresh token of a session and records the old hash.
+// It returns false when the old token was already rotated by a concurrent request.
+func (r *sessionRepository) Rotate(ctx context.Context, session *models.Session, oldTokenHash string) (bool, error) {
+	rotated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Session{}).
+			Where("id = ? AND refresh_token_hash = ? AND revoked_at IS NULL", session.ID, oldTokenHash).
+			Updates(map[string]interface{}{
+				"refresh_token_hash": session.RefreshTokenHash,
+				"expires_at":         session.ExpiresAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		rotated = true
+		return tx.Create(&models.RotatedRefreshToken{
+			SessionID: session.ID,
+			TokenHash: oldTokenHash,
+			RotatedAt: time.Now(),
+		}).Error
+	})
+	return rotated, err
+}
+
+// GetByRotatedToken retrieves the session a previously rotated refresh token belonged to
+func (r *sessionRepository) GetByRotatedToken(ctx context.Context, tokenHash string) (*models.Session, error) {
+	var rotated models.RotatedRefreshToken
+	err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&rotated).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, rotated.SessionID)
 }
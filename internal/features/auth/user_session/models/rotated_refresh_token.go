@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RotatedRefreshToken keeps the hash of a refresh token that has already been
+// exchanged, so a replayed token can be traced back to its session family
+type RotatedRefreshToken struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SessionID uuid.UUID `json:"session_id" gorm:"not null;type:uuid;index"`
+	TokenHash string    `json:"-" gorm:"not null;type:text;uniqueIndex"`
+	RotatedAt time.Time `json:"rotated_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the RotatedRefreshToken model
+func (RotatedRefreshToken) TableName() string {
+	return "session_rotated_tokens"
+}
@@ -5,7 +5,9 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
 }
 
-// LogoutRequest represents a logout request
+// LogoutRequest represents a logout request.
+// Without a refresh token or session ID the current session is revoked.
 type LogoutRequest struct {
-	SessionID *string `json:"session_id,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	SessionID    *string `json:"session_id,omitempty"`
 }
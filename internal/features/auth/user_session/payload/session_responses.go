@@ -1,5 +1,7 @@
 package payload
 
+import "time"
+
 // RefreshTokenResponse represents a refresh token response
 type RefreshTokenResponse struct {
 	AccessToken  string `json:"access_token"`
@@ -11,3 +13,19 @@ type RefreshTokenResponse struct {
 type LogoutResponse struct {
 	Message string `json:"message"`
 }
+
+// SessionResponse represents an active session (device) of the user
+type SessionResponse struct {
+	ID        string    `json:"id"`
+	UserAgent *string   `json:"user_agent"`
+	IPAddress *string   `json:"ip_address"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Current   bool      `json:"current"`
+}
+
+// SessionListResponse represents the list of active sessions
+type SessionListResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+	Total    int               `json:"total"`
+}
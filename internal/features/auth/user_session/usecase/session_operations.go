@@ -2,26 +2,49 @@ package usecase
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/yakka-backend/internal/features/auth/user_session/entity/database"
 	"github.com/yakka-backend/internal/features/auth/user_session/models"
 	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
 )
 
+// TokenReuseError is returned by RefreshSession when a refresh token that was already
+// rotated is replayed. The session it belonged to has been revoked, so the caller must
+// drop the user's cached principals.
+type TokenReuseError struct {
+	UserID uuid.UUID
+}
+
+// Error implements the error interface, reading like ErrUnauthorized
+func (e *TokenReuseError) Error() string {
+	return errors.ErrUnauthorized.Error()
+}
+
+// Unwrap lets errors.Is match ErrUnauthorized
+func (e *TokenReuseError) Unwrap() error {
+	return errors.ErrUnauthorized
+}
+
 // SessionUsecase defines the interface for session operations
 type SessionUsecase interface {
 	CreateSession(ctx context.Context, userID uuid.UUID, userAgent, ipAddress string) (*models.Session, string, error)
 	RefreshSession(ctx context.Context, refreshToken string) (*models.Session, string, error)
 	GetSession(ctx context.Context, sessionID uuid.UUID) (*models.Session, error)
+	ListUserSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error)
 	RevokeSession(ctx context.Context, sessionID uuid.UUID) error
+	RevokeUserSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	RevokeSessionByRefreshToken(ctx context.Context, userID uuid.UUID, refreshToken string) error
 	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) error
 	CleanupExpiredSessions(ctx context.Context) error
 }
 
+// sessionTTL is how long a refresh token stays valid after it is issued or rotated
+const sessionTTL = 7 * 24 * time.Hour
+
 // sessionUsecase implements SessionUsecase
 type sessionUsecase struct {
 	sessionRepo database.SessionRepository
@@ -37,20 +60,24 @@ func NewSessionUsecase(sessionRepo database.SessionRepository) SessionUsecase {
 // CreateSession creates a new session for a user
 func (u *sessionUsecase) CreateSession(ctx context.Context, userID uuid.UUID, userAgent, ipAddress string) (*models.Session, string, error) {
 	// Generate refresh token
-	refreshToken, err := generateRefreshToken()
+	refreshToken, err := utils.GenerateToken()
 	if err != nil {
 		return nil, "", errors.ErrInternal
 	}
 
 	// Hash the token for storage
-	tokenHash := hashToken(refreshToken)
+	tokenHash := utils.HashToken(refreshToken)
+
+	// Keep client metadata within the column limits
+	userAgent = utils.Truncate(userAgent, 255)
+	ipAddress = utils.Truncate(ipAddress, 45)
 
 	// Create session
 	session := &models.Session{
 		ID:               uuid.New(),
 		UserID:           userID,
 		RefreshTokenHash: tokenHash,
-		ExpiresAt:        time.Now().Add(7 * 24 * time.Hour), // 7 days
+		ExpiresAt:        time.Now().Add(sessionTTL),
 		UserAgent:        &userAgent,
 		IPAddress:        &ipAddress,
 		CreatedAt:        time.Now(),
@@ -64,35 +91,55 @@ func (u *sessionUsecase) CreateSession(ctx context.Context, userID uuid.UUID, us
 	return session, refreshToken, nil
 }
 
-// RefreshSession refreshes a session using a refresh token
+// RefreshSession rotates the refresh token of a session. Presenting a token that
+// was already rotated revokes the whole session family.
 func (u *sessionUsecase) RefreshSession(ctx context.Context, refreshToken string) (*models.Session, string, error) {
 	// Hash the token to find the session
-	tokenHash := hashToken(refreshToken)
+	tokenHash := utils.HashToken(refreshToken)
 
 	// Get session by token hash
 	session, err := u.sessionRepo.GetByRefreshToken(ctx, tokenHash)
 	if err != nil {
-		return nil, "", errors.ErrUnauthorized
+		return nil, "", u.detectTokenReuse(ctx, tokenHash)
 	}
 
 	// Generate new refresh token
-	newRefreshToken, err := generateRefreshToken()
+	newRefreshToken, err := utils.GenerateToken()
 	if err != nil {
 		return nil, "", errors.ErrInternal
 	}
 
 	// Update session with new token
-	session.RefreshTokenHash = hashToken(newRefreshToken)
-	session.ExpiresAt = time.Now().Add(7 * 24 * time.Hour) // 7 days
+	session.RefreshTokenHash = utils.HashToken(newRefreshToken)
+	session.ExpiresAt = time.Now().Add(sessionTTL)
 
-	err = u.sessionRepo.Update(ctx, session)
+	rotated, err := u.sessionRepo.Rotate(ctx, session, tokenHash)
 	if err != nil {
 		return nil, "", errors.ErrInternal
 	}
+	if !rotated {
+		// Another request exchanged this token first
+		return nil, "", u.detectTokenReuse(ctx, tokenHash)
+	}
 
 	return session, newRefreshToken, nil
 }
 
+// detectTokenReuse revokes the session family when an already rotated token is replayed.
+// It returns a *TokenReuseError when it revoked a session, ErrUnauthorized otherwise.
+func (u *sessionUsecase) detectTokenReuse(ctx context.Context, tokenHash string) error {
+	session, err := u.sessionRepo.GetByRotatedToken(ctx, tokenHash)
+	if err != nil {
+		return errors.ErrUnauthorized
+	}
+
+	log.Printf("🚨 Refresh token reuse detected for session %s (user %s), revoking session", session.ID, session.UserID)
+	if err := u.sessionRepo.Revoke(ctx, session.ID); err != nil {
+		log.Printf("❌ Failed to revoke session %s after token reuse: %v", session.ID, err)
+	}
+	return &TokenReuseError{UserID: session.UserID}
+}
+
 // GetSession retrieves a session by ID
 func (u *sessionUsecase) GetSession(ctx context.Context, sessionID uuid.UUID) (*models.Session, error) {
 	session, err := u.sessionRepo.GetByID(ctx, sessionID)
@@ -102,34 +149,50 @@ func (u *sessionUsecase) GetSession(ctx context.Context, sessionID uuid.UUID) (*
 	return session, nil
 }
 
+// ListUserSessions retrieves the active sessions of a user
+func (u *sessionUsecase) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]*models.Session, error) {
+	sessions, err := u.sessionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrInternal
+	}
+	return sessions, nil
+}
+
 // RevokeSession revokes a specific session
 func (u *sessionUsecase) RevokeSession(ctx context.Context, sessionID uuid.UUID) error {
 	return u.sessionRepo.Revoke(ctx, sessionID)
 }
 
+// RevokeUserSession revokes a session only if it belongs to the given user
+func (u *sessionUsecase) RevokeUserSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	session, err := u.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return errors.ErrNotFound
+	}
+	if err := u.sessionRepo.Revoke(ctx, session.ID); err != nil {
+		return errors.ErrInternal
+	}
+	return nil
+}
+
+// RevokeSessionByRefreshToken revokes the user's session that owns the given refresh token
+func (u *sessionUsecase) RevokeSessionByRefreshToken(ctx context.Context, userID uuid.UUID, refreshToken string) error {
+	session, err := u.sessionRepo.GetByRefreshToken(ctx, utils.HashToken(refreshToken))
+	if err != nil || session.UserID != userID {
+		return errors.ErrNotFound
+	}
+	if err := u.sessionRepo.Revoke(ctx, session.ID); err != nil {
+		return errors.ErrInternal
+	}
+	return nil
+}
+
 // RevokeAllUserSessions revokes all sessions for a user
 func (u *sessionUsecase) RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) error {
-	return u.sessionRepo.DeleteByUserID(ctx, userID)
+	return u.sessionRepo.RevokeByUserID(ctx, userID)
 }
 
 // CleanupExpiredSessions removes all expired sessions
 func (u *sessionUsecase) CleanupExpiredSessions(ctx context.Context) error {
 	return u.sessionRepo.DeleteExpired(ctx)
 }
-
-// generateRefreshToken generates a secure random refresh token
-func generateRefreshToken() (string, error) {
-	bytes := make([]byte, 32)
-	_, err := rand.Read(bytes)
-	if err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(bytes), nil
-}
-
-// hashToken hashes a token for secure storage
-func hashToken(token string) string {
-	// In a real implementation, you would use a proper hash function
-	// For now, we'll use a simple approach
-	return token // This should be replaced with proper hashing
-}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/user_session/entity/database"
+	"github.com/yakka-backend/internal/features/auth/user_session/models"
+	"github.com/yakka-backend/internal/shared/errors"
+	"github.com/yakka-backend/internal/shared/utils"
+	"gorm.io/gorm"
+)
+
+// rotatedSessions knows sessions only by refresh tokens they have already rotated away from
+type rotatedSessions struct {
+	database.SessionRepository
+	byRotatedToken map[string]*models.Session
+	revoked        []uuid.UUID
+}
+
+func (r *rotatedSessions) GetByRefreshToken(ctx context.Context, tokenHash string) (*models.Session, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *rotatedSessions) GetByRotatedToken(ctx context.Context, tokenHash string) (*models.Session, error) {
+	session, ok := r.byRotatedToken[tokenHash]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return session, nil
+}
+
+func (r *rotatedSessions) Revoke(ctx context.Context, id uuid.UUID) error {
+	r.revoked = append(r.revoked, id)
+	return nil
+}
+
+func TestRefreshSessionTokenReuse(t *testing.T) {
+	session := &models.Session{ID: uuid.New(), UserID: uuid.New()}
+	repo := &rotatedSessions{byRotatedToken: map[string]*models.Session{utils.HashToken("rotated-token"): session}}
+	u := NewSessionUsecase(repo)
+
+	t.Run("replayed token", func(t *testing.T) {
+		_, _, err := u.RefreshSession(context.Background(), "rotated-token")
+
+		var reuseErr *TokenReuseError
+		if !stderrors.As(err, &reuseErr) {
+			t.Fatalf("RefreshSession() error = %v, want *TokenReuseError", err)
+		}
+		if reuseErr.UserID != session.UserID {
+			t.Errorf("UserID = %s, want %s", reuseErr.UserID, session.UserID)
+		}
+		if !stderrors.Is(err, errors.ErrUnauthorized) || err.Error() != "Unauthorized" {
+			t.Errorf("error = %v, want it to read as ErrUnauthorized", err)
+		}
+		if len(repo.revoked) != 1 || repo.revoked[0] != session.ID {
+			t.Errorf("revoked %v, want [%s]", repo.revoked, session.ID)
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, _, err := u.RefreshSession(context.Background(), "never-issued")
+		if err != errors.ErrUnauthorized {
+			t.Errorf("RefreshSession() error = %v, want ErrUnauthorized", err)
+		}
+	})
+}
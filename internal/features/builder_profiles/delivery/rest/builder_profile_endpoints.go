@@ -87,6 +87,9 @@ func (h *BuilderProfileHandler) CreateBuilderProfile(w http.ResponseWriter, r *h
 		return
 	}
 
+	// The user now has a new role and profile
+	middleware.InvalidatePrincipal(userID)
+
 	// Convert to response
 	profileResp := payload.BuilderProfileResponse{
 		ID:          profile.ID.String(),
@@ -111,6 +114,7 @@ func (h *BuilderProfileHandler) CreateBuilderProfile(w http.ResponseWriter, r *h
 				Name:        profile.Company.Name,
 				Description: profile.Company.Description,
 				Website:     profile.Company.Website,
+				RequireMFA:  profile.Company.RequireMFA,
 			}
 		}
 	}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/yakka-backend/internal/features/builder_profiles/models"
 	"github.com/yakka-backend/internal/features/builder_profiles/payload"
 	"github.com/yakka-backend/internal/features/builder_profiles/usecase"
 	"github.com/yakka-backend/internal/infrastructure/http/middleware"
@@ -57,6 +59,7 @@ func (h *CompanyHandler) CreateCompany(w http.ResponseWriter, r *http.Request) {
 		Name:        company.Name,
 		Description: company.Description,
 		Website:     company.Website,
+		RequireMFA:  company.RequireMFA,
 		CreatedAt:   company.CreatedAt,
 		UpdatedAt:   company.UpdatedAt,
 	}
@@ -86,6 +89,7 @@ func (h *CompanyHandler) GetCompanies(w http.ResponseWriter, r *http.Request) {
 			Name:        company.Name,
 			Description: company.Description,
 			Website:     company.Website,
+			RequireMFA:  company.RequireMFA,
 			CreatedAt:   company.CreatedAt,
 			UpdatedAt:   company.UpdatedAt,
 		}
@@ -170,3 +174,99 @@ func (h *CompanyHandler) AssignCompany(w http.ResponseWriter, r *http.Request) {
 
 	response.WriteJSON(w, http.StatusOK, resp)
 }
+
+// UpdateMyCompanyMFAPolicy lets a builder require MFA for all builders of their company
+func (h *CompanyHandler) UpdateMyCompanyMFAPolicy(w http.ResponseWriter, r *http.Request) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	var req payload.UpdateCompanyMFAPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// The builder turning the policy on must already use MFA, or they would lock themselves out
+	if *req.RequireMFA && !principal.MFAEnabled {
+		response.WriteError(w, http.StatusConflict, "Enable two-factor authentication on your own account first")
+		return
+	}
+
+	company, err := h.companyUsecase.UpdateOwnCompanyMFAPolicy(r.Context(), principal.UserID, *req.RequireMFA)
+	if err != nil {
+		switch err.Error() {
+		case "only administrators can disable the MFA requirement":
+			response.WriteError(w, http.StatusForbidden, "Only administrators can disable the MFA requirement")
+		case "builder has no company":
+			response.WriteError(w, http.StatusBadRequest, "Assign a company to your builder profile first")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to update MFA policy")
+		}
+		return
+	}
+
+	h.writeMFAPolicyResponse(w, company)
+}
+
+// UpdateCompanyMFAPolicy lets an administrator set the MFA policy of any company
+func (h *CompanyHandler) UpdateCompanyMFAPolicy(w http.ResponseWriter, r *http.Request) {
+	companyID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid company ID")
+		return
+	}
+
+	var req payload.UpdateCompanyMFAPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	company, err := h.companyUsecase.UpdateCompanyMFAPolicy(r.Context(), companyID, *req.RequireMFA)
+	if err != nil {
+		if err.Error() == "company not found" {
+			response.WriteError(w, http.StatusNotFound, "Company not found")
+			return
+		}
+		response.WriteError(w, http.StatusInternalServerError, "Failed to update MFA policy")
+		return
+	}
+
+	h.writeMFAPolicyResponse(w, company)
+}
+
+// writeMFAPolicyResponse applies a policy change to cached principals and writes the company
+func (h *CompanyHandler) writeMFAPolicyResponse(w http.ResponseWriter, company *models.Company) {
+	// Every builder of the company is affected, so drop all cached principals
+	middleware.InvalidateAllPrincipals()
+
+	resp := payload.UpdateCompanyMFAPolicyResponse{
+		Company: payload.CompanyResponse{
+			ID:          company.ID.String(),
+			Name:        company.Name,
+			Description: company.Description,
+			Website:     company.Website,
+			RequireMFA:  company.RequireMFA,
+			CreatedAt:   company.CreatedAt,
+			UpdatedAt:   company.UpdatedAt,
+		},
+		Message: "MFA policy updated successfully",
+	}
+
+	response.WriteJSON(w, http.StatusOK, resp)
+}
@@ -11,6 +11,7 @@ import (
 type BuilderProfileRepository interface {
 	Create(ctx context.Context, profile *models.BuilderProfile) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.BuilderProfile, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.BuilderProfile, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*models.BuilderProfile, error)
 	Update(ctx context.Context, profile *models.BuilderProfile) error
 	Delete(ctx context.Context, id uuid.UUID) error
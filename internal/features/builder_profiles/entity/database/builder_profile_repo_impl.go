@@ -35,6 +35,19 @@ func (r *builderProfileRepository) GetByID(ctx context.Context, id uuid.UUID) (*
 	return &profile, nil
 }
 
+// GetByIDs retrieves builder profiles by ID with their company, in no particular order
+func (r *builderProfileRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.BuilderProfile, error) {
+	var profiles []*models.BuilderProfile
+	if len(ids) == 0 {
+		return profiles, nil
+	}
+	err := r.db.WithContext(ctx).Preload("Company").Where("id IN ?", ids).Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
 // GetByUserID retrieves a builder profile by user ID
 func (r *builderProfileRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.BuilderProfile, error) {
 	var profile models.BuilderProfile
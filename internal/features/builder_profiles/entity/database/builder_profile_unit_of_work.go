@@ -0,0 +1,28 @@
+package database
+
+import (
+	authUserRepo "github.com/yakka-backend/internal/features/auth/user/entity/database"
+	dbInfra "github.com/yakka-backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// BuilderProfileTxRepositories are the repositories written when a builder profile is created
+type BuilderProfileTxRepositories struct {
+	Profiles     BuilderProfileRepository
+	UserLicenses authUserRepo.UserLicenseRepository
+	Users        authUserRepo.UserRepository
+}
+
+// BuilderProfileUnitOfWork runs builder profile writes in a single transaction
+type BuilderProfileUnitOfWork = dbInfra.UnitOfWork[BuilderProfileTxRepositories]
+
+// NewBuilderProfileUnitOfWork creates a unit of work for the builder profile repositories
+func NewBuilderProfileUnitOfWork(db *gorm.DB) BuilderProfileUnitOfWork {
+	return dbInfra.NewUnitOfWork(db, func(tx *gorm.DB) BuilderProfileTxRepositories {
+		return BuilderProfileTxRepositories{
+			Profiles:     NewBuilderProfileRepository(tx),
+			UserLicenses: authUserRepo.NewUserLicenseRepository(tx),
+			Users:        authUserRepo.NewUserRepository(tx),
+		}
+	})
+}
@@ -12,6 +12,7 @@ type Company struct {
 	Name        string    `json:"name" gorm:"size:255;not null;uniqueIndex"`
 	Description *string   `json:"description" gorm:"type:text"`
 	Website     *string   `json:"website" gorm:"size:255"`
+	RequireMFA  bool      `json:"require_mfa" gorm:"not null;default:false"` // builders must enrol in two-factor authentication
 	CreatedAt   time.Time `json:"created_at" gorm:"not null;type:timestamptz"`
 	UpdatedAt   time.Time `json:"updated_at" gorm:"not null;type:timestamptz"`
 }
@@ -26,6 +26,11 @@ type CreateCompanyRequest struct {
 	Website     *string `json:"website,omitempty" validate:"omitempty,url"`
 }
 
+// UpdateCompanyMFAPolicyRequest represents the request to require MFA for a company's builders
+type UpdateCompanyMFAPolicyRequest struct {
+	RequireMFA *bool `json:"require_mfa" validate:"required"`
+}
+
 // AssignCompanyRequest represents the request to assign a company to a builder
 type AssignCompanyRequest struct {
 	CompanyID string `json:"company_id" validate:"required,uuid"`
@@ -22,6 +22,7 @@ type CompanyResponse struct {
 	Name        string    `json:"name"`
 	Description *string   `json:"description"`
 	Website     *string   `json:"website"`
+	RequireMFA  bool      `json:"require_mfa"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -38,6 +39,12 @@ type CreateCompanyResponse struct {
 	Message string          `json:"message"`
 }
 
+// UpdateCompanyMFAPolicyResponse represents the response when changing a company MFA policy
+type UpdateCompanyMFAPolicyResponse struct {
+	Company CompanyResponse `json:"company"`
+	Message string          `json:"message"`
+}
+
 // GetCompaniesResponse represents the response when getting all companies
 type GetCompaniesResponse struct {
 	Companies []CompanyResponse `json:"companies"`
@@ -26,6 +26,8 @@ type CompanyUsecase interface {
 	CreateCompany(ctx context.Context, req payload.CreateCompanyRequest) (*builderModels.Company, error)
 	GetAllCompanies(ctx context.Context) ([]*builderModels.Company, error)
 	AssignCompanyToBuilder(ctx context.Context, userID uuid.UUID, req payload.AssignCompanyRequest) (*builderModels.BuilderProfile, error)
+	UpdateOwnCompanyMFAPolicy(ctx context.Context, userID uuid.UUID, requireMFA bool) (*builderModels.Company, error)
+	UpdateCompanyMFAPolicy(ctx context.Context, companyID uuid.UUID, requireMFA bool) (*builderModels.Company, error)
 }
 
 type builderProfileUsecase struct {
@@ -33,14 +35,16 @@ type builderProfileUsecase struct {
 	userLicenseRepo authUserRepo.UserLicenseRepository
 	userRepo        authUserRepo.UserRepository
 	licenseRepo     licenseRepo.LicenseRepository
+	uow             database.BuilderProfileUnitOfWork
 }
 
-func NewBuilderProfileUsecase(builderRepo database.BuilderProfileRepository, userLicenseRepo authUserRepo.UserLicenseRepository, userRepo authUserRepo.UserRepository, licenseRepo licenseRepo.LicenseRepository) BuilderProfileUsecase {
+func NewBuilderProfileUsecase(builderRepo database.BuilderProfileRepository, userLicenseRepo authUserRepo.UserLicenseRepository, userRepo authUserRepo.UserRepository, licenseRepo licenseRepo.LicenseRepository, uow database.BuilderProfileUnitOfWork) BuilderProfileUsecase {
 	return &builderProfileUsecase{
 		builderRepo:     builderRepo,
 		userLicenseRepo: userLicenseRepo,
 		userRepo:        userRepo,
 		licenseRepo:     licenseRepo,
+		uow:             uow,
 	}
 }
 
@@ -95,78 +99,82 @@ func (u *builderProfileUsecase) CreateProfile(ctx context.Context, userID uuid.U
 		UpdatedAt:   time.Now(),
 	}
 
-	// Create profile in database
-	if err := u.builderRepo.Create(ctx, profile); err != nil {
-		return nil, err
-	}
+	// The profile, the role change and the skills/licenses are stored together or not at all
+	err = u.uow.Do(ctx, func(repos database.BuilderProfileTxRepositories) error {
+		// Create profile in database
+		if err := repos.Profiles.Create(ctx, profile); err != nil {
+			return err
+		}
 
-	// Update user role to builder
-	user.Role = authUserModels.UserRoleBuilder
-	user.RoleChangedAt = &time.Time{}
-	*user.RoleChangedAt = time.Now()
+		// Update user role to builder
+		user.Role = authUserModels.UserRoleBuilder
+		user.RoleChangedAt = &time.Time{}
+		*user.RoleChangedAt = time.Now()
 
-	// Update user fields that are now in the user table
-	user.Photo = req.AvatarURL
+		// Update user fields that are now in the user table
+		user.Photo = req.AvatarURL
 
-	// Update user phone if provided
-	if req.Phone != nil {
-		user.Phone = req.Phone
-	}
+		// Update user phone if provided, a new number needs verifying again
+		phoneChanged := req.Phone != nil && user.SetPhone(req.Phone)
 
-	// Use Updates instead of Save to avoid overwriting existing fields
-	updates := map[string]interface{}{
-		"role":            user.Role,
-		"role_changed_at": user.RoleChangedAt,
-		"photo":           user.Photo,
-	}
+		// Use Updates instead of Save to avoid overwriting existing fields
+		updates := map[string]interface{}{
+			"role":            user.Role,
+			"role_changed_at": user.RoleChangedAt,
+			"photo":           user.Photo,
+		}
 
-	// Only update phone if provided
-	if req.Phone != nil {
-		updates["phone"] = user.Phone
-	}
+		// Only update phone if it changed
+		if phoneChanged {
+			updates["phone"] = user.Phone
+			updates["phone_verified_at"] = nil
+		}
 
-	if err := u.userRepo.UpdateSpecificFields(ctx, user.ID, updates); err != nil {
-		return nil, err
-	}
+		if err := repos.Users.UpdateSpecificFields(ctx, user.ID, updates); err != nil {
+			return err
+		}
 
-	// Create licenses if provided
-	if len(req.Licenses) > 0 {
-		var licenses []*authUserModels.UserLicense
-		for _, licenseReq := range req.Licenses {
-			licenseID, err := uuid.Parse(licenseReq.LicenseID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid license_id: %w", err)
-			}
+		// Create licenses if provided
+		if len(req.Licenses) > 0 {
+			var licenses []*authUserModels.UserLicense
+			for _, licenseReq := range req.Licenses {
+				licenseID, err := uuid.Parse(licenseReq.LicenseID)
+				if err != nil {
+					return fmt.Errorf("invalid license_id: %w", err)
+				}
 
-			license := &authUserModels.UserLicense{
-				UserID:    userID,
-				LicenseID: licenseID,
-				PhotoURL:  licenseReq.PhotoURL,
-				CreatedAt: time.Now(),
-				UpdatedAt: time.Now(),
-			}
+				license := &authUserModels.UserLicense{
+					UserID:    userID,
+					LicenseID: licenseID,
+					PhotoURL:  licenseReq.PhotoURL,
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				}
 
-			// Parse dates if provided
-			if licenseReq.IssuedAt != nil {
-				if issuedAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.IssuedAt); err == nil {
-					license.IssuedAt = &issuedAt
+				// Parse dates if provided
+				if licenseReq.IssuedAt != nil {
+					if issuedAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.IssuedAt); err == nil {
+						license.IssuedAt = &issuedAt
+					}
 				}
-			}
-			if licenseReq.ExpiresAt != nil {
-				if expiresAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.ExpiresAt); err == nil {
-					license.ExpiresAt = &expiresAt
+				if licenseReq.ExpiresAt != nil {
+					if expiresAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.ExpiresAt); err == nil {
+						license.ExpiresAt = &expiresAt
+					}
 				}
+
+				licenses = append(licenses, license)
 			}
 
-			licenses = append(licenses, license)
+			if err := repos.UserLicenses.CreateBatch(ctx, licenses); err != nil {
+				return fmt.Errorf("failed to create licenses: %w", err)
+			}
 		}
 
-		if err := u.userLicenseRepo.CreateBatch(ctx, licenses); err != nil {
-			// If licenses creation fails, we should rollback the profile creation
-			// For now, we'll log the error but not fail the entire operation
-			// In a production system, you might want to use database transactions
-			fmt.Printf("Warning: Failed to create licenses for user %s: %v\n", userID, err)
-		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return profile, nil
@@ -330,6 +338,43 @@ func (u *companyUsecase) AssignCompanyToBuilder(ctx context.Context, userID uuid
 	return builderProfile, nil
 }
 
+// UpdateOwnCompanyMFAPolicy lets a builder require MFA for everyone in their company.
+// Only administrators can lift the requirement again.
+func (u *companyUsecase) UpdateOwnCompanyMFAPolicy(ctx context.Context, userID uuid.UUID, requireMFA bool) (*builderModels.Company, error) {
+	if !requireMFA {
+		return nil, fmt.Errorf("only administrators can disable the MFA requirement")
+	}
+
+	builderProfile, err := u.builderRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get builder profile: %w", err)
+	}
+	if builderProfile.CompanyID == nil {
+		return nil, fmt.Errorf("builder has no company")
+	}
+
+	return u.UpdateCompanyMFAPolicy(ctx, *builderProfile.CompanyID, requireMFA)
+}
+
+// UpdateCompanyMFAPolicy sets whether a company requires MFA for its builders
+func (u *companyUsecase) UpdateCompanyMFAPolicy(ctx context.Context, companyID uuid.UUID, requireMFA bool) (*builderModels.Company, error) {
+	company, err := u.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("company not found")
+		}
+		return nil, fmt.Errorf("failed to get company: %w", err)
+	}
+
+	company.RequireMFA = requireMFA
+	company.UpdatedAt = time.Now()
+	if err := u.companyRepo.Update(ctx, company); err != nil {
+		return nil, fmt.Errorf("failed to update company: %w", err)
+	}
+
+	return company, nil
+}
+
 // validateCompanyExists checks if a company exists
 func (u *companyUsecase) validateCompanyExists(ctx context.Context, companyID uuid.UUID) error {
 	var count int64
@@ -2,10 +2,12 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/google/uuid"
+	auth_models "github.com/yakka-backend/internal/features/auth/user/models"
 	"github.com/yakka-backend/internal/features/job_applications/models"
 	"github.com/yakka-backend/internal/features/job_applications/payload"
 	"github.com/yakka-backend/internal/features/job_applications/usecase"
@@ -107,6 +109,12 @@ func (h *JobApplicationHandler) GetApplicationByID(w http.ResponseWriter, r *htt
 		return
 	}
 
+	timeline, err := h.applicationUsecase.GetApplicationTimeline(r.Context(), applicationID)
+	if err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "Failed to get application timeline")
+		return
+	}
+
 	// Convert to response
 	applicationResp := payload.JobApplicationResponse{
 		ID:           application.ID.String(),
@@ -119,6 +127,7 @@ func (h *JobApplicationHandler) GetApplicationByID(w http.ResponseWriter, r *htt
 		CreatedAt:    application.CreatedAt,
 		UpdatedAt:    application.UpdatedAt,
 		WithdrawnAt:  application.WithdrawnAt,
+		Timeline:     toApplicationTimeline(timeline),
 	}
 
 	response.WriteJSON(w, http.StatusOK, applicationResp)
@@ -155,6 +164,10 @@ func (h *JobApplicationHandler) UpdateApplication(w http.ResponseWriter, r *http
 	// Update application
 	application, err := h.applicationUsecase.UpdateApplication(r.Context(), applicationID, req)
 	if err != nil {
+		if err.Error() == "application can no longer be edited" {
+			response.WriteError(w, http.StatusConflict, "Only applications still APPLIED can be edited")
+			return
+		}
 		response.WriteError(w, http.StatusInternalServerError, "Failed to update application")
 		return
 	}
@@ -276,9 +289,13 @@ func (h *JobApplicationHandler) UpdateApplicationStatus(w http.ResponseWriter, r
 		return
 	}
 
-	var req struct {
-		Status string `json:"status" validate:"required,oneof=APPLIED REVIEWED ACCEPTED REJECTED WITHDRAWN"`
+	actor, actorID, ok := applicationActor(r)
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
 	}
+
+	var req payload.UpdateApplicationStatusRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
@@ -290,12 +307,10 @@ func (h *JobApplicationHandler) UpdateApplicationStatus(w http.ResponseWriter, r
 		return
 	}
 
-	status := models.ApplicationStatus(req.Status)
-
 	// Update status
-	application, err := h.applicationUsecase.UpdateApplicationStatus(r.Context(), applicationID, status)
+	application, err := h.applicationUsecase.UpdateApplicationStatus(r.Context(), applicationID, req.Status, actor, actorID, req.Reason)
 	if err != nil {
-		response.WriteError(w, http.StatusInternalServerError, "Failed to update application status")
+		writeTransitionError(w, err, "Failed to update application status")
 		return
 	}
 
@@ -332,13 +347,33 @@ func (h *JobApplicationHandler) WithdrawApplication(w http.ResponseWriter, r *ht
 		return
 	}
 
+	userIDStr, ok := r.Context().Value(middleware.UserIDKey).(string)
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	var req payload.WithdrawApplicationRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
 	// Withdraw application
-	if err := h.applicationUsecase.WithdrawApplication(r.Context(), applicationID); err != nil {
+	if err := h.applicationUsecase.WithdrawApplication(r.Context(), applicationID, userID, req.Reason); err != nil {
 		if err.Error() == "application already withdrawn" {
 			response.WriteError(w, http.StatusConflict, "Application already withdrawn")
 			return
 		}
-		response.WriteError(w, http.StatusInternalServerError, "Failed to withdraw application")
+		writeTransitionError(w, err, "Failed to withdraw application")
 		return
 	}
 
@@ -349,6 +384,55 @@ func (h *JobApplicationHandler) WithdrawApplication(w http.ResponseWriter, r *ht
 	response.WriteJSON(w, http.StatusOK, resp)
 }
 
+// applicationActor returns who is changing an application's status: the labourer by user ID,
+// or the builder by builder profile ID
+func applicationActor(r *http.Request) (models.ApplicationActor, *uuid.UUID, bool) {
+	principal, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		return "", nil, false
+	}
+	switch principal.Role {
+	case auth_models.UserRoleBuilder:
+		if principal.BuilderProfileID == nil {
+			return "", nil, false
+		}
+		return models.ApplicationActorBuilder, principal.BuilderProfileID, true
+	case auth_models.UserRoleLabour:
+		userID := principal.UserID
+		return models.ApplicationActorLabour, &userID, true
+	}
+	return "", nil, false
+}
+
+// writeTransitionError maps a failed status change to its HTTP status
+func writeTransitionError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case err.Error() == "application not found":
+		response.WriteError(w, http.StatusNotFound, "Application not found")
+	case errors.Is(err, usecase.ErrInvalidTransition):
+		response.WriteError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, usecase.ErrTransitionNotAllowed):
+		response.WriteError(w, http.StatusForbidden, err.Error())
+	default:
+		response.WriteError(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// toApplicationTimeline converts a status history, oldest first, to its response
+func toApplicationTimeline(events []*models.ApplicationStatusEvent) []payload.ApplicationEventResponse {
+	timeline := make([]payload.ApplicationEventResponse, len(events))
+	for i, event := range events {
+		timeline[i] = payload.ApplicationEventResponse{
+			FromStatus: event.FromStatus,
+			ToStatus:   event.ToStatus,
+			Actor:      event.Actor,
+			Reason:     event.Reason,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+	return timeline
+}
+
 // Helper functions
 func getStringParam(r *http.Request, key string) *string {
 	value := r.URL.Query().Get(key)
@@ -0,0 +1,18 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/job_applications/models"
+)
+
+// ApplicationReviewRepository defines the interface for builder reviews of applications
+type ApplicationReviewRepository interface {
+	// GetByApplicationIDs retrieves the reviews of a set of applications with their tags
+	GetByApplicationIDs(ctx context.Context, applicationIDs []uuid.UUID) ([]*models.ApplicationReview, error)
+
+	// Save creates or replaces the review of an application, tags included.
+	// Run it inside a unit of work so the review and its tags are stored together.
+	Save(ctx context.Context, review *models.ApplicationReview) error
+}
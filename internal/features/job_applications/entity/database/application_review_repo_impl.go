@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/job_applications/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// applicationReviewRepository implements ApplicationReviewRepository
+type applicationReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewApplicationReviewRepository creates a new application review repository
+func NewApplicationReviewRepository(db *gorm.DB) ApplicationReviewRepository {
+	return &applicationReviewRepository{db: db}
+}
+
+// GetByApplicationIDs retrieves the reviews of a set of applications with their tags
+func (r *applicationReviewRepository) GetByApplicationIDs(ctx context.Context, applicationIDs []uuid.UUID) ([]*models.ApplicationReview, error) {
+	var reviews []*models.ApplicationReview
+	if len(applicationIDs) == 0 {
+		return reviews, nil
+	}
+	err := r.db.WithContext(ctx).
+		Preload("Tags", func(db *gorm.DB) *gorm.DB { return db.Order("tag ASC") }).
+		Where("application_id IN ?", applicationIDs).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// Save creates or replaces the review of an application, tags included
+func (r *applicationReviewRepository) Save(ctx context.Context, review *models.ApplicationReview) error {
+	now := time.Now()
+	if review.CreatedAt.IsZero() {
+		review.CreatedAt = now
+	}
+	review.UpdatedAt = now
+
+	db := r.db.WithContext(ctx)
+	err := db.Omit("Tags").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "application_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"builder_profile_id", "notes", "rating", "updated_at"}),
+	}).Create(review).Error
+	if err != nil {
+		return err
+	}
+
+	if err := db.Where("application_id = ?", review.ApplicationID).Delete(&models.ApplicationReviewTag{}).Error; err != nil {
+		return err
+	}
+	if len(review.Tags) == 0 {
+		return nil
+	}
+	for i := range review.Tags {
+		review.Tags[i].ApplicationID = review.ApplicationID
+	}
+	return db.Create(&review.Tags).Error
+}
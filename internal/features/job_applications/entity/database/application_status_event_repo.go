@@ -0,0 +1,20 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/job_applications/models"
+)
+
+// ApplicationStatusEventRepository defines the interface for application status history operations
+type ApplicationStatusEventRepository interface {
+	// Create records a status change
+	Create(ctx context.Context, event *models.ApplicationStatusEvent) error
+
+	// GetByApplicationID retrieves the status history of an application, oldest first
+	GetByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]*models.ApplicationStatusEvent, error)
+
+	// GetByApplicationIDs retrieves the status history of a set of applications, oldest first
+	GetByApplicationIDs(ctx context.Context, applicationIDs []uuid.UUID) ([]*models.ApplicationStatusEvent, error)
+}
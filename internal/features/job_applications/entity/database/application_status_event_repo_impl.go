@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/job_applications/models"
+	"gorm.io/gorm"
+)
+
+// applicationStatusEventRepository implements ApplicationStatusEventRepository
+type applicationStatusEventRepository struct {
+	db *gorm.DB
+}
+
+// NewApplicationStatusEventRepository creates a new application status event repository
+func NewApplicationStatusEventRepository(db *gorm.DB) ApplicationStatusEventRepository {
+	return &applicationStatusEventRepository{db: db}
+}
+
+// Create records a status change
+func (r *applicationStatusEventRepository) Create(ctx context.Context, event *models.ApplicationStatusEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// GetByApplicationID retrieves the status history of an application, oldest first
+func (r *applicationStatusEventRepository) GetByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]*models.ApplicationStatusEvent, error) {
+	return r.GetByApplicationIDs(ctx, []uuid.UUID{applicationID})
+}
+
+// GetByApplicationIDs retrieves the status history of a set of applications, oldest first
+func (r *applicationStatusEventRepository) GetByApplicationIDs(ctx context.Context, applicationIDs []uuid.UUID) ([]*models.ApplicationStatusEvent, error) {
+	var events []*models.ApplicationStatusEvent
+	if len(applicationIDs) == 0 {
+		return events, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("application_id IN ?", applicationIDs).
+		Order("created_at ASC, id ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
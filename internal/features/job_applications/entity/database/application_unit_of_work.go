@@ -0,0 +1,25 @@
+package database
+
+import (
+	dbInfra "github.com/yakka-backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// ApplicationTxRepositories are the repositories written together when an application changes status
+type ApplicationTxRepositories struct {
+	Applications JobApplicationRepository
+	Events       ApplicationStatusEventRepository
+}
+
+// ApplicationUnitOfWork runs application writes in a single transaction
+type ApplicationUnitOfWork = dbInfra.UnitOfWork[ApplicationTxRepositories]
+
+// NewApplicationUnitOfWork creates a unit of work for the application repositories
+func NewApplicationUnitOfWork(db *gorm.DB) ApplicationUnitOfWork {
+	return dbInfra.NewUnitOfWork(db, func(tx *gorm.DB) ApplicationTxRepositories {
+		return ApplicationTxRepositories{
+			Applications: NewJobApplicationRepository(tx),
+			Events:       NewApplicationStatusEventRepository(tx),
+		}
+	})
+}
@@ -2,11 +2,20 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/yakka-backend/internal/features/job_applications/models"
 )
 
+// ApplicantFilter narrows the applications of a builder's jobs by status and by the builder's review
+type ApplicantFilter struct {
+	Status    *models.ApplicationStatus
+	MinRating *int
+	Tags      []string // the review must have every tag, lower case
+	Notes     *string  // text the review notes must contain, case insensitive
+}
+
 // JobApplicationRepository defines the interface for job application data operations
 type JobApplicationRepository interface {
 	// Create creates a new job application
@@ -15,18 +24,31 @@ type JobApplicationRepository interface {
 	// GetByID retrieves a job application by ID
 	GetByID(ctx context.Context, id uuid.UUID) (*models.JobApplication, error)
 
-	// GetByJobAndLabourUser retrieves a job application by job ID and labour user ID
+	// GetByJobAndLabourUser retrieves the latest job application by job ID and labour user ID
 	GetByJobAndLabourUser(ctx context.Context, jobID, labourUserID uuid.UUID) (*models.JobApplication, error)
 
 	// Update updates an existing job application
 	Update(ctx context.Context, application *models.JobApplication) error
 
+	// UpdateDetails updates the cover letter, expected rate and resume of an application still APPLIED,
+	// returning how many rows changed
+	UpdateDetails(ctx context.Context, application *models.JobApplication) (int64, error)
+
 	// Delete deletes a job application
 	Delete(ctx context.Context, id uuid.UUID) error
 
 	// GetByJobID retrieves all applications for a specific job
 	GetByJobID(ctx context.Context, jobID uuid.UUID, page, limit int) ([]*models.JobApplication, int64, error)
 
+	// GetByJobIDs retrieves the applications of a set of jobs, newest first
+	GetByJobIDs(ctx context.Context, jobIDs []uuid.UUID) ([]*models.JobApplication, error)
+
+	// GetByJobIDsFiltered retrieves the applications of a set of jobs that pass the filter, newest first
+	GetByJobIDsFiltered(ctx context.Context, jobIDs []uuid.UUID, filter ApplicantFilter) ([]*models.JobApplication, error)
+
+	// GetByLabourUserAndJobIDs retrieves the applications a labour user made to a set of jobs, newest first
+	GetByLabourUserAndJobIDs(ctx context.Context, labourUserID uuid.UUID, jobIDs []uuid.UUID) ([]*models.JobApplication, error)
+
 	// GetByLabourUserID retrieves all applications for a specific labour user
 	GetByLabourUserID(ctx context.Context, labourUserID uuid.UUID, page, limit int) ([]*models.JobApplication, int64, error)
 
@@ -36,12 +58,28 @@ type JobApplicationRepository interface {
 	// GetWithFilters retrieves applications with multiple filters
 	GetWithFilters(ctx context.Context, jobID, labourUserID *uuid.UUID, status *models.ApplicationStatus, page, limit int) ([]*models.JobApplication, int64, error)
 
-	// UpdateStatus updates the status of a job application
-	UpdateStatus(ctx context.Context, id uuid.UUID, status models.ApplicationStatus) error
+	// UpdateStatus moves a job application from one status to another, returning how many rows
+	// changed: none when the application is no longer in the from status
+	UpdateStatus(ctx context.Context, id uuid.UUID, from, to models.ApplicationStatus) (int64, error)
 
 	// WithdrawApplication withdraws an application
 	WithdrawApplication(ctx context.Context, id uuid.UUID) error
 
-	// CheckApplicationExists checks if an application already exists for a job and user
+	// FlagJobChanged marks the open applications of a job as needing confirmation after a material edit
+	FlagJobChanged(ctx context.Context, jobID uuid.UUID, changedAt time.Time) (int64, error)
+
+	// ClearJobChanged records that the labourer accepted the edited job
+	ClearJobChanged(ctx context.Context, id uuid.UUID) error
+
+	// SaveOffer stores the terms and deadline of the offer made on an application
+	SaveOffer(ctx context.Context, application *models.JobApplication) error
+
+	// CountPendingOffers counts the offers on a job that are still waiting for an answer before their deadline
+	CountPendingOffers(ctx context.Context, jobID uuid.UUID, now time.Time) (int64, error)
+
+	// GetExpiredOffers retrieves the OFFERED applications whose deadline has passed, oldest deadline first
+	GetExpiredOffers(ctx context.Context, now time.Time, limit int) ([]*models.JobApplication, error)
+
+	// CheckApplicationExists checks if a job and user already have an application that was not withdrawn
 	CheckApplicationExists(ctx context.Context, jobID, labourUserID uuid.UUID) (bool, error)
 }
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -34,10 +35,10 @@ func (r *JobApplicationRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID
 	return &application, nil
 }
 
-// GetByJobAndLabourUser retrieves a job application by job ID and labour user ID
+// GetByJobAndLabourUser retrieves the latest job application by job ID and labour user ID
 func (r *JobApplicationRepositoryImpl) GetByJobAndLabourUser(ctx context.Context, jobID, labourUserID uuid.UUID) (*models.JobApplication, error) {
 	var application models.JobApplication
-	err := r.db.WithContext(ctx).Where("job_id = ? AND labour_user_id = ?", jobID, labourUserID).First(&application).Error
+	err := r.db.WithContext(ctx).Where("job_id = ? AND labour_user_id = ?", jobID, labourUserID).Order("created_at DESC").First(&application).Error
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +51,21 @@ func (r *JobApplicationRepositoryImpl) Update(ctx context.Context, application *
 	return r.db.WithContext(ctx).Save(application).Error
 }
 
+// UpdateDetails updates the cover letter, expected rate and resume of an application still APPLIED,
+// returning how many rows changed
+func (r *JobApplicationRepositoryImpl) UpdateDetails(ctx context.Context, application *models.JobApplication) (int64, error) {
+	application.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).Model(&models.JobApplication{}).
+		Where("id = ? AND status = ?", application.ID, models.ApplicationStatusApplied).
+		Updates(map[string]interface{}{
+			"cover_letter":  application.CoverLetter,
+			"expected_rate": application.ExpectedRate,
+			"resume_url":    application.ResumeURL,
+			"updated_at":    application.UpdatedAt,
+		})
+	return result.RowsAffected, result.Error
+}
+
 // Delete deletes a job application
 func (r *JobApplicationRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.JobApplication{}).Error
@@ -73,6 +89,65 @@ func (r *JobApplicationRepositoryImpl) GetByJobID(ctx context.Context, jobID uui
 	return applications, total, err
 }
 
+// GetByJobIDs retrieves the applications of a set of jobs, newest first
+func (r *JobApplicationRepositoryImpl) GetByJobIDs(ctx context.Context, jobIDs []uuid.UUID) ([]*models.JobApplication, error) {
+	var applications []*models.JobApplication
+	if len(jobIDs) == 0 {
+		return applications, nil
+	}
+	err := r.db.WithContext(ctx).Where("job_id IN ?", jobIDs).Order("created_at DESC").Find(&applications).Error
+	return applications, err
+}
+
+// GetByJobIDsFiltered retrieves the applications of a set of jobs that pass the filter, newest first.
+// Review filters join the builder's review, so applications without one are left out.
+func (r *JobApplicationRepositoryImpl) GetByJobIDsFiltered(ctx context.Context, jobIDs []uuid.UUID, filter ApplicantFilter) ([]*models.JobApplication, error) {
+	var applications []*models.JobApplication
+	if len(jobIDs) == 0 {
+		return applications, nil
+	}
+
+	query := r.db.WithContext(ctx).
+		Select("job_applications.*").
+		Where("job_applications.job_id IN ?", jobIDs)
+	if filter.Status != nil {
+		query = query.Where("job_applications.status = ?", *filter.Status)
+	}
+	if filter.MinRating != nil || filter.Notes != nil || len(filter.Tags) > 0 {
+		query = query.Joins("JOIN application_reviews ON application_reviews.application_id = job_applications.id")
+		if filter.MinRating != nil {
+			query = query.Where("application_reviews.rating >= ?", *filter.MinRating)
+		}
+		if filter.Notes != nil {
+			query = query.Where("application_reviews.notes ILIKE ?", "%"+escapeLike(*filter.Notes)+"%")
+		}
+		if len(filter.Tags) > 0 {
+			query = query.Where("(SELECT COUNT(*) FROM application_review_tags WHERE application_review_tags.application_id = job_applications.id AND application_review_tags.tag IN ?) = ?", filter.Tags, len(filter.Tags))
+		}
+	}
+
+	err := query.Order("job_applications.created_at DESC").Find(&applications).Error
+	return applications, err
+}
+
+// escapeLike escapes the LIKE wildcards in text so it is matched literally
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
+// GetByLabourUserAndJobIDs retrieves the applications a labour user made to a set of jobs, newest first
+func (r *JobApplicationRepositoryImpl) GetByLabourUserAndJobIDs(ctx context.Context, labourUserID uuid.UUID, jobIDs []uuid.UUID) ([]*models.JobApplication, error) {
+	var applications []*models.JobApplication
+	if len(jobIDs) == 0 {
+		return applications, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("labour_user_id = ? AND job_id IN ?", labourUserID, jobIDs).
+		Order("created_at DESC").
+		Find(&applications).Error
+	return applications, err
+}
+
 // GetByLabourUserID retrieves all applications for a specific labour user
 func (r *JobApplicationRepositoryImpl) GetByLabourUserID(ctx context.Context, labourUserID uuid.UUID, page, limit int) ([]*models.JobApplication, int64, error) {
 	var applications []*models.JobApplication
@@ -138,19 +213,23 @@ func (r *JobApplicationRepositoryImpl) GetWithFilters(ctx context.Context, jobID
 	return applications, total, err
 }
 
-// UpdateStatus updates the status of a job application
-func (r *JobApplicationRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, status models.ApplicationStatus) error {
+// UpdateStatus moves a job application from one status to another, returning how many rows
+// changed: none when the application is no longer in the from status
+func (r *JobApplicationRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID, from, to models.ApplicationStatus) (int64, error) {
 	updates := map[string]interface{}{
-		"status":     status,
+		"status":     to,
 		"updated_at": time.Now(),
 	}
 
 	// If withdrawing, set withdrawn_at
-	if status == models.ApplicationStatusWithdrawn {
+	if to == models.ApplicationStatusWithdrawn {
 		updates["withdrawn_at"] = time.Now()
 	}
 
-	return r.db.WithContext(ctx).Model(&models.JobApplication{}).Where("id = ?", id).Updates(updates).Error
+	result := r.db.WithContext(ctx).Model(&models.JobApplication{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(updates)
+	return result.RowsAffected, result.Error
 }
 
 // WithdrawApplication withdraws an application
@@ -165,11 +244,73 @@ func (r *JobApplicationRepositoryImpl) WithdrawApplication(ctx context.Context,
 	return r.db.WithContext(ctx).Model(&models.JobApplication{}).Where("id = ?", id).Updates(updates).Error
 }
 
-// CheckApplicationExists checks if an application already exists for a job and user
+// FlagJobChanged marks the open applications of a job as needing confirmation after a material edit
+func (r *JobApplicationRepositoryImpl) FlagJobChanged(ctx context.Context, jobID uuid.UUID, changedAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.JobApplication{}).
+		Where("job_id = ? AND status IN ? AND created_at < ?", jobID, []models.ApplicationStatus{
+			models.ApplicationStatusApplied,
+			models.ApplicationStatusReviewed,
+			models.ApplicationStatusOffered,
+			models.ApplicationStatusAccepted,
+		}, changedAt).
+		Updates(map[string]interface{}{
+			"job_changed_at": changedAt,
+			"updated_at":     changedAt,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// ClearJobChanged records that the labourer accepted the edited job
+func (r *JobApplicationRepositoryImpl) ClearJobChanged(ctx context.Context, id uuid.UUID) error {
+	updates := map[string]interface{}{
+		"job_changed_at": nil,
+		"updated_at":     time.Now(),
+	}
+
+	return r.db.WithContext(ctx).Model(&models.JobApplication{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// SaveOffer stores the terms and deadline of the offer made on an application
+func (r *JobApplicationRepositoryImpl) SaveOffer(ctx context.Context, application *models.JobApplication) error {
+	application.UpdatedAt = time.Now()
+	updates := map[string]interface{}{
+		"offer_start_date": application.OfferStartDate,
+		"offer_end_date":   application.OfferEndDate,
+		"offer_rate":       application.OfferRate,
+		"offered_at":       application.OfferedAt,
+		"offer_expires_at": application.OfferExpiresAt,
+		"updated_at":       application.UpdatedAt,
+	}
+
+	return r.db.WithContext(ctx).Model(&models.JobApplication{}).Where("id = ?", application.ID).Updates(updates).Error
+}
+
+// CountPendingOffers counts the offers on a job that are still waiting for an answer before their deadline
+func (r *JobApplicationRepositoryImpl) CountPendingOffers(ctx context.Context, jobID uuid.UUID, now time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.JobApplication{}).
+		Where("job_id = ? AND status = ? AND offer_expires_at > ?", jobID, models.ApplicationStatusOffered, now).
+		Count(&count).Error
+	return count, err
+}
+
+// GetExpiredOffers retrieves the OFFERED applications whose deadline has passed, oldest deadline first
+func (r *JobApplicationRepositoryImpl) GetExpiredOffers(ctx context.Context, now time.Time, limit int) ([]*models.JobApplication, error) {
+	var applications []*models.JobApplication
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND offer_expires_at <= ?", models.ApplicationStatusOffered, now).
+		Order("offer_expires_at ASC").
+		Limit(limit).
+		Find(&applications).Error
+	return applications, err
+}
+
+// CheckApplicationExists checks if a job and user already have an application that was not withdrawn.
+// Withdrawn applications free the labourer to apply again.
 func (r *JobApplicationRepositoryImpl) CheckApplicationExists(ctx context.Context, jobID, labourUserID uuid.UUID) (bool, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&models.JobApplication{}).
-		Where("job_id = ? AND labour_user_id = ?", jobID, labourUserID).
+		Where("job_id = ? AND labour_user_id = ? AND status <> ?", jobID, labourUserID, models.ApplicationStatusWithdrawn).
 		Count(&count).Error
 
 	return count > 0, err
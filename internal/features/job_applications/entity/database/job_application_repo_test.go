@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/job_applications/models"
+	"github.com/yakka-backend/internal/features/jobs/jobstest"
+	"github.com/yakka-backend/internal/infrastructure/database/dbtest"
+)
+
+func TestGetByJobIDsFiltered(t *testing.T) {
+	db := dbtest.Open(t)
+	seed := jobstest.Create(t, db, jobstest.Options{Builders: 1, JobsPerBuilder: 1, ApplicantsPerJob: 4})
+	builderID := seed.BuilderProfileIDs[0]
+	now := time.Now()
+
+	// Applications 0 to 2 are reviewed; application 3 is not
+	reviews := []struct {
+		rating int
+		notes  string
+		tags   []string
+	}{
+		{5, "Great on site, 100% reliable", []string{"reliable", "forklift"}},
+		{3, "Late twice", []string{"reliable"}},
+		{4, "Good_with_concrete", nil},
+	}
+	for i, review := range reviews {
+		applicationID := seed.ApplicationIDs[i]
+		if err := db.Exec(`INSERT INTO application_reviews (application_id, builder_profile_id, notes, rating, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+			applicationID, builderID, review.notes, review.rating, now, now).Error; err != nil {
+			t.Fatalf("insert review: %v", err)
+		}
+		for _, tag := range review.tags {
+			if err := db.Exec(`INSERT INTO application_review_tags (application_id, tag) VALUES (?, ?)`, applicationID, tag).Error; err != nil {
+				t.Fatalf("insert tag: %v", err)
+			}
+		}
+	}
+	if err := db.Exec(`UPDATE job_applications SET status = 'REVIEWED' WHERE id = ?`, seed.ApplicationIDs[1]).Error; err != nil {
+		t.Fatalf("update status: %v", err)
+	}
+
+	reviewed := models.ApplicationStatusReviewed
+	four := 4
+	great, percent, underscore := "GREAT", "100%", "d_w"
+	tests := []struct {
+		name   string
+		filter ApplicantFilter
+		want   []int // indexes into seed.ApplicationIDs
+	}{
+		{"no filter", ApplicantFilter{}, []int{0, 1, 2, 3}},
+		{"status", ApplicantFilter{Status: &reviewed}, []int{1}},
+		{"min rating", ApplicantFilter{MinRating: &four}, []int{0, 2}},
+		{"one tag", ApplicantFilter{Tags: []string{"reliable"}}, []int{0, 1}},
+		{"every tag", ApplicantFilter{Tags: []string{"forklift", "reliable"}}, []int{0}},
+		{"notes ignore case", ApplicantFilter{Notes: &great}, []int{0}},
+		{"notes match % literally", ApplicantFilter{Notes: &percent}, []int{0}},
+		{"notes match _ literally", ApplicantFilter{Notes: &underscore}, []int{2}},
+		{"status and review", ApplicantFilter{Status: &reviewed, MinRating: &four}, nil},
+	}
+	repo := NewJobApplicationRepository(db)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applications, err := repo.GetByJobIDsFiltered(context.Background(), seed.JobIDs, tt.filter)
+			if err != nil {
+				t.Fatalf("GetByJobIDsFiltered: %v", err)
+			}
+			got := make(map[uuid.UUID]bool, len(applications))
+			for _, application := range applications {
+				got[application.ID] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got %d applications, want %d", len(got), len(tt.want))
+			}
+			for _, i := range tt.want {
+				if !got[seed.ApplicationIDs[i]] {
+					t.Errorf("application %d is missing", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicationReview holds the builder's private notes, rating and tags on an application.
+// It is kept out of JobApplication so it can never reach the labourer.
+type ApplicationReview struct {
+	ApplicationID    uuid.UUID              `json:"application_id" gorm:"type:uuid;primary_key"`
+	BuilderProfileID uuid.UUID              `json:"builder_profile_id" gorm:"type:uuid;not null"`
+	Notes            *string                `json:"notes" gorm:"type:text"`
+	Rating           *int                   `json:"rating" gorm:"type:smallint"` // 1 to 5
+	Tags             []ApplicationReviewTag `json:"tags" gorm:"foreignKey:ApplicationID;references:ApplicationID"`
+	CreatedAt        time.Time              `json:"created_at" gorm:"not null;type:timestamptz"`
+	UpdatedAt        time.Time              `json:"updated_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the ApplicationReview model
+func (ApplicationReview) TableName() string {
+	return "application_reviews"
+}
+
+// TagNames returns the tags of the review
+func (r *ApplicationReview) TagNames() []string {
+	names := make([]string, 0, len(r.Tags))
+	for _, tag := range r.Tags {
+		names = append(names, tag.Tag)
+	}
+	return names
+}
+
+// ApplicationReviewTag is one tag a builder put on an application
+type ApplicationReviewTag struct {
+	ApplicationID uuid.UUID `json:"application_id" gorm:"type:uuid;primary_key"`
+	Tag           string    `json:"tag" gorm:"type:varchar(30);primary_key"`
+}
+
+// TableName returns the table name for the ApplicationReviewTag model
+func (ApplicationReviewTag) TableName() string {
+	return "application_review_tags"
+}
@@ -6,21 +6,82 @@ type ApplicationStatus string
 const (
 	ApplicationStatusApplied   ApplicationStatus = "APPLIED"
 	ApplicationStatusReviewed  ApplicationStatus = "REVIEWED"
+	ApplicationStatusOffered   ApplicationStatus = "OFFERED" // the builder sent an offer the labourer has not answered yet
 	ApplicationStatusAccepted  ApplicationStatus = "ACCEPTED"
+	ApplicationStatusDeclined  ApplicationStatus = "DECLINED" // the labourer turned the offer down
+	ApplicationStatusExpired   ApplicationStatus = "EXPIRED"  // the offer was not answered before its deadline
 	ApplicationStatusRejected  ApplicationStatus = "REJECTED"
 	ApplicationStatusWithdrawn ApplicationStatus = "WITHDRAWN"
 )
 
+// ApplicationActor is who changes the status of an application
+type ApplicationActor string
+
+const (
+	ApplicationActorBuilder ApplicationActor = "BUILDER"
+	ApplicationActorLabour  ApplicationActor = "LABOUR"
+	ApplicationActorSystem  ApplicationActor = "SYSTEM" // changes made by the backend itself
+)
+
+// applicationStatusTransitions lists the statuses each status may move to and who may move it there.
+// The builder reviews, offers and rejects; the labourer accepts or declines offers and withdraws.
+// Only an accepted offer hires the labourer. ACCEPTED can still be withdrawn; the others are final.
+var applicationStatusTransitions = map[ApplicationStatus]map[ApplicationStatus][]ApplicationActor{
+	ApplicationStatusApplied: {
+		ApplicationStatusReviewed:  {ApplicationActorBuilder},
+		ApplicationStatusOffered:   {ApplicationActorBuilder},
+		ApplicationStatusRejected:  {ApplicationActorBuilder, ApplicationActorSystem},
+		ApplicationStatusWithdrawn: {ApplicationActorLabour},
+	},
+	ApplicationStatusReviewed: {
+		ApplicationStatusOffered:   {ApplicationActorBuilder},
+		ApplicationStatusRejected:  {ApplicationActorBuilder, ApplicationActorSystem},
+		ApplicationStatusWithdrawn: {ApplicationActorLabour},
+	},
+	ApplicationStatusOffered: {
+		ApplicationStatusAccepted:  {ApplicationActorLabour},
+		ApplicationStatusDeclined:  {ApplicationActorLabour},
+		ApplicationStatusExpired:   {ApplicationActorSystem},
+		ApplicationStatusRejected:  {ApplicationActorBuilder}, // the builder takes the offer back
+		ApplicationStatusWithdrawn: {ApplicationActorLabour},
+	},
+	ApplicationStatusAccepted: {
+		ApplicationStatusWithdrawn: {ApplicationActorLabour},
+	},
+}
+
 // IsValid checks if the application status is valid
 func (s ApplicationStatus) IsValid() bool {
 	switch s {
-	case ApplicationStatusApplied, ApplicationStatusReviewed, ApplicationStatusAccepted, ApplicationStatusRejected, ApplicationStatusWithdrawn:
+	case ApplicationStatusApplied, ApplicationStatusReviewed, ApplicationStatusOffered, ApplicationStatusAccepted,
+		ApplicationStatusDeclined, ApplicationStatusExpired, ApplicationStatusRejected, ApplicationStatusWithdrawn:
 		return true
 	default:
 		return false
 	}
 }
 
+// CanTransitionTo reports whether an application may move from this status to next
+func (s ApplicationStatus) CanTransitionTo(next ApplicationStatus) bool {
+	_, ok := applicationStatusTransitions[s][next]
+	return ok
+}
+
+// AllowsActor reports whether actor may move an application from this status to next
+func (s ApplicationStatus) AllowsActor(next ApplicationStatus, actor ApplicationActor) bool {
+	for _, allowed := range applicationStatusTransitions[s][next] {
+		if allowed == actor {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinal reports whether the application can no longer change status
+func (s ApplicationStatus) IsFinal() bool {
+	return len(applicationStatusTransitions[s]) == 0
+}
+
 // String returns the string representation of the status
 func (s ApplicationStatus) String() string {
 	return string(s)
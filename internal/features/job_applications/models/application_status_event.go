@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicationStatusEvent records one status change of an application, including its creation
+type ApplicationStatusEvent struct {
+	ID            uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ApplicationID uuid.UUID          `json:"application_id" gorm:"type:uuid;not null;index"`
+	FromStatus    *ApplicationStatus `json:"from_status" gorm:"type:varchar(20)"` // nil when the application was created
+	ToStatus      ApplicationStatus  `json:"to_status" gorm:"type:varchar(20);not null"`
+	Actor         ApplicationActor   `json:"actor" gorm:"type:varchar(20);not null"`
+	ActorID       *uuid.UUID         `json:"actor_id" gorm:"type:uuid"` // labour user ID or builder profile ID, nil for SYSTEM
+	Reason        *string            `json:"reason" gorm:"type:text"`
+	CreatedAt     time.Time          `json:"created_at" gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the table name for the ApplicationStatusEvent model
+func (ApplicationStatusEvent) TableName() string {
+	return "application_status_events"
+}
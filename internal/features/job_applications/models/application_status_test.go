@@ -0,0 +1,101 @@
+package models
+
+import "testing"
+
+var allApplicationStatuses = []ApplicationStatus{
+	ApplicationStatusApplied, ApplicationStatusReviewed, ApplicationStatusOffered, ApplicationStatusAccepted,
+	ApplicationStatusDeclined, ApplicationStatusExpired, ApplicationStatusRejected, ApplicationStatusWithdrawn,
+}
+
+var allApplicationActors = []ApplicationActor{ApplicationActorBuilder, ApplicationActorLabour, ApplicationActorSystem}
+
+func TestApplicationStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from, to ApplicationStatus
+		actor    ApplicationActor
+		want     bool
+	}{
+		{ApplicationStatusApplied, ApplicationStatusReviewed, ApplicationActorBuilder, true},
+		{ApplicationStatusApplied, ApplicationStatusOffered, ApplicationActorBuilder, true},
+		{ApplicationStatusReviewed, ApplicationStatusOffered, ApplicationActorBuilder, true},
+		{ApplicationStatusOffered, ApplicationStatusAccepted, ApplicationActorLabour, true},
+		{ApplicationStatusOffered, ApplicationStatusDeclined, ApplicationActorLabour, true},
+		{ApplicationStatusOffered, ApplicationStatusExpired, ApplicationActorSystem, true},
+		{ApplicationStatusOffered, ApplicationStatusRejected, ApplicationActorBuilder, true},
+		{ApplicationStatusApplied, ApplicationStatusRejected, ApplicationActorSystem, true},
+		{ApplicationStatusAccepted, ApplicationStatusWithdrawn, ApplicationActorLabour, true},
+
+		// Only an answered offer hires the labourer
+		{ApplicationStatusApplied, ApplicationStatusAccepted, ApplicationActorLabour, false},
+		{ApplicationStatusReviewed, ApplicationStatusAccepted, ApplicationActorLabour, false},
+		{ApplicationStatusWithdrawn, ApplicationStatusAccepted, ApplicationActorLabour, false},
+		{ApplicationStatusRejected, ApplicationStatusAccepted, ApplicationActorLabour, false},
+		{ApplicationStatusDeclined, ApplicationStatusAccepted, ApplicationActorLabour, false},
+		{ApplicationStatusExpired, ApplicationStatusAccepted, ApplicationActorLabour, false},
+		{ApplicationStatusWithdrawn, ApplicationStatusApplied, ApplicationActorLabour, false},
+		{ApplicationStatusAccepted, ApplicationStatusRejected, ApplicationActorBuilder, false},
+
+		// The right transition by the wrong party
+		{ApplicationStatusApplied, ApplicationStatusReviewed, ApplicationActorLabour, false},
+		{ApplicationStatusApplied, ApplicationStatusOffered, ApplicationActorSystem, false},
+		{ApplicationStatusApplied, ApplicationStatusRejected, ApplicationActorLabour, false},
+		{ApplicationStatusOffered, ApplicationStatusAccepted, ApplicationActorBuilder, false},
+		{ApplicationStatusOffered, ApplicationStatusDeclined, ApplicationActorBuilder, false},
+		{ApplicationStatusApplied, ApplicationStatusWithdrawn, ApplicationActorBuilder, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to)+" by "+string(tt.actor), func(t *testing.T) {
+			if got := tt.from.AllowsActor(tt.to, tt.actor); got != tt.want {
+				t.Errorf("AllowsActor() = %v, want %v", got, tt.want)
+			}
+			if tt.want && !tt.from.CanTransitionTo(tt.to) {
+				t.Errorf("CanTransitionTo() = false for an allowed transition")
+			}
+		})
+	}
+}
+
+// TestApplicationStatusActors checks every transition in the table against who may make it:
+// reviews, offers and rejections come from the builder (or the backend rejecting), answers
+// to an offer and withdrawals from the labourer.
+func TestApplicationStatusActors(t *testing.T) {
+	allowed := map[ApplicationStatus][]ApplicationActor{
+		ApplicationStatusReviewed:  {ApplicationActorBuilder},
+		ApplicationStatusOffered:   {ApplicationActorBuilder},
+		ApplicationStatusRejected:  {ApplicationActorBuilder, ApplicationActorSystem},
+		ApplicationStatusAccepted:  {ApplicationActorLabour},
+		ApplicationStatusDeclined:  {ApplicationActorLabour},
+		ApplicationStatusWithdrawn: {ApplicationActorLabour},
+		ApplicationStatusExpired:   {ApplicationActorSystem},
+	}
+	for _, from := range allApplicationStatuses {
+		for _, to := range allApplicationStatuses {
+			if !from.CanTransitionTo(to) {
+				continue
+			}
+			for _, actor := range allApplicationActors {
+				want := false
+				for _, a := range allowed[to] {
+					want = want || a == actor
+				}
+				if got := from.AllowsActor(to, actor); got && !want {
+					t.Errorf("%s -> %s allowed for %s", from, to, actor)
+				}
+			}
+		}
+	}
+}
+
+func TestApplicationStatusIsFinal(t *testing.T) {
+	final := map[ApplicationStatus]bool{
+		ApplicationStatusDeclined:  true,
+		ApplicationStatusExpired:   true,
+		ApplicationStatusRejected:  true,
+		ApplicationStatusWithdrawn: true,
+	}
+	for _, status := range allApplicationStatuses {
+		if got := status.IsFinal(); got != final[status] {
+			t.Errorf("%s.IsFinal() = %v, want %v", status, got, final[status])
+		}
+	}
+}
@@ -18,6 +18,19 @@ type JobApplication struct {
 	CreatedAt    time.Time         `json:"created_at" gorm:"not null;type:timestamptz"`
 	UpdatedAt    time.Time         `json:"updated_at" gorm:"not null;type:timestamptz"`
 	WithdrawnAt  *time.Time        `json:"withdrawn_at" gorm:"type:timestamptz"`
+	JobChangedAt *time.Time        `json:"job_changed_at" gorm:"type:timestamptz"` // set by a material job edit until the labourer confirms
+
+	// Offer sent by the builder; set from the moment the application is OFFERED
+	OfferStartDate *time.Time `json:"offer_start_date" gorm:"type:date"`
+	OfferEndDate   *time.Time `json:"offer_end_date" gorm:"type:date"`
+	OfferRate      *float64   `json:"offer_rate" gorm:"type:decimal(12,2)"` // final hourly rate
+	OfferedAt      *time.Time `json:"offered_at" gorm:"type:timestamptz"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at" gorm:"type:timestamptz"` // the labourer must answer before it
+}
+
+// OfferPending reports whether the application holds an offer that can still be accepted
+func (a *JobApplication) OfferPending(now time.Time) bool {
+	return a.Status == ApplicationStatusOffered && a.OfferExpiresAt != nil && now.Before(*a.OfferExpiresAt)
 }
 
 // TableName returns the table name for the JobApplication model
@@ -12,10 +12,15 @@ type CreateJobApplicationRequest struct {
 
 // UpdateJobApplicationRequest represents the request to update a job application
 type UpdateJobApplicationRequest struct {
-	Status       *models.ApplicationStatus `json:"status" validate:"omitempty,oneof=APPLIED REVIEWED ACCEPTED REJECTED WITHDRAWN"`
-	CoverLetter  *string                   `json:"cover_letter"`
-	ExpectedRate *float64                  `json:"expected_rate" validate:"omitempty,min=0"`
-	ResumeURL    *string                   `json:"resume_url" validate:"omitempty,url"`
+	CoverLetter  *string  `json:"cover_letter"`
+	ExpectedRate *float64 `json:"expected_rate" validate:"omitempty,min=0"`
+	ResumeURL    *string  `json:"resume_url" validate:"omitempty,url"`
+}
+
+// UpdateApplicationStatusRequest represents the request to move an application to a new status
+type UpdateApplicationStatusRequest struct {
+	Status models.ApplicationStatus `json:"status" validate:"required,oneof=REVIEWED REJECTED WITHDRAWN"`
+	Reason *string                  `json:"reason"`
 }
 
 // GetJobApplicationsRequest represents the request to get job applications with filters
@@ -8,16 +8,26 @@ import (
 
 // JobApplicationResponse represents the response for a job application
 type JobApplicationResponse struct {
-	ID           string                   `json:"id"`
-	JobID        string                   `json:"job_id"`
-	LabourUserID string                   `json:"labour_user_id"`
-	Status       models.ApplicationStatus `json:"status"`
-	CoverLetter  *string                  `json:"cover_letter"`
-	ExpectedRate *float64                 `json:"expected_rate"`
-	ResumeURL    *string                  `json:"resume_url"`
-	CreatedAt    time.Time                `json:"created_at"`
-	UpdatedAt    time.Time                `json:"updated_at"`
-	WithdrawnAt  *time.Time               `json:"withdrawn_at"`
+	ID           string                     `json:"id"`
+	JobID        string                     `json:"job_id"`
+	LabourUserID string                     `json:"labour_user_id"`
+	Status       models.ApplicationStatus   `json:"status"`
+	CoverLetter  *string                    `json:"cover_letter"`
+	ExpectedRate *float64                   `json:"expected_rate"`
+	ResumeURL    *string                    `json:"resume_url"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	UpdatedAt    time.Time                  `json:"updated_at"`
+	WithdrawnAt  *time.Time                 `json:"withdrawn_at"`
+	Timeline     []ApplicationEventResponse `json:"timeline,omitempty"`
+}
+
+// ApplicationEventResponse represents one status change in the timeline of an application
+type ApplicationEventResponse struct {
+	FromStatus *models.ApplicationStatus `json:"from_status"` // nil for the creation of the application
+	ToStatus   models.ApplicationStatus  `json:"to_status"`
+	Actor      models.ApplicationActor   `json:"actor"`
+	Reason     *string                   `json:"reason,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at"`
 }
 
 // CreateJobApplicationResponse represents the response when creating a job application
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/job_applications/entity/database"
+	"github.com/yakka-backend/internal/features/job_applications/models"
+)
+
+var (
+	// ErrInvalidTransition is returned when the transition table does not allow a status change,
+	// or when the application's status changed since it was read
+	ErrInvalidTransition = errors.New("invalid status transition")
+
+	// ErrTransitionNotAllowed is returned when a transition exists but the actor may not make it
+	ErrTransitionNotAllowed = errors.New("status change not allowed")
+)
+
+// TransitionApplication moves an application to the next status when the transition table
+// allows it for the actor, and records the change in the application's status history.
+// Run it inside a unit of work so the status and its event are stored together. The status
+// only changes if it is still the one read: when someone else moved the application first the
+// transition is refused as invalid.
+func TransitionApplication(ctx context.Context, applications database.JobApplicationRepository, events database.ApplicationStatusEventRepository, application *models.JobApplication, next models.ApplicationStatus, actor models.ApplicationActor, actorID *uuid.UUID, reason *string) error {
+	if !application.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w from %s to %s", ErrInvalidTransition, application.Status, next)
+	}
+	if !application.Status.AllowsActor(next, actor) {
+		return fmt.Errorf("%w: %s cannot move an application from %s to %s", ErrTransitionNotAllowed, actor, application.Status, next)
+	}
+
+	updated, err := applications.UpdateStatus(ctx, application.ID, application.Status, next)
+	if err != nil {
+		return fmt.Errorf("failed to update application status: %w", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w from %s to %s: the application was changed meanwhile", ErrInvalidTransition, application.Status, next)
+	}
+
+	now := time.Now()
+	from := application.Status
+	event := &models.ApplicationStatusEvent{
+		ApplicationID: application.ID,
+		FromStatus:    &from,
+		ToStatus:      next,
+		Actor:         actor,
+		ActorID:       actorID,
+		Reason:        reason,
+		CreatedAt:     now,
+	}
+	if err := events.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to record application status change: %w", err)
+	}
+	log.Printf("🔄 Application %s moved from %s to %s by %s", application.ID, from, next, actor)
+
+	application.Status = next
+	application.UpdatedAt = now
+	if next == models.ApplicationStatusWithdrawn {
+		application.WithdrawnAt = &now
+	}
+	return nil
+}
+
+// RecordApplicationCreated records the creation of an application by its labourer as the
+// first event of its status history
+func RecordApplicationCreated(ctx context.Context, events database.ApplicationStatusEventRepository, application *models.JobApplication) error {
+	labourUserID := application.LabourUserID
+	event := &models.ApplicationStatusEvent{
+		ApplicationID: application.ID,
+		ToStatus:      application.Status,
+		Actor:         models.ApplicationActorLabour,
+		ActorID:       &labourUserID,
+		CreatedAt:     application.CreatedAt,
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	if err := events.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to record application creation: %w", err)
+	}
+	return nil
+}
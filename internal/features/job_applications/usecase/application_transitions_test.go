@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/job_applications/entity/database"
+	"github.com/yakka-backend/internal/features/job_applications/models"
+)
+
+// statusStore keeps application statuses and applies UpdateStatus the way the database does
+type statusStore struct {
+	database.JobApplicationRepository
+	statuses map[uuid.UUID]models.ApplicationStatus
+}
+
+func (s *statusStore) UpdateStatus(ctx context.Context, id uuid.UUID, from, to models.ApplicationStatus) (int64, error) {
+	if s.statuses[id] != from {
+		return 0, nil
+	}
+	s.statuses[id] = to
+	return 1, nil
+}
+
+// eventLog remembers the recorded status changes
+type eventLog struct {
+	database.ApplicationStatusEventRepository
+	events []*models.ApplicationStatusEvent
+}
+
+func (l *eventLog) Create(ctx context.Context, event *models.ApplicationStatusEvent) error {
+	l.events = append(l.events, event)
+	return nil
+}
+
+func TestTransitionApplicationRefusesStaleStatus(t *testing.T) {
+	id := uuid.New()
+	store := &statusStore{statuses: map[uuid.UUID]models.ApplicationStatus{id: models.ApplicationStatusApplied}}
+	events := &eventLog{}
+	ctx := context.Background()
+
+	// The builder and the labourer both read the application while it is APPLIED
+	builderCopy := &models.JobApplication{ID: id, Status: models.ApplicationStatusApplied}
+	labourCopy := &models.JobApplication{ID: id, Status: models.ApplicationStatusApplied}
+
+	builderID := uuid.New()
+	if err := TransitionApplication(ctx, store, events, builderCopy, models.ApplicationStatusRejected, models.ApplicationActorBuilder, &builderID, nil); err != nil {
+		t.Fatalf("reject: %v", err)
+	}
+
+	labourID := uuid.New()
+	err := TransitionApplication(ctx, store, events, labourCopy, models.ApplicationStatusWithdrawn, models.ApplicationActorLabour, &labourID, nil)
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("withdraw error = %v, want an invalid status transition", err)
+	}
+
+	if got := store.statuses[id]; got != models.ApplicationStatusRejected {
+		t.Errorf("status = %s, want REJECTED", got)
+	}
+	if labourCopy.Status != models.ApplicationStatusApplied || labourCopy.WithdrawnAt != nil {
+		t.Errorf("refused transition changed the application to %s", labourCopy.Status)
+	}
+	if len(events.events) != 1 || events.events[0].ToStatus != models.ApplicationStatusRejected {
+		t.Errorf("recorded %d events, want only the rejection", len(events.events))
+	}
+}
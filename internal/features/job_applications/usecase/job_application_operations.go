@@ -20,19 +20,24 @@ type JobApplicationUsecase interface {
 	GetApplicationsByJob(ctx context.Context, jobID uuid.UUID, page, limit int) ([]*models.JobApplication, int64, error)
 	GetApplicationsByLabourUser(ctx context.Context, labourUserID uuid.UUID, page, limit int) ([]*models.JobApplication, int64, error)
 	GetApplicationsWithFilters(ctx context.Context, req payload.GetJobApplicationsRequest) ([]*models.JobApplication, int64, error)
-	UpdateApplicationStatus(ctx context.Context, id uuid.UUID, status models.ApplicationStatus) (*models.JobApplication, error)
-	WithdrawApplication(ctx context.Context, id uuid.UUID) error
+	UpdateApplicationStatus(ctx context.Context, id uuid.UUID, status models.ApplicationStatus, actor models.ApplicationActor, actorID *uuid.UUID, reason *string) (*models.JobApplication, error)
+	WithdrawApplication(ctx context.Context, id uuid.UUID, labourUserID uuid.UUID, reason *string) error
+	GetApplicationTimeline(ctx context.Context, id uuid.UUID) ([]*models.ApplicationStatusEvent, error)
 }
 
 // JobApplicationUsecaseImpl implements JobApplicationUsecase
 type JobApplicationUsecaseImpl struct {
 	applicationRepo database.JobApplicationRepository
+	eventRepo       database.ApplicationStatusEventRepository
+	uow             database.ApplicationUnitOfWork
 }
 
 // NewJobApplicationUsecase creates a new job application usecase
-func NewJobApplicationUsecase(applicationRepo database.JobApplicationRepository) JobApplicationUsecase {
+func NewJobApplicationUsecase(applicationRepo database.JobApplicationRepository, eventRepo database.ApplicationStatusEventRepository, uow database.ApplicationUnitOfWork) JobApplicationUsecase {
 	return &JobApplicationUsecaseImpl{
 		applicationRepo: applicationRepo,
+		eventRepo:       eventRepo,
+		uow:             uow,
 	}
 }
 
@@ -63,8 +68,14 @@ func (u *JobApplicationUsecaseImpl) CreateApplication(ctx context.Context, labou
 		ResumeURL:    req.ResumeURL,
 	}
 
-	if err := u.applicationRepo.Create(ctx, application); err != nil {
-		return nil, fmt.Errorf("failed to create application: %w", err)
+	err = u.uow.Do(ctx, func(repos database.ApplicationTxRepositories) error {
+		if err := repos.Applications.Create(ctx, application); err != nil {
+			return fmt.Errorf("failed to create application: %w", err)
+		}
+		return RecordApplicationCreated(ctx, repos.Events, application)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return application, nil
@@ -87,10 +98,8 @@ func (u *JobApplicationUsecaseImpl) UpdateApplication(ctx context.Context, id uu
 		return nil, fmt.Errorf("failed to get application: %w", err)
 	}
 
-	// Update fields if provided
-	if req.Status != nil {
-		application.Status = *req.Status
-	}
+	// Update fields if provided. The status only changes through UpdateApplicationStatus
+	// and WithdrawApplication, which check the transition table.
 	if req.CoverLetter != nil {
 		application.CoverLetter = req.CoverLetter
 	}
@@ -101,10 +110,14 @@ func (u *JobApplicationUsecaseImpl) UpdateApplication(ctx context.Context, id uu
 		application.ResumeURL = req.ResumeURL
 	}
 
-	// Save changes
-	if err := u.applicationRepo.Update(ctx, application); err != nil {
+	// Only applications still APPLIED can be edited
+	updated, err := u.applicationRepo.UpdateDetails(ctx, application)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update application: %w", err)
 	}
+	if updated == 0 {
+		return nil, fmt.Errorf("application can no longer be edited")
+	}
 
 	return application, nil
 }
@@ -206,46 +219,64 @@ func (u *JobApplicationUsecaseImpl) GetApplicationsWithFilters(ctx context.Conte
 	return applications, total, nil
 }
 
-// UpdateApplicationStatus updates the status of a job application
-func (u *JobApplicationUsecaseImpl) UpdateApplicationStatus(ctx context.Context, id uuid.UUID, status models.ApplicationStatus) (*models.JobApplication, error) {
+// UpdateApplicationStatus moves a job application to a new status on behalf of an actor.
+// Labour actors may only change their own applications; callers acting for a builder must
+// check the builder owns the job first.
+func (u *JobApplicationUsecaseImpl) UpdateApplicationStatus(ctx context.Context, id uuid.UUID, status models.ApplicationStatus, actor models.ApplicationActor, actorID *uuid.UUID, reason *string) (*models.JobApplication, error) {
 	// Validate status
 	if !status.IsValid() {
 		return nil, fmt.Errorf("invalid status")
 	}
-
-	// Update status
-	if err := u.applicationRepo.UpdateStatus(ctx, id, status); err != nil {
-		return nil, fmt.Errorf("failed to update status: %w", err)
+	// Offers carry their terms and hold a place on the job, so they are only sent and
+	// accepted through the jobs feature
+	if status == models.ApplicationStatusOffered || status == models.ApplicationStatusAccepted {
+		return nil, fmt.Errorf("%w: offers are sent and accepted through the job applicant endpoints", ErrTransitionNotAllowed)
 	}
 
-	// Get updated application
-	application, err := u.applicationRepo.GetByID(ctx, id)
+	var application *models.JobApplication
+	err := u.uow.Do(ctx, func(repos database.ApplicationTxRepositories) error {
+		var err error
+		application, err = repos.Applications.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("application not found: %w", err)
+		}
+		if actor == models.ApplicationActorLabour && (actorID == nil || application.LabourUserID != *actorID) {
+			return fmt.Errorf("application not found")
+		}
+		return TransitionApplication(ctx, repos.Applications, repos.Events, application, status, actor, actorID, reason)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get updated application: %w", err)
+		return nil, err
 	}
 
 	return application, nil
 }
 
-// WithdrawApplication withdraws an application
-func (u *JobApplicationUsecaseImpl) WithdrawApplication(ctx context.Context, id uuid.UUID) error {
-	// Check if application exists
-	application, err := u.applicationRepo.GetByID(ctx, id)
-	if err != nil {
-		return fmt.Errorf("application not found: %w", err)
-	}
+// WithdrawApplication withdraws an application on behalf of the labourer who made it
+func (u *JobApplicationUsecaseImpl) WithdrawApplication(ctx context.Context, id uuid.UUID, labourUserID uuid.UUID, reason *string) error {
+	return u.uow.Do(ctx, func(repos database.ApplicationTxRepositories) error {
+		// Check if application exists
+		application, err := repos.Applications.GetByID(ctx, id)
+		if err != nil || application.LabourUserID != labourUserID {
+			return fmt.Errorf("application not found")
+		}
 
-	// Check if already withdrawn
-	if application.Status == models.ApplicationStatusWithdrawn {
-		return fmt.Errorf("application already withdrawn")
-	}
+		// Check if already withdrawn
+		if application.Status == models.ApplicationStatusWithdrawn {
+			return fmt.Errorf("application already withdrawn")
+		}
 
-	// Withdraw application
-	if err := u.applicationRepo.WithdrawApplication(ctx, id); err != nil {
-		return fmt.Errorf("failed to withdraw application: %w", err)
-	}
+		return TransitionApplication(ctx, repos.Applications, repos.Events, application, models.ApplicationStatusWithdrawn, models.ApplicationActorLabour, &labourUserID, reason)
+	})
+}
 
-	return nil
+// GetApplicationTimeline retrieves the status history of an application, oldest first
+func (u *JobApplicationUsecaseImpl) GetApplicationTimeline(ctx context.Context, id uuid.UUID) ([]*models.ApplicationStatusEvent, error) {
+	events, err := u.eventRepo.GetByApplicationID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application timeline: %w", err)
+	}
+	return events, nil
 }
 
 // Helper function to calculate total pages
@@ -3,13 +3,20 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	builder_db "github.com/yakka-backend/internal/features/builder_profiles/entity/database"
 	builder_models "github.com/yakka-backend/internal/features/builder_profiles/models"
+	job_application_usecase "github.com/yakka-backend/internal/features/job_applications/usecase"
 	"github.com/yakka-backend/internal/features/jobs/models"
 	"github.com/yakka-backend/internal/features/jobs/payload"
 	"github.com/yakka-backend/internal/features/jobs/usecase"
@@ -340,8 +347,14 @@ func (h *JobHandler) GetBuilderApplicants(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	filter, err := parseBuilderApplicantFilter(r)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	// Get applicants for builder's jobs grouped by jobsite
-	jobsitesWithJobs, err := h.jobUsecase.GetBuilderApplicantsByJobsite(r.Context(), builderProfile.ID)
+	jobsitesWithJobs, err := h.jobUsecase.GetBuilderApplicantsByJobsite(r.Context(), builderProfile.ID, filter)
 	if err != nil {
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get applicants")
 		return
@@ -362,6 +375,180 @@ func (h *JobHandler) GetBuilderApplicants(w http.ResponseWriter, r *http.Request
 	response.WriteJSON(w, http.StatusOK, resp)
 }
 
+// BulkApplicantDecision hires and rejects many applicants of one of the builder's jobs at once
+func (h *JobHandler) BulkApplicantDecision(w http.ResponseWriter, r *http.Request) {
+	jobID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
+	// Get builder profile ID from context (set by AuthMiddleware, route guarded by RequireRole)
+	builderProfileIDStr, ok := r.Context().Value(middleware.BuilderProfileIDKey).(string)
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "Builder profile ID not found in context")
+		return
+	}
+
+	builderProfileID, err := uuid.Parse(builderProfileIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid builder profile ID format")
+		return
+	}
+
+	var req payload.BulkApplicantDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.jobUsecase.BulkApplicantDecision(r.Context(), builderProfileID, jobID, req)
+	if err != nil {
+		if err.Error() == "job not found" {
+			response.WriteError(w, http.StatusNotFound, "Job not found")
+			return
+		}
+		log.Printf("🚫 BulkApplicantDecision - Failed for job %s: %v", jobID, err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to process applicant decisions")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// ShortlistApplicant moves an applicant of one of the builder's jobs to REVIEWED
+func (h *JobHandler) ShortlistApplicant(w http.ResponseWriter, r *http.Request) {
+	applicationID, builderProfileID, ok := builderApplicationIDs(w, r)
+	if !ok {
+		return
+	}
+
+	// The reason is optional, so is the body
+	var req payload.ShortlistApplicantRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.jobUsecase.ShortlistApplicant(r.Context(), builderProfileID, applicationID, req)
+	if err != nil {
+		writeBuilderApplicationError(w, err, "Failed to shortlist applicant")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// ReviewApplicant saves the builder's private notes, rating and tags on an applicant
+func (h *JobHandler) ReviewApplicant(w http.ResponseWriter, r *http.Request) {
+	applicationID, builderProfileID, ok := builderApplicationIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req payload.ApplicantReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.jobUsecase.ReviewApplicant(r.Context(), builderProfileID, applicationID, req)
+	if err != nil {
+		writeBuilderApplicationError(w, err, "Failed to save applicant review")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// builderApplicationIDs reads the application ID from the path and the builder profile ID from
+// the context, writing the error response when either is missing
+func builderApplicationIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	applicationID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid application ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	// Get builder profile ID from context (set by AuthMiddleware, route guarded by RequireRole)
+	builderProfileIDStr, ok := r.Context().Value(middleware.BuilderProfileIDKey).(string)
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "Builder profile ID not found in context")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	builderProfileID, err := uuid.Parse(builderProfileIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid builder profile ID format")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return applicationID, builderProfileID, true
+}
+
+// writeBuilderApplicationError maps a failed builder applicant request to its HTTP status
+func writeBuilderApplicationError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case err.Error() == "application not found":
+		response.WriteError(w, http.StatusNotFound, "Application not found")
+	case errors.Is(err, job_application_usecase.ErrInvalidTransition):
+		response.WriteError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, job_application_usecase.ErrTransitionNotAllowed):
+		response.WriteError(w, http.StatusForbidden, err.Error())
+	default:
+		log.Printf("🚫 Builder applicant request failed: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// parseBuilderApplicantFilter reads the applicant filters from the query string
+func parseBuilderApplicantFilter(r *http.Request) (payload.BuilderApplicantFilter, error) {
+	query := r.URL.Query()
+	var filter payload.BuilderApplicantFilter
+
+	if status := query.Get("status"); status != "" {
+		filter.Status = &status
+	}
+	if minRating := query.Get("min_rating"); minRating != "" {
+		value, err := strconv.Atoi(minRating)
+		if err != nil {
+			return filter, fmt.Errorf("invalid min_rating")
+		}
+		filter.MinRating = &value
+	}
+	if tags := query.Get("tags"); tags != "" {
+		filter.Tags = strings.Split(tags, ",")
+	}
+	if notes := strings.TrimSpace(query.Get("notes")); notes != "" {
+		filter.Notes = &notes
+	}
+
+	if err := validation.ValidateStruct(filter); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
 // ProcessApplicantDecision processes hiring or rejection of an applicant
 func (h *JobHandler) ProcessApplicantDecision(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context (set by auth middleware)
@@ -399,6 +586,26 @@ func (h *JobHandler) ProcessApplicantDecision(w http.ResponseWriter, r *http.Req
 	// Process the decision
 	result, err := h.jobUsecase.ProcessApplicantDecision(r.Context(), builderProfile.ID, req)
 	if err != nil {
+		if err.Error() == "job is not open for hiring" {
+			response.WriteError(w, http.StatusConflict, "Job is not open for hiring")
+			return
+		}
+		if err.Error() == "job has no places left" {
+			response.WriteError(w, http.StatusConflict, "Job has no places left")
+			return
+		}
+		if errors.Is(err, usecase.ErrInvalidOffer) {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, job_application_usecase.ErrInvalidTransition) {
+			response.WriteError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, job_application_usecase.ErrTransitionNotAllowed) {
+			response.WriteError(w, http.StatusForbidden, err.Error())
+			return
+		}
 		response.WriteError(w, http.StatusInternalServerError, "Failed to process applicant decision")
 		return
 	}
@@ -406,7 +613,7 @@ func (h *JobHandler) ProcessApplicantDecision(w http.ResponseWriter, r *http.Req
 	response.WriteJSON(w, http.StatusOK, result)
 }
 
-// GetLabourJobs retrieves all jobs with application status for a labour user
+// GetLabourJobs searches open public jobs with application status for a labour user
 func (h *JobHandler) GetLabourJobs(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context (set by auth middleware)
 	userIDStr, ok := r.Context().Value(middleware.UserIDKey).(string)
@@ -421,22 +628,177 @@ func (h *JobHandler) GetLabourJobs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get jobs with application status for this labour user
-	jobs, err := h.jobUsecase.GetLabourJobs(r.Context(), userID)
+	req, err := parseLabourJobSearchRequest(r)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Search jobs with application status for this labour user
+	resp, err := h.jobUsecase.GetLabourJobs(r.Context(), userID, req)
 	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid search") {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Printf("❌ Failed to search jobs: %v", err)
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get jobs")
 		return
 	}
 
-	resp := payload.LabourJobsResponse{
-		Jobs:    jobs,
-		Total:   len(jobs),
-		Message: "Jobs retrieved successfully",
+	response.WriteJSON(w, http.StatusOK, resp)
+}
+
+// GetRecommendedJobs lists the open jobs that best match the labour user's skills, licenses and qualifications
+func (h *JobHandler) GetRecommendedJobs(w http.ResponseWriter, r *http.Request) {
+	// Get user ID from context (set by auth middleware)
+	userIDStr, ok := r.Context().Value(middleware.UserIDKey).(string)
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			response.WriteError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	resp, err := h.jobUsecase.GetRecommendedJobs(r.Context(), userID, limit)
+	if err != nil {
+		log.Printf("❌ Failed to get recommended jobs: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to get recommended jobs")
+		return
 	}
 
 	response.WriteJSON(w, http.StatusOK, resp)
 }
 
+// parseLabourJobSearchRequest reads the job search filters from the query string
+func parseLabourJobSearchRequest(r *http.Request) (payload.LabourJobSearchRequest, error) {
+	query := r.URL.Query()
+	req := payload.LabourJobSearchRequest{
+		Sort:   query.Get("sort"),
+		Cursor: query.Get("cursor"),
+	}
+
+	var err error
+	for key, target := range map[string]**uuid.UUID{
+		"job_type_id":          &req.JobTypeID,
+		"skill_category_id":    &req.SkillCategoryID,
+		"skill_subcategory_id": &req.SkillSubcategoryID,
+		"license_id":           &req.LicenseID,
+	} {
+		if *target, err = queryUUID(query, key); err != nil {
+			return req, err
+		}
+	}
+	for key, target := range map[string]**float64{
+		"min_wage":  &req.MinWage,
+		"max_wage":  &req.MaxWage,
+		"lat":       &req.Latitude,
+		"lng":       &req.Longitude,
+		"radius_km": &req.RadiusKm,
+	} {
+		if *target, err = queryFloat(query, key); err != nil {
+			return req, err
+		}
+	}
+	for key, target := range map[string]**time.Time{
+		"start_from": &req.StartFrom,
+		"start_to":   &req.StartTo,
+	} {
+		if *target, err = queryDate(query, key); err != nil {
+			return req, err
+		}
+	}
+	for key, target := range map[string]**bool{
+		"work_saturday": &req.WorkSaturday,
+		"work_sunday":   &req.WorkSunday,
+	} {
+		if *target, err = queryBool(query, key); err != nil {
+			return req, err
+		}
+	}
+
+	if paymentType := query.Get("payment_type"); paymentType != "" {
+		value := models.PaymentType(paymentType)
+		req.PaymentType = &value
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if req.Limit, err = strconv.Atoi(limit); err != nil {
+			return req, fmt.Errorf("invalid limit")
+		}
+	}
+
+	return req, nil
+}
+
+// queryUUID reads an optional UUID query parameter
+func queryUUID(query url.Values, key string) (*uuid.UUID, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", key)
+	}
+	return &id, nil
+}
+
+// queryFloat reads an optional number query parameter
+func queryFloat(query url.Values, key string) (*float64, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", key)
+	}
+	return &number, nil
+}
+
+// queryDate reads an optional YYYY-MM-DD query parameter
+func queryDate(query url.Values, key string) (*time.Time, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s, expected YYYY-MM-DD", key)
+	}
+	return &date, nil
+}
+
+// queryBool reads an optional true/false query parameter
+func queryBool(query url.Values, key string) (*bool, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", key)
+	}
+	return &flag, nil
+}
+
 // ApplyToJob allows a labour user to apply for a job
 func (h *JobHandler) ApplyToJob(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context (set by auth middleware)
@@ -467,6 +829,20 @@ func (h *JobHandler) ApplyToJob(w http.ResponseWriter, r *http.Request) {
 	// Apply to job
 	result, err := h.jobUsecase.ApplyToJob(r.Context(), userID, req)
 	if err != nil {
+		if err.Error() == "job is not open for applications" {
+			response.WriteError(w, http.StatusConflict, "Job is not open for applications")
+			return
+		}
+		var eligibilityErr *usecase.EligibilityError
+		if errors.As(err, &eligibilityErr) {
+			response.WriteJSON(w, http.StatusUnprocessableEntity, response.Response{
+				Success: false,
+				Message: "You do not meet the requirements of this job",
+				Error:   eligibilityErr.Error(),
+				Data:    eligibilityErr.Response(),
+			})
+			return
+		}
 		response.WriteError(w, http.StatusInternalServerError, "Failed to apply to job")
 		return
 	}
@@ -474,6 +850,66 @@ func (h *JobHandler) ApplyToJob(w http.ResponseWriter, r *http.Request) {
 	response.WriteJSON(w, http.StatusCreated, result)
 }
 
+// RespondToJobChanges confirms or withdraws an application after the job was edited materially
+func (h *JobHandler) RespondToJobChanges(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	applicationID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid application ID")
+		return
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userIDStr, ok := r.Context().Value(middleware.UserIDKey).(string)
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid user ID")
+		return
+	}
+
+	var req payload.JobChangesDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.jobUsecase.RespondToJobChanges(r.Context(), applicationID, userID, req)
+	if err != nil {
+		if err.Error() == "application not found" {
+			response.WriteError(w, http.StatusNotFound, "Application not found")
+			return
+		}
+		if err.Error() == "no pending job changes" {
+			response.WriteError(w, http.StatusConflict, "The job has no changes waiting for your confirmation")
+			return
+		}
+		if errors.Is(err, job_application_usecase.ErrInvalidTransition) {
+			response.WriteError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, job_application_usecase.ErrTransitionNotAllowed) {
+			response.WriteError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		log.Printf("🚫 RespondToJobChanges - Failed for application %s: %v", applicationID, err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to respond to job changes")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
 // GetLabourApplicants retrieves all applications for the authenticated labour user
 func (h *JobHandler) GetLabourApplicants(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context (set by LabourMiddleware)
@@ -499,6 +935,175 @@ func (h *JobHandler) GetLabourApplicants(w http.ResponseWriter, r *http.Request)
 	response.WriteJSON(w, http.StatusOK, result)
 }
 
+// GetLabourApplication retrieves one application of the authenticated labour user
+func (h *JobHandler) GetLabourApplication(w http.ResponseWriter, r *http.Request) {
+	applicationID, userID, ok := labourApplicationIDs(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.jobUsecase.GetLabourApplication(r.Context(), applicationID, userID)
+	if err != nil {
+		writeLabourApplicationError(w, err, "Failed to get application")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// UpdateLabourApplication edits an application of the authenticated labour user while it is APPLIED
+func (h *JobHandler) UpdateLabourApplication(w http.ResponseWriter, r *http.Request) {
+	applicationID, userID, ok := labourApplicationIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req payload.UpdateLabourApplicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.jobUsecase.UpdateLabourApplication(r.Context(), applicationID, userID, req)
+	if err != nil {
+		writeLabourApplicationError(w, err, "Failed to update application")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// WithdrawLabourApplication withdraws an application of the authenticated labour user
+func (h *JobHandler) WithdrawLabourApplication(w http.ResponseWriter, r *http.Request) {
+	applicationID, userID, ok := labourApplicationIDs(w, r)
+	if !ok {
+		return
+	}
+
+	// The reason is optional, so is the body
+	var req payload.WithdrawLabourApplicationRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.jobUsecase.WithdrawLabourApplication(r.Context(), applicationID, userID, req)
+	if err != nil {
+		writeLabourApplicationError(w, err, "Failed to withdraw application")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// AcceptOffer accepts the builder's offer on one of the labour user's applications
+func (h *JobHandler) AcceptOffer(w http.ResponseWriter, r *http.Request) {
+	applicationID, userID, ok := labourApplicationIDs(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.jobUsecase.AcceptOffer(r.Context(), applicationID, userID)
+	if err != nil {
+		writeLabourApplicationError(w, err, "Failed to accept offer")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// DeclineOffer turns down the builder's offer on one of the labour user's applications
+func (h *JobHandler) DeclineOffer(w http.ResponseWriter, r *http.Request) {
+	applicationID, userID, ok := labourApplicationIDs(w, r)
+	if !ok {
+		return
+	}
+
+	// The reason is optional, so is the body
+	var req payload.DeclineOfferRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.jobUsecase.DeclineOffer(r.Context(), applicationID, userID, req)
+	if err != nil {
+		writeLabourApplicationError(w, err, "Failed to decline offer")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
+// labourApplicationIDs reads the application ID from the path and the labour user ID from the
+// context, writing the error response when either is missing
+func labourApplicationIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	applicationID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid application ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userIDStr, ok := r.Context().Value(middleware.UserIDKey).(string)
+	if !ok {
+		response.WriteError(w, http.StatusUnauthorized, "User not authenticated")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return applicationID, userID, true
+}
+
+// writeLabourApplicationError maps a failed labour application request to its HTTP status
+func writeLabourApplicationError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case err.Error() == "application not found":
+		response.WriteError(w, http.StatusNotFound, "Application not found")
+	case err.Error() == "application can no longer be edited":
+		response.WriteError(w, http.StatusConflict, "Only applications the builder has not acted on yet can be edited")
+	case err.Error() == "offer has expired":
+		response.WriteError(w, http.StatusConflict, "Offer has expired")
+	case err.Error() == "job is not open for hiring":
+		response.WriteError(w, http.StatusConflict, "Job is not open for hiring")
+	case err.Error() == "job has no places left":
+		response.WriteError(w, http.StatusConflict, "Job has no places left")
+	case errors.Is(err, job_application_usecase.ErrInvalidTransition):
+		response.WriteError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, job_application_usecase.ErrTransitionNotAllowed):
+		response.WriteError(w, http.StatusForbidden, err.Error())
+	default:
+		log.Printf("🚫 Labour application request failed: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, fallback)
+	}
+}
+
 // GetJobsByJobsite retrieves jobs by jobsite ID
 func (h *JobHandler) GetJobsByJobsite(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -585,7 +1190,7 @@ func (h *JobHandler) GetAllJobs(w http.ResponseWriter, r *http.Request) {
 	response.WriteJSON(w, http.StatusOK, resp)
 }
 
-// UpdateJob updates a job
+// UpdateJob updates a job (only for the owner builder)
 func (h *JobHandler) UpdateJob(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	jobID, err := uuid.Parse(vars["id"])
@@ -594,29 +1199,86 @@ func (h *JobHandler) UpdateJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Get builder profile ID from context (set by AuthMiddleware, route guarded by RequireRole)
+	builderProfileIDStr, ok := r.Context().Value(middleware.BuilderProfileIDKey).(string)
+	if !ok {
+		log.Printf("🚫 UpdateJob - Builder profile ID not found in context")
+		response.WriteError(w, http.StatusUnauthorized, "Builder profile ID not found in context")
+		return
+	}
+
+	// Parse string to UUID
+	builderProfileID, err := uuid.Parse(builderProfileIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid builder profile ID format")
+		return
+	}
+
 	var req payload.UpdateJobRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	// Update job
-	job, err := h.jobUsecase.UpdateJob(r.Context(), jobID, req)
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Update job (only if owned by builder)
+	job, err := h.jobUsecase.UpdateJob(r.Context(), jobID, builderProfileID, req)
 	if err != nil {
+		if err.Error() == "job not found" {
+			response.WriteError(w, http.StatusNotFound, "Job not found")
+			return
+		}
+		if err.Error() == "invalid job - not owned by builder" {
+			response.WriteError(w, http.StatusForbidden, "Invalid job - not owned by builder")
+			return
+		}
+		if strings.HasPrefix(err.Error(), "validation failed") {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Printf("🚫 UpdateJob - Failed to update job %s: %v", jobID, err)
 		response.WriteError(w, http.StatusInternalServerError, "Failed to update job")
 		return
 	}
 
-	jobResp := h.convertToJobResponse(job)
+	// Reload the job with its new links for the response
+	jobWithRelations, err := h.jobUsecase.GetJobWithRelations(r.Context(), job.ID)
+	if err != nil {
+		log.Printf("🚫 UpdateJob - Failed to get job with relations: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to get job details")
+		return
+	}
+
+	// Get additional relation data
+	builderProfile, err := h.builderProfileRepo.GetByID(r.Context(), job.BuilderProfileID)
+	if err != nil {
+		log.Printf("🚫 UpdateJob - Failed to get builder profile: %v", err)
+	}
+
+	jobsite, err := h.jobsiteRepo.GetByID(r.Context(), job.JobsiteID)
+	if err != nil {
+		log.Printf("🚫 UpdateJob - Failed to get jobsite: %v", err)
+	}
+
+	jobType, err := h.jobTypeRepo.GetByID(r.Context(), job.JobTypeID)
+	if err != nil {
+		log.Printf("🚫 UpdateJob - Failed to get job type: %v", err)
+	}
+
 	resp := payload.UpdateJobResponse{
-		Job:     jobResp,
+		Job:     h.convertToJobResponseWithRelations(r.Context(), jobWithRelations, builderProfile, jobsite, jobType),
 		Message: "Job updated successfully",
 	}
 
 	response.WriteJSON(w, http.StatusOK, resp)
 }
 
-// DeleteJob deletes a job
+// DeleteJob deletes a job (only for the owner builder), archiving it when it has active assignments
 func (h *JobHandler) DeleteJob(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	jobID, err := uuid.Parse(vars["id"])
@@ -625,13 +1287,42 @@ func (h *JobHandler) DeleteJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.jobUsecase.DeleteJob(r.Context(), jobID); err != nil {
+	// Get builder profile ID from context (set by AuthMiddleware, route guarded by RequireRole)
+	builderProfileIDStr, ok := r.Context().Value(middleware.BuilderProfileIDKey).(string)
+	if !ok {
+		log.Printf("🚫 DeleteJob - Builder profile ID not found in context")
+		response.WriteError(w, http.StatusUnauthorized, "Builder profile ID not found in context")
+		return
+	}
+
+	// Parse string to UUID
+	builderProfileID, err := uuid.Parse(builderProfileIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid builder profile ID format")
+		return
+	}
+
+	archived, err := h.jobUsecase.DeleteJob(r.Context(), jobID, builderProfileID)
+	if err != nil {
+		if err.Error() == "job not found" {
+			response.WriteError(w, http.StatusNotFound, "Job not found")
+			return
+		}
+		if err.Error() == "invalid job - not owned by builder" {
+			response.WriteError(w, http.StatusForbidden, "Invalid job - not owned by builder")
+			return
+		}
+		log.Printf("🚫 DeleteJob - Failed to delete job %s: %v", jobID, err)
 		response.WriteError(w, http.StatusInternalServerError, "Failed to delete job")
 		return
 	}
 
 	resp := payload.DeleteJobResponse{
-		Message: "Job deleted successfully",
+		Archived: archived,
+		Message:  "Job deleted successfully",
+	}
+	if archived {
+		resp.Message = "Job has applications and was archived instead of deleted"
 	}
 
 	response.WriteJSON(w, http.StatusOK, resp)
@@ -661,6 +1352,7 @@ func (h *JobHandler) convertToJobResponse(job *models.Job) payload.JobResponse {
 		RequiresSupervisorSignature: job.RequiresSupervisorSignature,
 		SupervisorName:              job.SupervisorName,
 		Visibility:                  job.Visibility,
+		Status:                      job.Status,
 		PaymentType:                 job.PaymentType,
 		CreatedAt:                   job.CreatedAt,
 		UpdatedAt:                   job.UpdatedAt,
@@ -785,7 +1477,7 @@ func (h *JobHandler) GetBuilderJobDetail(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Get builder profile ID from context (set by BuilderMiddleware)
+	// Get builder profile ID from context (set by AuthMiddleware, route guarded by RequireRole)
 	log.Printf("🔍 Handler - Checking context for builder_profile_id")
 	builderProfileIDStr, ok := r.Context().Value(middleware.BuilderProfileIDKey).(string)
 	if !ok {
@@ -834,9 +1526,17 @@ func (h *JobHandler) GetBuilderJobDetail(w http.ResponseWriter, r *http.Request)
 	// Convert to detail response (shows null values)
 	jobResp := h.convertToJobDetailResponse(r.Context(), job, builderProfile, jobsite, jobType)
 
+	revisions, err := h.jobUsecase.GetJobRevisions(r.Context(), job.ID)
+	if err != nil {
+		log.Printf("🚫 GetBuilderJobDetail - Failed to get job revisions: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to get job revisions")
+		return
+	}
+
 	resp := payload.GetBuilderJobDetailResponse{
-		Job:     jobResp,
-		Message: "Job detail retrieved successfully",
+		Job:       jobResp,
+		Revisions: revisions,
+		Message:   "Job detail retrieved successfully",
 	}
 
 	response.WriteJSON(w, http.StatusOK, resp)
@@ -853,7 +1553,7 @@ func (h *JobHandler) UpdateJobVisibility(w http.ResponseWriter, r *http.Request)
 
 	log.Printf("🔍 UpdateJobVisibility - Job ID: %s", jobID)
 
-	// Get builder profile ID from context (set by BuilderMiddleware)
+	// Get builder profile ID from context (set by AuthMiddleware, route guarded by RequireRole)
 	builderProfileIDStr, ok := r.Context().Value(middleware.BuilderProfileIDKey).(string)
 	if !ok {
 		log.Printf("🚫 UpdateJobVisibility - Builder profile ID not found in context")
@@ -894,6 +1594,66 @@ func (h *JobHandler) UpdateJobVisibility(w http.ResponseWriter, r *http.Request)
 	response.WriteJSON(w, http.StatusOK, jobDetail)
 }
 
+// UpdateJobStatus moves a job through its lifecycle (only for the owner builder)
+func (h *JobHandler) UpdateJobStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	jobID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
+	// Get builder profile ID from context (set by AuthMiddleware, route guarded by RequireRole)
+	builderProfileIDStr, ok := r.Context().Value(middleware.BuilderProfileIDKey).(string)
+	if !ok {
+		log.Printf("🚫 UpdateJobStatus - Builder profile ID not found in context")
+		response.WriteError(w, http.StatusUnauthorized, "Builder profile ID not found in context")
+		return
+	}
+
+	// Parse string to UUID
+	builderProfileID, err := uuid.Parse(builderProfileIDStr)
+	if err != nil {
+		response.WriteError(w, http.StatusUnauthorized, "Invalid builder profile ID format")
+		return
+	}
+
+	// Parse request body
+	var req payload.UpdateJobStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validate request
+	if err := validation.ValidateStruct(req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Update job status (only if owned by builder)
+	result, err := h.jobUsecase.UpdateJobStatus(r.Context(), jobID, builderProfileID, req)
+	if err != nil {
+		if err.Error() == "job not found" {
+			response.WriteError(w, http.StatusNotFound, "Job not found")
+			return
+		}
+		if err.Error() == "invalid job - not owned by builder" {
+			response.WriteError(w, http.StatusForbidden, "Invalid job - not owned by builder")
+			return
+		}
+		if strings.HasPrefix(err.Error(), "invalid status transition") {
+			response.WriteError(w, http.StatusConflict, err.Error())
+			return
+		}
+		log.Printf("🚫 UpdateJobStatus - Failed to update job %s: %v", jobID, err)
+		response.WriteError(w, http.StatusInternalServerError, "Failed to update job status")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, result)
+}
+
 // convertToJobResponseWithRelations converts a Job model to JobResponse with full relations
 func (h *JobHandler) convertToJobResponseWithRelations(ctx context.Context, job *models.Job, builderProfile *builder_models.BuilderProfile, jobsite *jobsite_models.Jobsite, jobType *job_type_models.JobType) payload.JobResponse {
 	jobResp := payload.JobResponse{
@@ -918,14 +1678,22 @@ func (h *JobHandler) convertToJobResponseWithRelations(ctx context.Context, job
 		RequiresSupervisorSignature: job.RequiresSupervisorSignature,
 		SupervisorName:              job.SupervisorName,
 		Visibility:                  job.Visibility,
+		Status:                      job.Status,
 		PaymentType:                 job.PaymentType,
 		CreatedAt:                   job.CreatedAt,
 		UpdatedAt:                   job.UpdatedAt,
 	}
 
-	// Calculate total wage
-	totalWage := calculateTotalWage(job)
-	jobResp.TotalWage = &totalWage
+	// Estimate the pay per day, week and pay period
+	payEstimate, err := h.jobUsecase.EstimateJobPay(ctx, job)
+	if err != nil {
+		log.Printf("🚫 Failed to estimate pay for job %s: %v", job.ID, err)
+	}
+	jobResp.PayEstimate = payEstimate
+	if payEstimate != nil {
+		totalWage := payEstimate.PerPayPeriod.Subtotal.Float64()
+		jobResp.TotalWage = &totalWage
+	}
 
 	// Add builder profile information
 	if builderProfile != nil {
@@ -982,6 +1750,7 @@ func (h *JobHandler) convertToJobResponseWithRelations(ctx context.Context, job
 			ID:        jobLicense.ID,
 			JobID:     jobLicense.JobID,
 			LicenseID: jobLicense.LicenseID,
+			Required:  jobLicense.Required,
 			License: &payload.LicenseResponse{
 				ID:          licenseDetails.ID,
 				Name:        licenseDetails.Name,
@@ -1078,32 +1847,6 @@ func getCompanyName(company *builder_models.Company) string {
 	return company.Name
 }
 
-// calculateTotalWage calculates the total wage by summing all wage components
-func calculateTotalWage(job *models.Job) float64 {
-	var total float64
-
-	if job.WageSiteAllowance != nil {
-		total += *job.WageSiteAllowance
-	}
-	if job.WageLeadingHandAllowance != nil {
-		total += *job.WageLeadingHandAllowance
-	}
-	if job.WageProductivityAllowance != nil {
-		total += *job.WageProductivityAllowance
-	}
-	if job.WageHourlyRate != nil {
-		total += *job.WageHourlyRate
-	}
-	if job.TravelAllowance != nil {
-		total += *job.TravelAllowance
-	}
-	if job.ExtrasOvertimeRate != nil {
-		total += *job.ExtrasOvertimeRate
-	}
-
-	return total
-}
-
 // convertToJobDetailResponse converts a Job model to JobDetailResponse with full relations (shows null values)
 func (h *JobHandler) convertToJobDetailResponse(ctx context.Context, job *models.Job, builderProfile *builder_models.BuilderProfile, jobsite *jobsite_models.Jobsite, jobType *job_type_models.JobType) payload.JobDetailResponse {
 	jobResp := payload.JobDetailResponse{
@@ -1128,14 +1871,22 @@ func (h *JobHandler) convertToJobDetailResponse(ctx context.Context, job *models
 		RequiresSupervisorSignature: job.RequiresSupervisorSignature,
 		SupervisorName:              job.SupervisorName,
 		Visibility:                  job.Visibility,
+		Status:                      job.Status,
 		PaymentType:                 job.PaymentType,
 		CreatedAt:                   job.CreatedAt,
 		UpdatedAt:                   job.UpdatedAt,
 	}
 
-	// Calculate total wage
-	totalWage := calculateTotalWage(job)
-	jobResp.TotalWage = &totalWage
+	// Estimate the pay per day, week and pay period
+	payEstimate, err := h.jobUsecase.EstimateJobPay(ctx, job)
+	if err != nil {
+		log.Printf("🚫 Failed to estimate pay for job %s: %v", job.ID, err)
+	}
+	jobResp.PayEstimate = payEstimate
+	if payEstimate != nil {
+		totalWage := payEstimate.PerPayPeriod.Subtotal.Float64()
+		jobResp.TotalWage = &totalWage
+	}
 
 	// Add builder profile information
 	if builderProfile != nil {
@@ -1192,6 +1943,7 @@ func (h *JobHandler) convertToJobDetailResponse(ctx context.Context, job *models
 			ID:        jobLicense.ID,
 			JobID:     jobLicense.JobID,
 			LicenseID: jobLicense.LicenseID,
+			Required:  jobLicense.Required,
 			License: &payload.LicenseResponse{
 				ID:          licenseDetails.ID,
 				Name:        licenseDetails.Name,
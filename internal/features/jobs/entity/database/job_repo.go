@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/yakka-backend/internal/features/jobs/models"
@@ -11,12 +12,20 @@ import (
 type JobRepository interface {
 	Create(ctx context.Context, job *models.Job) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Job, error)
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*models.Job, error)
 	GetByBuilderProfileID(ctx context.Context, builderProfileID uuid.UUID) ([]*models.Job, error)
+	GetByBuilderProfileIDWithRelations(ctx context.Context, builderProfileID uuid.UUID) ([]*models.Job, error)
 	GetByJobsiteID(ctx context.Context, jobsiteID uuid.UUID) ([]*models.Job, error)
 	GetByVisibility(ctx context.Context, visibility models.JobVisibility) ([]*models.Job, error)
 	GetByVisibilityWithRelations(ctx context.Context, visibility models.JobVisibility) ([]*models.Job, error)
 	GetAll(ctx context.Context) ([]*models.Job, error)
 	Update(ctx context.Context, job *models.Job) error
+	UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
+	UpdateStatus(ctx context.Context, id uuid.UUID, status models.JobStatus) error
+	UpdateRejectRemainingWhenFilled(ctx context.Context, id uuid.UUID, reject bool) error
+	ExpireOverdue(ctx context.Context, before time.Time) (int64, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetWithRelations(ctx context.Context, id uuid.UUID) (*models.Job, error)
+	GetByIDsWithRelations(ctx context.Context, ids []uuid.UUID) ([]*models.Job, error)
+	SearchPublic(ctx context.Context, filter JobSearchFilter) ([]JobSearchHit, error)
 }
@@ -2,10 +2,12 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/yakka-backend/internal/features/jobs/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // jobRepository implements JobRepository
@@ -35,6 +37,17 @@ func (r *jobRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Job,
 	return &job, nil
 }
 
+// GetByIDForUpdate retrieves a job by ID and locks its row until the transaction ends,
+// so concurrent hires on the same job are counted one after the other
+func (r *jobRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*models.Job, error) {
+	var job models.Job
+	err := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
 // GetByBuilderProfileID retrieves jobs by builder profile ID
 func (r *jobRepository) GetByBuilderProfileID(ctx context.Context, builderProfileID uuid.UUID) ([]*models.Job, error) {
 	var jobs []*models.Job
@@ -45,6 +58,21 @@ func (r *jobRepository) GetByBuilderProfileID(ctx context.Context, builderProfil
 	return jobs, nil
 }
 
+// GetByBuilderProfileIDWithRelations retrieves jobs by builder profile ID with their license, skill and requirement links
+func (r *jobRepository) GetByBuilderProfileIDWithRelations(ctx context.Context, builderProfileID uuid.UUID) ([]*models.Job, error) {
+	var jobs []*models.Job
+	err := r.db.WithContext(ctx).
+		Preload("JobLicenses").
+		Preload("JobSkills").
+		Preload("JobRequirements").
+		Where("builder_profile_id = ?", builderProfileID).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
 // GetByJobsiteID retrieves jobs by jobsite ID
 func (r *jobRepository) GetByJobsiteID(ctx context.Context, jobsiteID uuid.UUID) ([]*models.Job, error) {
 	var jobs []*models.Job
@@ -95,6 +123,43 @@ func (r *jobRepository) Update(ctx context.Context, job *models.Job) error {
 	return r.db.WithContext(ctx).Save(job).Error
 }
 
+// UpdateSpecificFields updates only the given columns of a job
+func (r *jobRepository) UpdateSpecificFields(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error {
+	return r.db.WithContext(ctx).Model(&models.Job{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// UpdateStatus updates the lifecycle status of a job
+func (r *jobRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status models.JobStatus) error {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now(),
+	}
+
+	return r.db.WithContext(ctx).Model(&models.Job{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// UpdateRejectRemainingWhenFilled records whether the applicants still waiting for a decision are
+// rejected once the job is filled
+func (r *jobRepository) UpdateRejectRemainingWhenFilled(ctx context.Context, id uuid.UUID, reject bool) error {
+	updates := map[string]interface{}{
+		"reject_remaining_when_filled": reject,
+		"updated_at":                   time.Now(),
+	}
+
+	return r.db.WithContext(ctx).Model(&models.Job{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// ExpireOverdue moves open jobs whose last work day is before the given time to EXPIRED
+func (r *jobRepository) ExpireOverdue(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.Job{}).
+		Where("status = ? AND end_date_work < ?", models.JobStatusOpen, before).
+		Updates(map[string]interface{}{
+			"status":     models.JobStatusExpired,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
 // Delete deletes a job by ID
 func (r *jobRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Job{}).Error
@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/jobs/models"
+)
+
+// JobRevisionRepository defines the interface for job revision operations
+type JobRevisionRepository interface {
+	Create(ctx context.Context, revision *models.JobRevision) error
+	GetByJobID(ctx context.Context, jobID uuid.UUID) ([]*models.JobRevision, error)
+	GetLatestVersion(ctx context.Context, jobID uuid.UUID) (int, error)
+}
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"gorm.io/gorm"
+)
+
+// jobRevisionRepository implements JobRevisionRepository
+type jobRevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewJobRevisionRepository creates a new job revision repository
+func NewJobRevisionRepository(db *gorm.DB) JobRevisionRepository {
+	return &jobRevisionRepository{
+		db: db,
+	}
+}
+
+// Create creates a revision together with its field changes
+func (r *jobRevisionRepository) Create(ctx context.Context, revision *models.JobRevision) error {
+	return r.db.WithContext(ctx).Create(revision).Error
+}
+
+// GetByJobID retrieves the revisions of a job with their changes, newest first
+func (r *jobRevisionRepository) GetByJobID(ctx context.Context, jobID uuid.UUID) ([]*models.JobRevision, error) {
+	var revisions []*models.JobRevision
+	err := r.db.WithContext(ctx).
+		Preload("Changes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("field")
+		}).
+		Where("job_id = ?", jobID).
+		Order("version DESC").
+		Find(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// GetLatestVersion returns the highest revision number of a job, or 0 when it was never edited
+func (r *jobRevisionRepository) GetLatestVersion(ctx context.Context, jobID uuid.UUID) (int, error) {
+	var version int
+	err := r.db.WithContext(ctx).Model(&models.JobRevision{}).
+		Where("job_id = ?", jobID).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error
+	return version, err
+}
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"gorm.io/gorm"
+)
+
+// JobSearchSort is the order of job search results
+type JobSearchSort string
+
+const (
+	JobSearchSortRecent   JobSearchSort = "recent"   // newest first
+	JobSearchSortPay      JobSearchSort = "pay"      // highest hourly rate first
+	JobSearchSortDistance JobSearchSort = "distance" // closest jobsite first
+)
+
+// earthRadiusKm is the mean Earth radius used for distances
+const earthRadiusKm = 6371.0
+
+// JobSearchPoint is a location jobs are searched around
+type JobSearchPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// JobSearchCursor is the position after the last result of a page
+type JobSearchCursor struct {
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	Value     float64   `json:"value,omitempty"` // hourly rate or distance, depending on the sort
+	ID        uuid.UUID `json:"id"`
+}
+
+// JobSearchFilter describes a page of open public jobs
+type JobSearchFilter struct {
+	JobTypeID          *uuid.UUID
+	SkillCategoryID    *uuid.UUID
+	SkillSubcategoryID *uuid.UUID
+	LicenseID          *uuid.UUID
+	MinHourlyRate      *float64
+	MaxHourlyRate      *float64
+	StartFrom          *time.Time
+	StartTo            *time.Time
+	WorkSaturday       *bool
+	WorkSunday         *bool
+	PaymentType        *models.PaymentType
+	Near               *JobSearchPoint // required for a radius or distance sort
+	RadiusKm           *float64
+	Sort               JobSearchSort
+	After              *JobSearchCursor
+	Limit              int
+}
+
+// JobSearchHit is one job of a search page
+type JobSearchHit struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	HourlyRate float64
+	DistanceKm *float64
+}
+
+// SearchPublic returns one page of open public jobs matching the filter in a single query.
+// Filtering, ordering and keyset pagination all run in the database; callers load the jobs by ID.
+func (r *jobRepository) SearchPublic(ctx context.Context, filter JobSearchFilter) ([]JobSearchHit, error) {
+	const hourlyRate = "COALESCE(jobs.wage_hourly_rate, 0)"
+
+	query := r.db.WithContext(ctx).
+		Table("jobs").
+		Joins("JOIN jobsites ON jobsites.id = jobs.jobsite_id").
+		Where("jobs.visibility = ? AND jobs.status = ?", models.JobVisibilityPublic, models.JobStatusOpen)
+
+	// Distance is computed with the haversine formula; the bounding box lets the jobsite
+	// coordinate index discard far away rows before the exact distance is calculated
+	var distance string
+	var distanceArgs []interface{}
+	if filter.Near != nil {
+		distance = "(? * 2 * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(jobsites.latitude - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(jobsites.latitude)) * POWER(SIN(RADIANS(jobsites.longitude - ?) / 2), 2)))))"
+		distanceArgs = []interface{}{earthRadiusKm, filter.Near.Latitude, filter.Near.Latitude, filter.Near.Longitude}
+		query = query.Select("jobs.id, jobs.created_at, "+hourlyRate+" AS hourly_rate, "+distance+" AS distance_km", distanceArgs...)
+
+		// A jobsite without coordinates has no distance: it can neither be inside a radius
+		// nor take a place in the distance order, where a NULL would break the cursor
+		if filter.RadiusKm != nil || filter.Sort == JobSearchSortDistance {
+			query = query.Where("jobsites.latitude IS NOT NULL AND jobsites.longitude IS NOT NULL")
+		}
+
+		if filter.RadiusKm != nil {
+			minLat, maxLat, minLng, maxLng := boundingBox(*filter.Near, *filter.RadiusKm)
+			query = query.Where("jobsites.latitude BETWEEN ? AND ?", minLat, maxLat)
+			if minLng <= maxLng {
+				query = query.Where("jobsites.longitude BETWEEN ? AND ?", minLng, maxLng)
+			} else {
+				// The box crosses the antimeridian
+				query = query.Where("(jobsites.longitude >= ? OR jobsites.longitude <= ?)", minLng, maxLng)
+			}
+			query = query.Where(distance+" <= ?", append(distanceArgs, *filter.RadiusKm)...)
+		}
+	} else {
+		query = query.Select("jobs.id, jobs.created_at, " + hourlyRate + " AS hourly_rate, NULL AS distance_km")
+	}
+
+	if filter.JobTypeID != nil {
+		query = query.Where("jobs.job_type_id = ?", *filter.JobTypeID)
+	}
+	if filter.SkillCategoryID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM job_skills WHERE job_skills.job_id = jobs.id AND job_skills.skill_category_id = ?)", *filter.SkillCategoryID)
+	}
+	if filter.SkillSubcategoryID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM job_skills WHERE job_skills.job_id = jobs.id AND job_skills.skill_subcategory_id = ?)", *filter.SkillSubcategoryID)
+	}
+	if filter.LicenseID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM job_licenses WHERE job_licenses.job_id = jobs.id AND job_licenses.license_id = ?)", *filter.LicenseID)
+	}
+	if filter.MinHourlyRate != nil {
+		query = query.Where("jobs.wage_hourly_rate >= ?", *filter.MinHourlyRate)
+	}
+	if filter.MaxHourlyRate != nil {
+		query = query.Where("jobs.wage_hourly_rate <= ?", *filter.MaxHourlyRate)
+	}
+	if filter.StartFrom != nil {
+		query = query.Where("jobs.start_date_work >= ?", *filter.StartFrom)
+	}
+	if filter.StartTo != nil {
+		query = query.Where("jobs.start_date_work <= ?", *filter.StartTo)
+	}
+	if filter.WorkSaturday != nil {
+		query = query.Where("jobs.work_saturday = ?", *filter.WorkSaturday)
+	}
+	if filter.WorkSunday != nil {
+		query = query.Where("jobs.work_sunday = ?", *filter.WorkSunday)
+	}
+	if filter.PaymentType != nil {
+		query = query.Where("jobs.payment_type = ?", *filter.PaymentType)
+	}
+
+	query = applySearchOrder(query, filter, hourlyRate, distance, distanceArgs)
+
+	var hits []JobSearchHit
+	if err := query.Limit(filter.Limit).Scan(&hits).Error; err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// applySearchOrder orders the results by the requested sort with the job ID as tie breaker,
+// and continues after the cursor of the previous page
+func applySearchOrder(query *gorm.DB, filter JobSearchFilter, hourlyRate, distance string, distanceArgs []interface{}) *gorm.DB {
+	switch filter.Sort {
+	case JobSearchSortDistance:
+		if filter.After != nil {
+			query = query.Where("("+distance+", jobs.id) > (?, ?)", append(distanceArgs, filter.After.Value, filter.After.ID)...)
+		}
+		return query.Order("distance_km ASC, jobs.id ASC")
+	case JobSearchSortPay:
+		if filter.After != nil {
+			query = query.Where("("+hourlyRate+", jobs.id) < (?, ?)", filter.After.Value, filter.After.ID)
+		}
+		return query.Order(hourlyRate + " DESC, jobs.id DESC")
+	default:
+		if filter.After != nil {
+			query = query.Where("(jobs.created_at, jobs.id) < (?, ?)", filter.After.CreatedAt, filter.After.ID)
+		}
+		return query.Order("jobs.created_at DESC, jobs.id DESC")
+	}
+}
+
+// boundingBox returns the latitude and longitude range that contains a circle around a point.
+// When the circle crosses the antimeridian minLng is greater than maxLng and the range
+// wraps: it covers minLng to 180 and -180 to maxLng.
+func boundingBox(center JobSearchPoint, radiusKm float64) (minLat, maxLat, minLng, maxLng float64) {
+	latDelta := radiusKm / earthRadiusKm * 180 / math.Pi
+	minLat = math.Max(center.Latitude-latDelta, -90)
+	maxLat = math.Min(center.Latitude+latDelta, 90)
+
+	// Near the poles every longitude can be within the radius
+	cosLat := math.Cos(center.Latitude * math.Pi / 180)
+	if cosLat < 1e-6 || maxLat >= 90 || minLat <= -90 {
+		return minLat, maxLat, -180, 180
+	}
+	lngDelta := latDelta / cosLat
+	if lngDelta >= 180 {
+		return minLat, maxLat, -180, 180
+	}
+
+	minLng = center.Longitude - lngDelta
+	if minLng < -180 {
+		minLng += 360
+	}
+	maxLng = center.Longitude + lngDelta
+	if maxLng > 180 {
+		maxLng -= 360
+	}
+	return minLat, maxLat, minLng, maxLng
+}
+
+// GetByIDsWithRelations retrieves jobs by ID with all relations, in no particular order
+func (r *jobRepository) GetByIDsWithRelations(ctx context.Context, ids []uuid.UUID) ([]*models.Job, error) {
+	var jobs []*models.Job
+	if len(ids) == 0 {
+		return jobs, nil
+	}
+	err := r.db.WithContext(ctx).
+		Preload("JobLicenses").
+		Preload("JobSkills").
+		Preload("JobRequirements").
+		Where("id IN ?", ids).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
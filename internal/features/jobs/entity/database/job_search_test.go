@@ -0,0 +1,217 @@
+package database
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/jobs/jobstest"
+	"github.com/yakka-backend/internal/infrastructure/database/dbtest"
+)
+
+// inBox reports whether a point falls in a range returned by boundingBox, wrapping included
+func inBox(lat, lng, minLat, maxLat, minLng, maxLng float64) bool {
+	if lat < minLat || lat > maxLat {
+		return false
+	}
+	if minLng <= maxLng {
+		return lng >= minLng && lng <= maxLng
+	}
+	return lng >= minLng || lng <= maxLng
+}
+
+// haversineKm is the distance SearchPublic computes in SQL
+func haversineKm(a, b JobSearchPoint) float64 {
+	rad := math.Pi / 180
+	dLat := (b.Latitude - a.Latitude) * rad
+	dLng := (b.Longitude - a.Longitude) * rad
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(a.Latitude*rad)*math.Cos(b.Latitude*rad)*math.Pow(math.Sin(dLng/2), 2)
+	return earthRadiusKm * 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func TestBoundingBox(t *testing.T) {
+	tests := []struct {
+		name     string
+		center   JobSearchPoint
+		radiusKm float64
+		wrap     bool
+		fullLng  bool
+		inside   []JobSearchPoint // points within the radius that must be in the box
+		outside  []JobSearchPoint // points the box must leave out
+	}{
+		{
+			name:     "Sydney",
+			center:   JobSearchPoint{-33.8688, 151.2093},
+			radiusKm: 25,
+			inside:   []JobSearchPoint{{-33.80, 151.28}, {-34.0, 151.2093}},
+			outside:  []JobSearchPoint{{-33.8688, 152.0}, {-35.0, 151.2093}},
+		},
+		{
+			name:     "east of the antimeridian",
+			center:   JobSearchPoint{-17.7, 179.9},
+			radiusKm: 50,
+			wrap:     true,
+			inside:   []JobSearchPoint{{-17.7, -179.9}, {-17.7, 179.6}, {-17.5, -179.95}},
+			outside:  []JobSearchPoint{{-17.7, 0}, {-17.7, -178.0}, {-17.7, 178.0}},
+		},
+		{
+			name:     "west of the antimeridian",
+			center:   JobSearchPoint{-17.7, -179.9},
+			radiusKm: 50,
+			wrap:     true,
+			inside:   []JobSearchPoint{{-17.7, 179.9}, {-17.7, -179.6}},
+			outside:  []JobSearchPoint{{-17.7, 0}, {-17.7, 178.0}},
+		},
+		{
+			name:     "touching the antimeridian",
+			center:   JobSearchPoint{0, 180},
+			radiusKm: 10,
+			wrap:     true,
+			inside:   []JobSearchPoint{{0, -179.95}, {0, 179.95}},
+			outside:  []JobSearchPoint{{0, 179.0}, {0, -179.0}},
+		},
+		{
+			name:     "near the pole",
+			center:   JobSearchPoint{89.99, 10},
+			radiusKm: 50,
+			fullLng:  true,
+			inside:   []JobSearchPoint{{89.9, -170}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minLat, maxLat, minLng, maxLng := boundingBox(tt.center, tt.radiusKm)
+
+			if wraps := minLng > maxLng; wraps != tt.wrap {
+				t.Errorf("longitude range [%f, %f] wraps = %v, want %v", minLng, maxLng, wraps, tt.wrap)
+			}
+			if full := minLng == -180 && maxLng == 180; full != tt.fullLng {
+				t.Errorf("longitude range [%f, %f] full = %v, want %v", minLng, maxLng, full, tt.fullLng)
+			}
+			for _, v := range []float64{minLng, maxLng} {
+				if v < -180 || v > 180 {
+					t.Errorf("longitude %f is outside [-180, 180]", v)
+				}
+			}
+
+			for _, p := range tt.inside {
+				if d := haversineKm(tt.center, p); d > tt.radiusKm {
+					t.Fatalf("test point %+v is %.1f km away, outside the radius", p, d)
+				}
+				if !inBox(p.Latitude, p.Longitude, minLat, maxLat, minLng, maxLng) {
+					t.Errorf("point %+v within %.0f km is outside the box lat [%f, %f] lng [%f, %f]", p, tt.radiusKm, minLat, maxLat, minLng, maxLng)
+				}
+			}
+			for _, p := range tt.outside {
+				if inBox(p.Latitude, p.Longitude, minLat, maxLat, minLng, maxLng) {
+					t.Errorf("point %+v is inside the box lat [%f, %f] lng [%f, %f]", p, minLat, maxLat, minLng, maxLng)
+				}
+			}
+		})
+	}
+}
+
+// nextCursor returns the cursor after a hit the way the usecase encodes it
+func nextCursor(sort JobSearchSort, hit JobSearchHit) *JobSearchCursor {
+	cursor := &JobSearchCursor{ID: hit.ID}
+	switch sort {
+	case JobSearchSortDistance:
+		cursor.Value = *hit.DistanceKm
+	case JobSearchSortPay:
+		cursor.Value = hit.HourlyRate
+	default:
+		cursor.CreatedAt = hit.CreatedAt
+	}
+	return cursor
+}
+
+func TestSearchPublicPaging(t *testing.T) {
+	db := dbtest.Open(t)
+	center := jobstest.Point{Latitude: -33.8688, Longitude: 151.2093}
+	// More jobs than jobsites, so distances tie as well as rates
+	seed := jobstest.Create(t, db, jobstest.Options{
+		Builders:           3,
+		JobsitesPerBuilder: 2,
+		JobsPerBuilder:     5,
+		Center:             center,
+		SpacingKm:          1,
+	})
+	repo := NewJobRepository(db)
+	near := &JobSearchPoint{Latitude: center.Latitude, Longitude: center.Longitude}
+
+	for _, sort := range []JobSearchSort{JobSearchSortRecent, JobSearchSortPay, JobSearchSortDistance} {
+		t.Run(string(sort), func(t *testing.T) {
+			filter := JobSearchFilter{Near: near, Sort: sort, LicenseID: &seed.LicenseID, Limit: 4}
+			seen := make(map[uuid.UUID]bool)
+			var previous *JobSearchHit
+			for page := 0; ; page++ {
+				if page > len(seed.JobIDs) {
+					t.Fatal("paging did not end")
+				}
+				hits, err := repo.SearchPublic(context.Background(), filter)
+				if err != nil {
+					t.Fatalf("SearchPublic: %v", err)
+				}
+				for i := range hits {
+					hit := hits[i]
+					if seen[hit.ID] {
+						t.Fatalf("job %s returned twice", hit.ID)
+					}
+					seen[hit.ID] = true
+					if hit.DistanceKm == nil {
+						t.Fatalf("job %s has no distance", hit.ID)
+					}
+					if previous != nil && !ordered(sort, *previous, hit) {
+						t.Errorf("job %s is out of %s order after %s", hit.ID, sort, previous.ID)
+					}
+					previous = &hit
+				}
+				if len(hits) < filter.Limit {
+					break
+				}
+				filter.After = nextCursor(sort, hits[len(hits)-1])
+			}
+			if len(seen) != len(seed.JobIDs) {
+				t.Errorf("paged through %d jobs, want %d", len(seen), len(seed.JobIDs))
+			}
+		})
+	}
+}
+
+// ordered reports whether b may follow a in the given sort
+func ordered(sort JobSearchSort, a, b JobSearchHit) bool {
+	idBefore := a.ID.String() < b.ID.String()
+	switch sort {
+	case JobSearchSortDistance:
+		return *a.DistanceKm < *b.DistanceKm || (*a.DistanceKm == *b.DistanceKm && idBefore)
+	case JobSearchSortPay:
+		return a.HourlyRate > b.HourlyRate || (a.HourlyRate == b.HourlyRate && !idBefore)
+	default:
+		return a.CreatedAt.After(b.CreatedAt) || (a.CreatedAt.Equal(b.CreatedAt) && !idBefore)
+	}
+}
+
+func TestSearchPublicAcrossAntimeridian(t *testing.T) {
+	db := dbtest.Open(t)
+	seed := jobstest.Create(t, db, jobstest.Options{
+		Builders:       1,
+		JobsPerBuilder: 2,
+		Center:         jobstest.Point{Latitude: -17.7, Longitude: 179.99},
+	})
+	radius := 10.0
+
+	hits, err := NewJobRepository(db).SearchPublic(context.Background(), JobSearchFilter{
+		Near:      &JobSearchPoint{Latitude: -17.7, Longitude: -179.99},
+		RadiusKm:  &radius,
+		Sort:      JobSearchSortDistance,
+		LicenseID: &seed.LicenseID,
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatalf("SearchPublic: %v", err)
+	}
+	if len(hits) != len(seed.JobIDs) {
+		t.Errorf("found %d jobs across the antimeridian, want %d", len(hits), len(seed.JobIDs))
+	}
+}
@@ -0,0 +1,41 @@
+package database
+
+import (
+	job_application_db "github.com/yakka-backend/internal/features/job_applications/entity/database"
+	job_assignment_db "github.com/yakka-backend/internal/features/job_assignments/entity/database"
+	dbInfra "github.com/yakka-backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// JobTxRepositories are the repositories written together when a job or its applicants change
+type JobTxRepositories struct {
+	Jobs               JobRepository
+	JobLicenses        JobLicenseRepository
+	JobSkills          JobSkillRepository
+	JobJobRequirements JobJobRequirementRepository
+	Revisions          JobRevisionRepository
+	Applications       job_application_db.JobApplicationRepository
+	ApplicationEvents  job_application_db.ApplicationStatusEventRepository
+	ApplicationReviews job_application_db.ApplicationReviewRepository
+	Assignments        job_assignment_db.JobAssignmentRepository
+}
+
+// JobUnitOfWork runs job writes in a single transaction
+type JobUnitOfWork = dbInfra.UnitOfWork[JobTxRepositories]
+
+// NewJobUnitOfWork creates a unit of work for the job repositories
+func NewJobUnitOfWork(db *gorm.DB) JobUnitOfWork {
+	return dbInfra.NewUnitOfWork(db, func(tx *gorm.DB) JobTxRepositories {
+		return JobTxRepositories{
+			Jobs:               NewJobRepository(tx),
+			JobLicenses:        NewJobLicenseRepository(tx),
+			JobSkills:          NewJobSkillRepository(tx),
+			JobJobRequirements: NewJobJobRequirementRepository(tx),
+			Revisions:          NewJobRevisionRepository(tx),
+			Applications:       job_application_db.NewJobApplicationRepository(tx),
+			ApplicationEvents:  job_application_db.NewApplicationStatusEventRepository(tx),
+			ApplicationReviews: job_application_db.NewApplicationReviewRepository(tx),
+			Assignments:        job_assignment_db.NewJobAssignmentRepository(tx),
+		}
+	})
+}
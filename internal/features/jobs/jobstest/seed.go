@@ -0,0 +1,137 @@
+// Package jobstest seeds builders, jobsites, jobs and applicants into a test database
+// created with dbtest, for integration tests and benchmarks of the job listings.
+package jobstest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Point is a jobsite location
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Options sizes the seeded data
+type Options struct {
+	Builders           int
+	JobsitesPerBuilder int     // jobsites per builder; jobs are spread over them
+	JobsPerBuilder     int     // open public jobs per builder
+	ApplicantsPerJob   int     // labourers who applied to every job
+	Center             Point   // jobsites are placed on a line starting here
+	SpacingKm          float64 // distance between consecutive jobsites, 0 puts them all on Center
+}
+
+// Seed holds the IDs of what was created
+type Seed struct {
+	JobTypeID         uuid.UUID
+	SkillCategoryID   uuid.UUID
+	LicenseID         uuid.UUID
+	BuilderUserIDs    []uuid.UUID
+	BuilderProfileIDs []uuid.UUID
+	JobsiteIDs        []uuid.UUID
+	JobIDs            []uuid.UUID
+	LabourUserIDs     []uuid.UUID
+	ApplicationIDs    []uuid.UUID
+}
+
+// kmPerDegree is the length of one degree of latitude
+const kmPerDegree = 111.195
+
+// Create inserts the data described by opts
+func Create(tb testing.TB, db *gorm.DB, opts Options) *Seed {
+	tb.Helper()
+
+	if opts.JobsitesPerBuilder < 1 {
+		opts.JobsitesPerBuilder = 1
+	}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	seed := &Seed{JobTypeID: uuid.New(), SkillCategoryID: uuid.New(), LicenseID: uuid.New()}
+
+	exec(tb, db, `INSERT INTO job_types (id, name, is_active, created_at, updated_at) VALUES (?, ?, true, ?, ?)`,
+		seed.JobTypeID, "Labourer "+seed.JobTypeID.String()[:8], now, now)
+	exec(tb, db, `INSERT INTO skill_categories (id, name, created_at, updated_at) VALUES (?, ?, ?, ?)`,
+		seed.SkillCategoryID, "Concreting "+seed.SkillCategoryID.String()[:8], now, now)
+	exec(tb, db, `INSERT INTO licenses (id, name, created_at, updated_at) VALUES (?, ?, ?, ?)`,
+		seed.LicenseID, "White Card "+seed.LicenseID.String()[:8], now, now)
+
+	for a := 0; a < opts.ApplicantsPerJob; a++ {
+		userID := createUser(tb, db, "labour", now)
+		exec(tb, db, `INSERT INTO labour_profiles (id, user_id, created_at, updated_at) VALUES (?, ?, ?, ?)`,
+			uuid.New(), userID, now, now)
+		seed.LabourUserIDs = append(seed.LabourUserIDs, userID)
+	}
+
+	site := 0
+	for b := 0; b < opts.Builders; b++ {
+		userID := createUser(tb, db, "builder", now)
+		profileID := uuid.New()
+		exec(tb, db, `INSERT INTO builder_profiles (id, user_id, display_name, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+			profileID, userID, fmt.Sprintf("Builder %d", b), now, now)
+		seed.BuilderUserIDs = append(seed.BuilderUserIDs, userID)
+		seed.BuilderProfileIDs = append(seed.BuilderProfileIDs, profileID)
+
+		jobsites := make([]uuid.UUID, 0, opts.JobsitesPerBuilder)
+		for s := 0; s < opts.JobsitesPerBuilder; s++ {
+			jobsiteID := uuid.New()
+			lat := opts.Center.Latitude + float64(site)*opts.SpacingKm/kmPerDegree
+			exec(tb, db, `INSERT INTO jobsites (id, builder_id, address, latitude, longitude, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				jobsiteID, userID, fmt.Sprintf("%d Test Street", site+1), lat, opts.Center.Longitude, now, now)
+			jobsites = append(jobsites, jobsiteID)
+			seed.JobsiteIDs = append(seed.JobsiteIDs, jobsiteID)
+			site++
+		}
+
+		for j := 0; j < opts.JobsPerBuilder; j++ {
+			jobID := uuid.New()
+			createdAt := now.Add(-time.Duration(len(seed.JobIDs)) * time.Minute)
+			// Rates repeat so the pay order has ties broken by ID
+			rate := 30 + float64(len(seed.JobIDs)%5)*2.5
+			exec(tb, db, `INSERT INTO jobs (id, builder_profile_id, jobsite_id, job_type_id, many_labours, wage_hourly_rate,
+				start_date_work, end_date_work, visibility, status, payment_type, created_at, updated_at)
+				VALUES (?, ?, ?, ?, 2, ?, ?, ?, 'PUBLIC', 'OPEN', 'WEEKLY', ?, ?)`,
+				jobID, profileID, jobsites[j%len(jobsites)], seed.JobTypeID, rate,
+				now.AddDate(0, 0, 7), now.AddDate(0, 1, 0), createdAt, now)
+			exec(tb, db, `INSERT INTO job_licenses (id, job_id, license_id, required, created_at) VALUES (?, ?, ?, true, ?)`,
+				uuid.New(), jobID, seed.LicenseID, now)
+			exec(tb, db, `INSERT INTO job_skills (id, job_id, skill_category_id, created_at) VALUES (?, ?, ?, ?)`,
+				uuid.New(), jobID, seed.SkillCategoryID, now)
+			seed.JobIDs = append(seed.JobIDs, jobID)
+
+			for _, labourUserID := range seed.LabourUserIDs {
+				applicationID := uuid.New()
+				exec(tb, db, `INSERT INTO job_applications (id, job_id, labour_user_id, status, created_at, updated_at) VALUES (?, ?, ?, 'APPLIED', ?, ?)`,
+					applicationID, jobID, labourUserID, now, now)
+				exec(tb, db, `INSERT INTO application_status_events (id, application_id, to_status, actor, actor_id, created_at) VALUES (?, ?, 'APPLIED', 'LABOUR', ?, ?)`,
+					uuid.New(), applicationID, labourUserID, now)
+				seed.ApplicationIDs = append(seed.ApplicationIDs, applicationID)
+			}
+		}
+	}
+
+	return seed
+}
+
+// createUser inserts an active user with the given role
+func createUser(tb testing.TB, db *gorm.DB, role string, now time.Time) uuid.UUID {
+	tb.Helper()
+
+	id := uuid.New()
+	exec(tb, db, `INSERT INTO users (id, email, password_hash, status, role, created_at, updated_at) VALUES (?, ?, '', 'active', ?, ?, ?)`,
+		id, id.String()+"@example.com", role, now, now)
+	return id
+}
+
+// exec runs one statement and fails the test when it errors
+func exec(tb testing.TB, db *gorm.DB, sql string, args ...interface{}) {
+	tb.Helper()
+
+	if err := db.Exec(sql, args...).Error; err != nil {
+		tb.Fatalf("seed: %v", err)
+	}
+}
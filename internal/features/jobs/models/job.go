@@ -33,6 +33,8 @@ type Job struct {
 	SupervisorName              *string       `json:"supervisor_name" gorm:"size:100"`
 	Visibility                  JobVisibility `json:"visibility" gorm:"type:varchar(20);not null;default:'DRAFT'"`
 	PaymentType                 PaymentType   `json:"payment_type" gorm:"type:varchar(20);not null;default:'WEEKLY'"`
+	Status                      JobStatus     `json:"status" gorm:"type:varchar(20);not null;default:'OPEN'"`
+	RejectRemainingWhenFilled   bool          `json:"reject_remaining_when_filled" gorm:"not null;default:false"` // set by bulk decisions, applied when accepted offers fill the job
 	CreatedAt                   time.Time     `json:"created_at" gorm:"not null;type:timestamptz"`
 	UpdatedAt                   time.Time     `json:"updated_at" gorm:"not null;type:timestamptz"`
 
@@ -55,6 +57,7 @@ type JobLicense struct {
 	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	JobID     uuid.UUID `json:"job_id" gorm:"type:uuid;not null"`
 	LicenseID uuid.UUID `json:"license_id" gorm:"type:uuid;not null"`
+	Required  bool      `json:"required" gorm:"not null"` // false when the license is only preferred; no gorm default so false is written
 	CreatedAt time.Time `json:"created_at" gorm:"not null;type:timestamptz"`
 
 	// Relations - loaded separately to avoid circular imports
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobRevision records one edit of a job, numbered per job starting at 1
+type JobRevision struct {
+	ID               uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	JobID            uuid.UUID `json:"job_id" gorm:"type:uuid;not null;uniqueIndex:idx_job_revisions_job_version"`
+	Version          int       `json:"version" gorm:"not null;uniqueIndex:idx_job_revisions_job_version"`
+	BuilderProfileID uuid.UUID `json:"builder_profile_id" gorm:"type:uuid;not null"`
+	Material         bool      `json:"material" gorm:"not null;default:false"` // pay, dates or head count changed
+	CreatedAt        time.Time `json:"created_at" gorm:"not null;type:timestamptz"`
+
+	Changes []JobRevisionChange `json:"changes,omitempty" gorm:"foreignKey:RevisionID"`
+}
+
+// TableName returns the table name for the JobRevision model
+func (JobRevision) TableName() string {
+	return "job_revisions"
+}
+
+// JobRevisionChange is the old and new value of one field in a revision
+type JobRevisionChange struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	RevisionID uuid.UUID `json:"revision_id" gorm:"type:uuid;not null;index"`
+	Field      string    `json:"field" gorm:"type:varchar(50);not null"`
+	OldValue   *string   `json:"old_value" gorm:"type:text"`
+	NewValue   *string   `json:"new_value" gorm:"type:text"`
+	Material   bool      `json:"material" gorm:"not null;default:false"`
+}
+
+// TableName returns the table name for the JobRevisionChange model
+func (JobRevisionChange) TableName() string {
+	return "job_revision_changes"
+}
@@ -0,0 +1,51 @@
+package models
+
+// JobStatus represents where a job is in its lifecycle, independent of who can see it
+type JobStatus string
+
+const (
+	JobStatusOpen       JobStatus = "OPEN"
+	JobStatusFilled     JobStatus = "FILLED"
+	JobStatusInProgress JobStatus = "IN_PROGRESS"
+	JobStatusCompleted  JobStatus = "COMPLETED"
+	JobStatusCancelled  JobStatus = "CANCELLED"
+	JobStatusExpired    JobStatus = "EXPIRED"
+)
+
+// jobStatusTransitions lists the statuses each status may move to.
+// COMPLETED, CANCELLED and EXPIRED are final.
+var jobStatusTransitions = map[JobStatus][]JobStatus{
+	JobStatusOpen:       {JobStatusFilled, JobStatusInProgress, JobStatusCancelled, JobStatusExpired},
+	JobStatusFilled:     {JobStatusOpen, JobStatusInProgress, JobStatusCancelled},
+	JobStatusInProgress: {JobStatusCompleted, JobStatusCancelled},
+}
+
+// IsValid checks if the job status is valid
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case JobStatusOpen, JobStatusFilled, JobStatusInProgress, JobStatusCompleted, JobStatusCancelled, JobStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo reports whether a job may move from this status to next
+func (s JobStatus) CanTransitionTo(next JobStatus) bool {
+	for _, allowed := range jobStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptsApplications reports whether labourers can still apply
+func (s JobStatus) AcceptsApplications() bool {
+	return s == JobStatusOpen
+}
+
+// String returns the string representation of the status
+func (s JobStatus) String() string {
+	return string(s)
+}
@@ -2,11 +2,52 @@ package payload
 
 import "time"
 
-// BuilderApplicantDecisionRequest represents the request to hire or reject an applicant
+// BuilderApplicantDecisionRequest represents the request to hire or reject an applicant.
+// Hiring sends the applicant an offer; dates and rate left out are taken from the job.
 type BuilderApplicantDecisionRequest struct {
-	ApplicationID string     `json:"application_id" validate:"required,uuid"`
-	Hired         *bool      `json:"hired" validate:"required"`
-	StartDate     *time.Time `json:"start_date" validate:"omitempty"`
-	EndDate       *time.Time `json:"end_date" validate:"omitempty"`
-	Reason        *string    `json:"reason" validate:"omitempty"`
+	ApplicationID  string     `json:"application_id" validate:"required,uuid"`
+	Hired          *bool      `json:"hired" validate:"required"`
+	StartDate      *time.Time `json:"start_date" validate:"omitempty"`
+	EndDate        *time.Time `json:"end_date" validate:"omitempty"`
+	Rate           *float64   `json:"rate" validate:"omitempty,gt=0"`        // final hourly rate of the offer
+	OfferExpiresAt *time.Time `json:"offer_expires_at" validate:"omitempty"` // deadline to answer the offer
+	Reason         *string    `json:"reason" validate:"omitempty"`
+}
+
+// ShortlistApplicantRequest represents the request to shortlist an applicant, moving the application to REVIEWED
+type ShortlistApplicantRequest struct {
+	Reason *string `json:"reason" validate:"omitempty,max=500"`
+}
+
+// ApplicantReviewRequest represents the builder's private review of an applicant.
+// It replaces the previous review: fields left out are cleared.
+type ApplicantReviewRequest struct {
+	Notes  *string  `json:"notes" validate:"omitempty,max=5000"`
+	Rating *int     `json:"rating" validate:"omitempty,min=1,max=5"`
+	Tags   []string `json:"tags" validate:"omitempty,max=10,dive,min=1,max=30"`
+}
+
+// BuilderApplicantFilter narrows the applicants listed to a builder. Empty fields do not filter.
+type BuilderApplicantFilter struct {
+	Status    *string  `validate:"omitempty,oneof=APPLIED REVIEWED OFFERED ACCEPTED DECLINED EXPIRED REJECTED WITHDRAWN"`
+	MinRating *int     `validate:"omitempty,min=1,max=5"`
+	Tags      []string // applicants must have every tag
+	Notes     *string  // text the builder's notes must contain, case insensitive
+}
+
+// BulkApplicantDecisionRequest represents the request to hire or reject many applicants of one job at once
+type BulkApplicantDecisionRequest struct {
+	Decisions                 []BulkApplicantDecisionItem `json:"decisions" validate:"required,min=1,max=100,dive"`
+	RejectRemainingWhenFilled bool                        `json:"reject_remaining_when_filled"` // reject APPLIED and REVIEWED applicants once accepted offers take every place
+}
+
+// BulkApplicantDecisionItem represents one decision of a bulk request
+type BulkApplicantDecisionItem struct {
+	ApplicationID  string     `json:"application_id" validate:"required"`
+	Hired          *bool      `json:"hired" validate:"required"`
+	StartDate      *time.Time `json:"start_date" validate:"omitempty"`
+	EndDate        *time.Time `json:"end_date" validate:"omitempty"`
+	Rate           *float64   `json:"rate" validate:"omitempty,gt=0"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at" validate:"omitempty"`
+	Reason         *string    `json:"reason" validate:"omitempty,max=500"`
 }
@@ -4,22 +4,28 @@ import "time"
 
 // LabourApplicantInfo represents the labour user information for an applicant
 type LabourApplicantInfo struct {
-	UserID    string  `json:"user_id"`
-	FullName  string  `json:"full_name"`
-	AvatarURL *string `json:"avatar_url"`
-	Phone     *string `json:"phone"`
-	Email     string  `json:"email"`
+	UserID        string  `json:"user_id"`
+	FullName      string  `json:"full_name"`
+	AvatarURL     *string `json:"avatar_url"`
+	Phone         *string `json:"phone"`
+	PhoneVerified bool    `json:"phone_verified"`
+	Email         string  `json:"email"`
 }
 
 // JobApplicantInfo represents a job application with labour information
 type JobApplicantInfo struct {
-	ApplicationID string              `json:"application_id"`
-	Status        string              `json:"status"`
-	CoverLetter   *string             `json:"cover_letter"`
-	ExpectedRate  *float64            `json:"expected_rate"`
-	ResumeURL     *string             `json:"resume_url"`
-	AppliedAt     time.Time           `json:"applied_at"`
-	Labour        LabourApplicantInfo `json:"labour"`
+	ApplicationID string                 `json:"application_id"`
+	Status        string                 `json:"status"`
+	CoverLetter   *string                `json:"cover_letter"`
+	ExpectedRate  *float64               `json:"expected_rate"`
+	ResumeURL     *string                `json:"resume_url"`
+	AppliedAt     time.Time              `json:"applied_at"`
+	JobChangedAt  *time.Time             `json:"job_changed_at"` // job edited materially and not yet confirmed by the labourer
+	Offer         *ApplicationOfferInfo  `json:"offer,omitempty"`
+	Timeline      []ApplicationEventInfo `json:"timeline"` // status changes, oldest first
+	Labour        LabourApplicantInfo    `json:"labour"`
+	Match         *JobMatchResponse      `json:"match,omitempty"`  // fit with the job, applicants are ranked by it
+	Review        *ApplicantReviewInfo   `json:"review,omitempty"` // the builder's private review, never shown to the labourer
 }
 
 // JobWithApplicants represents a job with all its applicants
@@ -57,8 +63,72 @@ type BuilderApplicantsByJobsiteResponse struct {
 
 // BuilderApplicantDecisionResponse represents the response when hiring or rejecting an applicant
 type BuilderApplicantDecisionResponse struct {
-	ApplicationID string  `json:"application_id"`
-	Hired         bool    `json:"hired"`
-	AssignmentID  *string `json:"assignment_id,omitempty"` // Only present if hired
-	Message       string  `json:"message"`
+	ApplicationID string                `json:"application_id"`
+	Hired         bool                  `json:"hired"`
+	Status        string                `json:"status"`
+	Offer         *ApplicationOfferInfo `json:"offer,omitempty"` // Only present if hired
+	Message       string                `json:"message"`
+}
+
+// ApplicationOfferInfo represents the offer a builder made on an application
+type ApplicationOfferInfo struct {
+	StartDate *time.Time `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"`
+	Rate      *float64   `json:"rate"` // final hourly rate
+	OfferedAt time.Time  `json:"offered_at"`
+	ExpiresAt time.Time  `json:"expires_at"` // the labourer must accept or decline before it
+}
+
+// ApplicationEventInfo represents one status change of an application
+type ApplicationEventInfo struct {
+	FromStatus *string   `json:"from_status"` // nil for the creation of the application
+	ToStatus   string    `json:"to_status"`
+	Actor      string    `json:"actor"` // BUILDER, LABOUR or SYSTEM
+	Reason     *string   `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ApplicantReviewInfo represents the builder's private notes, rating and tags on an applicant
+type ApplicantReviewInfo struct {
+	Notes     *string   `json:"notes"`
+	Rating    *int      `json:"rating"`
+	Tags      []string  `json:"tags"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ApplicantReviewResponse represents the response when a builder reviews an applicant
+type ApplicantReviewResponse struct {
+	ApplicationID string              `json:"application_id"`
+	Review        ApplicantReviewInfo `json:"review"`
+	Message       string              `json:"message"`
+}
+
+// ShortlistApplicantResponse represents the response when a builder shortlists an applicant
+type ShortlistApplicantResponse struct {
+	ApplicationID string `json:"application_id"`
+	Status        string `json:"status"`
+	Message       string `json:"message"`
+}
+
+// BulkApplicantDecisionResponse represents the outcome of a bulk decision, one result per decision
+type BulkApplicantDecisionResponse struct {
+	JobID           string                        `json:"job_id"`
+	JobStatus       string                        `json:"job_status"`
+	RemainingPlaces int                           `json:"remaining_places"`
+	Offered         int                           `json:"offered"`
+	Rejected        int                           `json:"rejected"`
+	Failed          int                           `json:"failed"`
+	Results         []BulkApplicantDecisionResult `json:"results"`       // in the order of the request
+	AutoRejected    []string                      `json:"auto_rejected"` // applications rejected because every place was taken
+	Message         string                        `json:"message"`
+}
+
+// BulkApplicantDecisionResult represents the outcome of one decision of a bulk request
+type BulkApplicantDecisionResult struct {
+	ApplicationID string                `json:"application_id"`
+	Hired         bool                  `json:"hired"`
+	Success       bool                  `json:"success"`
+	Status        string                `json:"status,omitempty"` // status of the application after the decision
+	Offer         *ApplicationOfferInfo `json:"offer,omitempty"`  // only present if an offer was sent
+	Error         *string               `json:"error,omitempty"`  // why the decision was not applied
 }
@@ -0,0 +1,23 @@
+package payload
+
+// Kinds of items compared when matching a labourer with a job
+const (
+	JobMatchItemSkill       = "SKILL"
+	JobMatchItemLicense     = "LICENSE"
+	JobMatchItemRequirement = "REQUIREMENT"
+)
+
+// JobMatchResponse represents how well a labourer fits a job and why
+type JobMatchResponse struct {
+	Score   int            `json:"score"` // 0 to 100
+	Matched []JobMatchItem `json:"matched"`
+	Missing []JobMatchItem `json:"missing"`
+}
+
+// JobMatchItem represents one skill, license or requirement of the job
+type JobMatchItem struct {
+	Type   string  `json:"type"` // SKILL, LICENSE or REQUIREMENT
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Detail *string `json:"detail,omitempty"` // e.g. experience for skills, expiry for licenses
+}
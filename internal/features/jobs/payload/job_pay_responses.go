@@ -0,0 +1,56 @@
+package payload
+
+import "github.com/yakka-backend/internal/shared/money"
+
+// Kinds of day in a pay estimate
+const (
+	PayDayWeekday  = "WEEKDAY"
+	PayDaySaturday = "SATURDAY"
+	PayDaySunday   = "SUNDAY"
+)
+
+// Pay periods, one per payment type
+const (
+	PayPeriodWeek      = "WEEK"      // WEEKLY
+	PayPeriodFortnight = "FORTNIGHT" // FORTNIGHTLY
+	PayPeriodMonth     = "MONTH"     // FIXED_DAY, paid once a month on the payment day
+)
+
+// PayEstimateResponse represents what a labourer can expect to earn on a job.
+// Amounts are in dollars with two decimals; GST is kept apart from the pay.
+type PayEstimateResponse struct {
+	Currency     string           `json:"currency"`
+	PaymentType  string           `json:"payment_type"`
+	HourlyRate   money.Amount     `json:"hourly_rate"`
+	GSTPercent   int              `json:"gst_percent"`
+	Days         []DayPayEstimate `json:"days"` // one per kind of day worked
+	PerWeek      PayAmount        `json:"per_week"`
+	PayPeriod    string           `json:"pay_period"` // WEEK, FORTNIGHT or MONTH
+	PerPayPeriod PayAmount        `json:"per_pay_period"`
+	WholeJob     *JobPayEstimate  `json:"whole_job,omitempty"` // only for jobs with start and end dates
+	Notes        []string         `json:"notes,omitempty"`     // assumptions made for missing job fields
+}
+
+// DayPayEstimate represents the pay of one day of work
+type DayPayEstimate struct {
+	Day           string       `json:"day"` // WEEKDAY, SATURDAY or SUNDAY
+	OrdinaryHours float64      `json:"ordinary_hours"`
+	OvertimeHours float64      `json:"overtime_hours"`
+	OrdinaryPay   money.Amount `json:"ordinary_pay"`
+	OvertimePay   money.Amount `json:"overtime_pay"`
+	Allowances    money.Amount `json:"allowances"`
+	PayAmount
+}
+
+// JobPayEstimate represents the pay of the whole job, from its start date to its end date
+type JobPayEstimate struct {
+	WorkingDays int `json:"working_days"`
+	PayAmount
+}
+
+// PayAmount represents an amount of pay before and after GST
+type PayAmount struct {
+	Subtotal money.Amount `json:"subtotal"`
+	GST      money.Amount `json:"gst"`
+	Total    money.Amount `json:"total"`
+}
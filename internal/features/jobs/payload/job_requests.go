@@ -33,7 +33,8 @@ type CreateJobRequest struct {
 	SupervisorName              *string              `json:"supervisor_name"`
 	Visibility                  models.JobVisibility `json:"visibility"`
 	PaymentType                 models.PaymentType   `json:"payment_type"`
-	LicenseIDs                  []uuid.UUID          `json:"license_ids"`
+	LicenseIDs                  []uuid.UUID          `json:"license_ids"`           // Hard-required licenses
+	PreferredLicenseIDs         []uuid.UUID          `json:"preferred_license_ids"` // Licenses that only improve the match
 	SkillCategoryIDs            []uuid.UUID          `json:"skill_category_ids"`
 	SkillSubcategoryIDs         []uuid.UUID          `json:"skill_subcategory_ids"`
 	JobSkills                   []JobSkillRequest    `json:"job_skills,omitempty"`
@@ -45,9 +46,16 @@ type UpdateJobVisibilityRequest struct {
 	Visibility models.JobVisibility `json:"visibility" validate:"required,oneof=DRAFT PUBLIC PRIVATE"`
 }
 
-// UpdateJobRequest represents the request to update a job
+// UpdateJobStatusRequest represents the request to move a job through its lifecycle.
+// OPEN, FILLED and EXPIRED are set by the system from applications and dates.
+type UpdateJobStatusRequest struct {
+	Status models.JobStatus `json:"status" validate:"required,oneof=IN_PROGRESS COMPLETED CANCELLED"`
+}
+
+// UpdateJobRequest represents the request to update a job.
+// Omitted fields keep their current value; a link list that is sent (even empty) replaces the stored set.
 type UpdateJobRequest struct {
-	ManyLabours                 *int                  `json:"many_labours"`
+	ManyLabours                 *int                  `json:"many_labours" validate:"omitempty,min=1"`
 	OngoingWork                 *bool                 `json:"ongoing_work"`
 	WageSiteAllowance           *float64              `json:"wage_site_allowance"`
 	WageLeadingHandAllowance    *float64              `json:"wage_leading_hand_allowance"`
@@ -66,11 +74,13 @@ type UpdateJobRequest struct {
 	PaymentDay                  *time.Time            `json:"payment_day"`
 	RequiresSupervisorSignature *bool                 `json:"requires_supervisor_signature"`
 	SupervisorName              *string               `json:"supervisor_name"`
-	Visibility                  *models.JobVisibility `json:"visibility"`
+	Visibility                  *models.JobVisibility `json:"visibility" validate:"omitempty,oneof=DRAFT PUBLIC PRIVATE"`
 	PaymentType                 *models.PaymentType   `json:"payment_type"`
 	LicenseIDs                  []uuid.UUID           `json:"license_ids"`
+	PreferredLicenseIDs         []uuid.UUID           `json:"preferred_license_ids"`
 	SkillCategoryIDs            []uuid.UUID           `json:"skill_category_ids"`
 	SkillSubcategoryIDs         []uuid.UUID           `json:"skill_subcategory_ids"`
+	JobSkills                   []JobSkillRequest     `json:"job_skills"`
 	JobRequirementIDs           []uuid.UUID           `json:"job_requirement_ids"`
 }
 
@@ -30,8 +30,10 @@ type JobResponse struct {
 	RequiresSupervisorSignature bool                 `json:"requires_supervisor_signature"`
 	SupervisorName              *string              `json:"supervisor_name,omitempty"`
 	Visibility                  models.JobVisibility `json:"visibility"`
+	Status                      models.JobStatus     `json:"status"`
 	PaymentType                 models.PaymentType   `json:"payment_type"`
-	TotalWage                   *float64             `json:"total_wage,omitempty"`
+	TotalWage                   *float64             `json:"total_wage,omitempty"` // pay per pay period before GST, see pay_estimate
+	PayEstimate                 *PayEstimateResponse `json:"pay_estimate,omitempty"`
 	CreatedAt                   time.Time            `json:"created_at"`
 	UpdatedAt                   time.Time            `json:"updated_at"`
 
@@ -86,6 +88,7 @@ type JobLicenseResponse struct {
 	JobID     uuid.UUID        `json:"job_id"`
 	LicenseID uuid.UUID        `json:"license_id"`
 	License   *LicenseResponse `json:"license,omitempty"`
+	Required  bool             `json:"required"`
 	CreatedAt time.Time        `json:"created_at"`
 }
 
@@ -135,8 +138,9 @@ type GetJobResponse struct {
 
 // GetBuilderJobDetailResponse represents the response when getting a builder job detail (shows null values)
 type GetBuilderJobDetailResponse struct {
-	Job     JobDetailResponse `json:"job"`
-	Message string            `json:"message"`
+	Job       JobDetailResponse     `json:"job"`
+	Revisions []JobRevisionResponse `json:"revisions"`
+	Message   string                `json:"message"`
 }
 
 // JobDetailResponse represents a job in detail API responses (shows null values)
@@ -162,8 +166,10 @@ type JobDetailResponse struct {
 	RequiresSupervisorSignature bool                 `json:"requires_supervisor_signature"`
 	SupervisorName              *string              `json:"supervisor_name"`
 	Visibility                  models.JobVisibility `json:"visibility"`
+	Status                      models.JobStatus     `json:"status"`
 	PaymentType                 models.PaymentType   `json:"payment_type"`
-	TotalWage                   *float64             `json:"total_wage"`
+	TotalWage                   *float64             `json:"total_wage"` // pay per pay period before GST, see pay_estimate
+	PayEstimate                 *PayEstimateResponse `json:"pay_estimate"`
 	CreatedAt                   time.Time            `json:"created_at"`
 	UpdatedAt                   time.Time            `json:"updated_at"`
 
@@ -190,7 +196,14 @@ type UpdateJobResponse struct {
 
 // DeleteJobResponse represents the response when deleting a job
 type DeleteJobResponse struct {
-	Message string `json:"message"`
+	Archived bool   `json:"archived"` // true when active assignments kept the job as ARCHIVED
+	Message  string `json:"message"`
+}
+
+// UpdateJobStatusResponse represents the response when updating job status
+type UpdateJobStatusResponse struct {
+	Job     JobResponse `json:"job"`
+	Message string      `json:"message"`
 }
 
 // UpdateJobVisibilityResponse represents the response when updating job visibility
@@ -201,21 +214,40 @@ type UpdateJobVisibilityResponse struct {
 
 // LabourJobDetailResponse represents the response for labour job detail with application info
 type LabourJobDetailResponse struct {
-	Job         JobResponse         `json:"job"`
-	Application *JobApplicationInfo `json:"application"`
-	Message     string              `json:"message"`
+	Job         JobResponse           `json:"job"`
+	Application *JobApplicationInfo   `json:"application"`
+	Revisions   []JobRevisionResponse `json:"revisions"`
+	Message     string                `json:"message"`
 }
 
 // JobApplicationInfo represents application information for a labour user
 type JobApplicationInfo struct {
-	ID           uuid.UUID  `json:"id"`
-	Status       string     `json:"status"`
-	CoverLetter  *string    `json:"cover_letter,omitempty"`
-	ExpectedRate *float64   `json:"expected_rate,omitempty"`
-	ResumeURL    *string    `json:"resume_url,omitempty"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
-	WithdrawnAt  *time.Time `json:"withdrawn_at,omitempty"`
+	ID           uuid.UUID             `json:"id"`
+	Status       string                `json:"status"`
+	CoverLetter  *string               `json:"cover_letter,omitempty"`
+	ExpectedRate *float64              `json:"expected_rate,omitempty"`
+	ResumeURL    *string               `json:"resume_url,omitempty"`
+	CreatedAt    time.Time             `json:"created_at"`
+	UpdatedAt    time.Time             `json:"updated_at"`
+	WithdrawnAt  *time.Time            `json:"withdrawn_at,omitempty"`
+	JobChangedAt *time.Time            `json:"job_changed_at,omitempty"` // job edited materially since applying, confirm or withdraw
+	Offer        *ApplicationOfferInfo `json:"offer,omitempty"`          // the builder's offer, accept or decline it before it expires
+}
+
+// JobRevisionResponse represents one edit of a job in responses
+type JobRevisionResponse struct {
+	Version   int                         `json:"version"`
+	Material  bool                        `json:"material"`
+	CreatedAt time.Time                   `json:"created_at"`
+	Changes   []JobRevisionChangeResponse `json:"changes"`
+}
+
+// JobRevisionChangeResponse represents a changed field of a job revision
+type JobRevisionChangeResponse struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"old_value"`
+	NewValue *string `json:"new_value"`
+	Material bool    `json:"material"`
 }
 
 // JobRequirementResponse represents a job requirement in responses
@@ -2,7 +2,36 @@ package payload
 
 // LabourApplicationRequest represents the request to apply for a job
 type LabourApplicationRequest struct {
-	JobID       string  `json:"job_id" validate:"required,uuid"`
-	CoverLetter *string `json:"cover_letter" validate:"omitempty"`
-	ResumeURL   *string `json:"resume_url" validate:"omitempty,url"`
+	JobID                   string   `json:"job_id" validate:"required,uuid"`
+	CoverLetter             *string  `json:"cover_letter" validate:"omitempty"`
+	ResumeURL               *string  `json:"resume_url" validate:"omitempty,url"`
+	ConfirmedRequirementIDs []string `json:"confirmed_requirement_ids" validate:"omitempty,dive,uuid"` // job requirements the labourer declares to meet
+}
+
+// Answers to a material edit of a job
+const (
+	JobChangesDecisionConfirm  = "CONFIRM"
+	JobChangesDecisionWithdraw = "WITHDRAW"
+)
+
+// JobChangesDecisionRequest represents the labourer's answer to a material edit of a job they applied to
+type JobChangesDecisionRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=CONFIRM WITHDRAW"`
+}
+
+// UpdateLabourApplicationRequest represents the labourer's edit of an application the builder has not acted on yet
+type UpdateLabourApplicationRequest struct {
+	CoverLetter  *string  `json:"cover_letter" validate:"omitempty"`
+	ExpectedRate *float64 `json:"expected_rate" validate:"omitempty,min=0"`
+	ResumeURL    *string  `json:"resume_url" validate:"omitempty,url"`
+}
+
+// WithdrawLabourApplicationRequest represents the labourer's withdrawal of an application
+type WithdrawLabourApplicationRequest struct {
+	Reason *string `json:"reason" validate:"omitempty,max=500"`
+}
+
+// DeclineOfferRequest represents the labourer turning down the builder's offer
+type DeclineOfferRequest struct {
+	Reason *string `json:"reason" validate:"omitempty,max=500"`
 }
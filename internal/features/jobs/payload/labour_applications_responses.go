@@ -14,16 +14,26 @@ type LabourApplicationResponse struct {
 	Message       string    `json:"message"`
 }
 
+// ApplicationEligibilityResponse lists what a labourer is missing to apply to a job
+type ApplicationEligibilityResponse struct {
+	JobID   string         `json:"job_id"`
+	Missing []JobMatchItem `json:"missing"` // LICENSE or REQUIREMENT items; detail says why, e.g. "expired on 2025-01-31"
+}
+
 // LabourApplicationInfo represents detailed application information for a labour user
 type LabourApplicationInfo struct {
-	ApplicationID string    `json:"application_id"`
-	JobID         string    `json:"job_id"`
-	Status        string    `json:"status"`
-	CoverLetter   *string   `json:"cover_letter"`
-	ExpectedRate  *float64  `json:"expected_rate"`
-	ResumeURL     *string   `json:"resume_url"`
-	AppliedAt     time.Time `json:"applied_at"`
-	Job           JobInfo   `json:"job"`
+	ApplicationID string                 `json:"application_id"`
+	JobID         string                 `json:"job_id"`
+	Status        string                 `json:"status"`
+	CoverLetter   *string                `json:"cover_letter"`
+	ExpectedRate  *float64               `json:"expected_rate"`
+	ResumeURL     *string                `json:"resume_url"`
+	AppliedAt     time.Time              `json:"applied_at"`
+	JobChangedAt  *time.Time             `json:"job_changed_at"` // job edited materially since applying, confirm or withdraw
+	WithdrawnAt   *time.Time             `json:"withdrawn_at,omitempty"`
+	Offer         *ApplicationOfferInfo  `json:"offer,omitempty"` // the builder's offer, accept or decline it before it expires
+	Timeline      []ApplicationEventInfo `json:"timeline"`        // status changes, oldest first
+	Job           JobInfo                `json:"job"`
 }
 
 // JobInfo represents basic job information for labour applications
@@ -34,6 +44,7 @@ type JobInfo struct {
 	EndDate        *time.Time              `json:"end_date"`
 	WageHourlyRate *float64                `json:"wage_hourly_rate"`
 	Visibility     string                  `json:"visibility"`
+	Status         string                  `json:"status"`
 	CreatedAt      time.Time               `json:"created_at"`
 	BuilderProfile *BuilderProfileInfo     `json:"builder_profile,omitempty"`
 	Jobsite        *JobsiteApplicationInfo `json:"jobsite,omitempty"`
@@ -71,3 +82,10 @@ type LabourApplicantsResponse struct {
 	Total        int                     `json:"total"`
 	Message      string                  `json:"message"`
 }
+
+// JobChangesDecisionResponse represents the response after confirming or withdrawing from an edited job
+type JobChangesDecisionResponse struct {
+	ApplicationID string `json:"application_id"`
+	Status        string `json:"status"`
+	Message       string `json:"message"`
+}
@@ -0,0 +1,30 @@
+package payload
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/jobs/models"
+)
+
+// LabourJobSearchRequest represents the filters, sort and page of the labour job search.
+// It is read from the query string of GET /labour/jobs.
+type LabourJobSearchRequest struct {
+	JobTypeID          *uuid.UUID          `json:"job_type_id"`
+	SkillCategoryID    *uuid.UUID          `json:"skill_category_id"`
+	SkillSubcategoryID *uuid.UUID          `json:"skill_subcategory_id"`
+	LicenseID          *uuid.UUID          `json:"license_id"`
+	MinWage            *float64            `json:"min_wage" validate:"omitempty,min=0"` // hourly rate
+	MaxWage            *float64            `json:"max_wage" validate:"omitempty,min=0"`
+	StartFrom          *time.Time          `json:"start_from"` // earliest start date, YYYY-MM-DD
+	StartTo            *time.Time          `json:"start_to"`
+	WorkSaturday       *bool               `json:"work_saturday"`
+	WorkSunday         *bool               `json:"work_sunday"`
+	PaymentType        *models.PaymentType `json:"payment_type" validate:"omitempty,oneof=FIXED_DAY WEEKLY FORTNIGHTLY"`
+	Latitude           *float64            `json:"lat" validate:"omitempty,min=-90,max=90"`
+	Longitude          *float64            `json:"lng" validate:"omitempty,min=-180,max=180"`
+	RadiusKm           *float64            `json:"radius_km" validate:"omitempty,gt=0,max=500"`
+	Sort               string              `json:"sort" validate:"omitempty,oneof=recent pay distance"`
+	Cursor             string              `json:"cursor"`
+	Limit              int                 `json:"limit" validate:"omitempty,min=1,max=100"`
+}
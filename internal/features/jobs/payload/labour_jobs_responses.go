@@ -4,20 +4,22 @@ import "time"
 
 // LabourJobInfo represents a job with application status for a labour user
 type LabourJobInfo struct {
-	JobID           string     `json:"job_id"`
-	Title           string     `json:"title"`
-	Description     string     `json:"description"`
-	Location        string     `json:"location"`
-	JobType         string     `json:"job_type"`
-	ManyLabours     int        `json:"many_labours"`
-	ExperienceLevel string     `json:"experience_level"`
-	Status          string     `json:"status"`
-	Visibility      string     `json:"visibility"`
-	TotalWage       *float64   `json:"total_wage"`
-	StartDate       *time.Time `json:"start_date"`
-	EndDate         *time.Time `json:"end_date"`
-	CreatedAt       time.Time  `json:"created_at"`
-	UpdatedAt       time.Time  `json:"updated_at"`
+	JobID           string               `json:"job_id"`
+	Title           string               `json:"title"`
+	Description     string               `json:"description"`
+	Location        string               `json:"location"`
+	JobType         string               `json:"job_type"`
+	ManyLabours     int                  `json:"many_labours"`
+	ExperienceLevel string               `json:"experience_level"`
+	Status          string               `json:"status"`
+	Visibility      string               `json:"visibility"`
+	TotalWage       *float64             `json:"total_wage"` // pay per pay period before GST, see pay_estimate
+	PayEstimate     *PayEstimateResponse `json:"pay_estimate"`
+	DistanceKm      *float64             `json:"distance_km,omitempty"` // only when searching around a point
+	StartDate       *time.Time           `json:"start_date"`
+	EndDate         *time.Time           `json:"end_date"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
 
 	// Builder information
 	Builder BuilderInfo `json:"builder"`
@@ -32,6 +34,9 @@ type LabourJobInfo struct {
 	HasApplied        bool    `json:"has_applied"`
 	ApplicationStatus *string `json:"application_status"` // null if not applied
 	ApplicationID     *string `json:"application_id"`     // null if not applied
+
+	// Match is only set on recommended jobs
+	Match *JobMatchResponse `json:"match,omitempty"`
 }
 
 // BuilderInfo represents basic builder information
@@ -85,7 +90,8 @@ type SkillSubcategoryInfo struct {
 
 // LabourJobsResponse represents the response for labour jobs
 type LabourJobsResponse struct {
-	Jobs    []LabourJobInfo `json:"jobs"`
-	Total   int             `json:"total"`
-	Message string          `json:"message"`
+	Jobs       []LabourJobInfo `json:"jobs"`
+	Total      int             `json:"total"`       // jobs in this page
+	NextCursor *string         `json:"next_cursor"` // null on the last page
+	Message    string          `json:"message"`
 }
@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	job_application_usecase "github.com/yakka-backend/internal/features/job_applications/usecase"
+	job_assignment_models "github.com/yakka-backend/internal/features/job_assignments/models"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+)
+
+// jobFilledReason is stored on the applications rejected because every place of their job was taken
+const jobFilledReason = "job filled"
+
+// BulkApplicantDecision sends offers to and rejects many applicants of one of the builder's jobs
+// in a single transaction. Offers beyond the job's remaining places are refused. Decisions that
+// cannot be applied are reported in their result and skipped; the others are stored together.
+// The request's wish to reject the remaining applicants is kept on the job: they are rejected
+// once accepted offers take every place, now or when the acceptance that fills the job comes.
+func (u *jobUsecase) BulkApplicantDecision(ctx context.Context, builderProfileID uuid.UUID, jobID uuid.UUID, req payload.BulkApplicantDecisionRequest) (*payload.BulkApplicantDecisionResponse, error) {
+	job, err := u.jobRepo.GetByID(ctx, jobID)
+	if err != nil || job.BuilderProfileID != builderProfileID {
+		return nil, fmt.Errorf("job not found")
+	}
+
+	var response *payload.BulkApplicantDecisionResponse
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		// Lock the job so concurrent offers cannot both take its last places
+		job, err := repos.Jobs.GetByIDForUpdate(ctx, jobID)
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+		places, err := remainingPlaces(ctx, repos, job)
+		if err != nil {
+			return err
+		}
+
+		response = &payload.BulkApplicantDecisionResponse{
+			JobID:        job.ID.String(),
+			Results:      make([]payload.BulkApplicantDecisionResult, 0, len(req.Decisions)),
+			AutoRejected: []string{},
+		}
+		seen := make(map[uuid.UUID]bool, len(req.Decisions))
+		for _, decision := range req.Decisions {
+			result, err := u.applyBulkDecision(ctx, repos, job, builderProfileID, decision, seen, &places)
+			if err != nil {
+				return err
+			}
+			if result.Success && result.Hired {
+				response.Offered++
+			} else if result.Success {
+				response.Rejected++
+			} else {
+				response.Failed++
+			}
+			response.Results = append(response.Results, result)
+		}
+
+		if job.RejectRemainingWhenFilled != req.RejectRemainingWhenFilled {
+			if err := repos.Jobs.UpdateRejectRemainingWhenFilled(ctx, job.ID, req.RejectRemainingWhenFilled); err != nil {
+				return fmt.Errorf("failed to update job: %w", err)
+			}
+			job.RejectRemainingWhenFilled = req.RejectRemainingWhenFilled
+		}
+		rejected, err := rejectRemainingIfFilled(ctx, repos, job)
+		if err != nil {
+			return err
+		}
+		response.AutoRejected = rejected
+
+		// Offers leave the job open; it fills when they are accepted
+		response.RemainingPlaces = places
+		response.JobStatus = string(job.Status)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response.Message = fmt.Sprintf("%d offered, %d rejected, %d failed", response.Offered, response.Rejected, response.Failed)
+	return response, nil
+}
+
+// applyBulkDecision applies one decision of a bulk request. Problems with the decision itself are
+// reported in the result; only storage failures are returned, aborting the whole request.
+func (u *jobUsecase) applyBulkDecision(ctx context.Context, repos database.JobTxRepositories, job *models.Job, builderProfileID uuid.UUID, decision payload.BulkApplicantDecisionItem, seen map[uuid.UUID]bool, places *int) (payload.BulkApplicantDecisionResult, error) {
+	result := payload.BulkApplicantDecisionResult{
+		ApplicationID: decision.ApplicationID,
+		Hired:         *decision.Hired,
+	}
+	fail := func(message string) (payload.BulkApplicantDecisionResult, error) {
+		result.Error = &message
+		return result, nil
+	}
+
+	applicationID, err := uuid.Parse(decision.ApplicationID)
+	if err != nil {
+		return fail("invalid application ID")
+	}
+	if seen[applicationID] {
+		return fail("application appears more than once in the request")
+	}
+	seen[applicationID] = true
+
+	application, err := repos.Applications.GetByID(ctx, applicationID)
+	if err != nil || application.JobID != job.ID {
+		return fail("application not found")
+	}
+	result.Status = string(application.Status)
+
+	if !*decision.Hired {
+		err := job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusRejected, job_application_models.ApplicationActorBuilder, &builderProfileID, decision.Reason)
+		if isDecisionError(err) {
+			return fail(err.Error())
+		}
+		if err != nil {
+			return result, err
+		}
+		result.Success = true
+		result.Status = string(application.Status)
+		return result, nil
+	}
+
+	// Offers need a job that still takes people on
+	if !takesHires(job) {
+		return fail("job is not open for hiring")
+	}
+	if *places == 0 {
+		return fail("job has no places left")
+	}
+
+	terms := offerTerms{StartDate: decision.StartDate, EndDate: decision.EndDate, Rate: decision.Rate, ExpiresAt: decision.OfferExpiresAt}
+	err = sendOffer(ctx, repos, job, application, builderProfileID, terms, decision.Reason)
+	if isDecisionError(err) {
+		return fail(err.Error())
+	}
+	if err != nil {
+		return result, err
+	}
+	*places--
+
+	result.Success = true
+	result.Status = string(application.Status)
+	result.Offer = applicationOfferInfo(application)
+	return result, nil
+}
+
+// takesHires reports whether a job is in a status that still takes people on
+func takesHires(job *models.Job) bool {
+	return job.Status == models.JobStatusOpen || job.Status == models.JobStatusInProgress
+}
+
+// remainingPlaces returns how many more offers a job can take: its places not taken by an
+// active assignment or held by an offer still waiting for an answer
+func remainingPlaces(ctx context.Context, repos database.JobTxRepositories, job *models.Job) (int, error) {
+	active, err := countActiveAssignments(ctx, repos, job)
+	if err != nil {
+		return 0, err
+	}
+	offered, err := repos.Applications.CountPendingOffers(ctx, job.ID, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending offers: %w", err)
+	}
+	if places := job.ManyLabours - int(active) - int(offered); places > 0 {
+		return places, nil
+	}
+	return 0, nil
+}
+
+// countActiveAssignments counts the labourers currently assigned to a job
+func countActiveAssignments(ctx context.Context, repos database.JobTxRepositories, job *models.Job) (int64, error) {
+	activeStatus := job_assignment_models.AssignmentStatusActive
+	_, active, err := repos.Assignments.GetWithFilters(ctx, &job.ID, nil, nil, &activeStatus, 1, 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count job assignments: %w", err)
+	}
+	return active, nil
+}
+
+// rejectRemainingIfFilled rejects the applicants still waiting for a decision when the builder
+// asked for it and accepted offers take every place of the job, returning their IDs. Pending
+// offers do not count: one that is declined or expires gives its place back.
+func rejectRemainingIfFilled(ctx context.Context, repos database.JobTxRepositories, job *models.Job) ([]string, error) {
+	if !job.RejectRemainingWhenFilled {
+		return []string{}, nil
+	}
+	active, err := countActiveAssignments(ctx, repos, job)
+	if err != nil {
+		return nil, err
+	}
+	if active < int64(job.ManyLabours) {
+		return []string{}, nil
+	}
+	return rejectRemainingApplicants(ctx, repos, job)
+}
+
+// rejectRemainingApplicants rejects, on behalf of the system, the applications of a job that are
+// still waiting for a decision, returning their IDs
+func rejectRemainingApplicants(ctx context.Context, repos database.JobTxRepositories, job *models.Job) ([]string, error) {
+	applications, err := repos.Applications.GetByJobIDs(ctx, []uuid.UUID{job.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job applications: %w", err)
+	}
+
+	reason := jobFilledReason
+	rejected := []string{}
+	for _, application := range applications {
+		if application.Status != job_application_models.ApplicationStatusApplied && application.Status != job_application_models.ApplicationStatusReviewed {
+			continue
+		}
+		if err := job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusRejected, job_application_models.ApplicationActorSystem, nil, &reason); err != nil {
+			return nil, err
+		}
+		rejected = append(rejected, application.ID.String())
+	}
+	return rejected, nil
+}
+
+// isDecisionError reports whether an error comes from a decision the application's status
+// does not allow or from invalid offer terms, rather than from storage
+func isDecisionError(err error) bool {
+	return errors.Is(err, job_application_usecase.ErrInvalidTransition) ||
+		errors.Is(err, job_application_usecase.ErrTransitionNotAllowed) ||
+		errors.Is(err, ErrInvalidOffer)
+}
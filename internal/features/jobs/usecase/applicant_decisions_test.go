@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	job_application_db "github.com/yakka-backend/internal/features/job_applications/entity/database"
+	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	job_application_usecase "github.com/yakka-backend/internal/features/job_applications/usecase"
+	job_assignment_db "github.com/yakka-backend/internal/features/job_assignments/entity/database"
+	job_assignment_models "github.com/yakka-backend/internal/features/job_assignments/models"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/models"
+)
+
+func TestIsDecisionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"no error", nil, false},
+		{"invalid transition", fmt.Errorf("%w from WITHDRAWN to REJECTED", job_application_usecase.ErrInvalidTransition), true},
+		{"actor not allowed", fmt.Errorf("%w: BUILDER cannot move an application from APPLIED to WITHDRAWN", job_application_usecase.ErrTransitionNotAllowed), true},
+		{"invalid offer", fmt.Errorf("%w: end date is before start date", ErrInvalidOffer), true},
+		{"wrapped again", fmt.Errorf("bulk decision: %w", ErrInvalidOffer), true},
+		{"storage failure", fmt.Errorf("failed to save offer: %w", errors.New("connection reset")), false},
+		// Only the sentinel counts, not a message that happens to look like one
+		{"lookalike message", errors.New("invalid offer: from the database driver"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDecisionError(tt.err); got != tt.want {
+				t.Errorf("isDecisionError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// jobApplications keeps the applications of one job in memory
+type jobApplications struct {
+	job_application_db.JobApplicationRepository
+	applications []*job_application_models.JobApplication
+}
+
+func (r *jobApplications) GetByJobIDs(ctx context.Context, jobIDs []uuid.UUID) ([]*job_application_models.JobApplication, error) {
+	return r.applications, nil
+}
+
+func (r *jobApplications) UpdateStatus(ctx context.Context, id uuid.UUID, from, to job_application_models.ApplicationStatus) (int64, error) {
+	return 1, nil
+}
+
+// ignoredEvents drops the recorded status changes
+type ignoredEvents struct {
+	job_application_db.ApplicationStatusEventRepository
+}
+
+func (ignoredEvents) Create(ctx context.Context, event *job_application_models.ApplicationStatusEvent) error {
+	return nil
+}
+
+// activeAssignments reports a fixed number of active assignments
+type activeAssignments struct {
+	job_assignment_db.JobAssignmentRepository
+	active int64
+}
+
+func (r activeAssignments) GetWithFilters(ctx context.Context, jobID, labourUserID, applicationID *uuid.UUID, status *job_assignment_models.AssignmentStatus, page, limit int) ([]*job_assignment_models.JobAssignment, int64, error) {
+	return nil, r.active, nil
+}
+
+func TestRejectRemainingIfFilled(t *testing.T) {
+	tests := []struct {
+		name         string
+		reject       bool
+		accepted     int64
+		wantRejected int
+	}{
+		{"not asked for", false, 2, 0},
+		// The second place is only held by an offer that can still be declined
+		{"pending offer holds the last place", true, 1, 0},
+		{"accepted offers take every place", true, 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &models.Job{ID: uuid.New(), ManyLabours: 2, Status: models.JobStatusOpen, RejectRemainingWhenFilled: tt.reject}
+			applications := &jobApplications{}
+			for _, status := range []job_application_models.ApplicationStatus{
+				job_application_models.ApplicationStatusApplied,
+				job_application_models.ApplicationStatusReviewed,
+				job_application_models.ApplicationStatusOffered,
+				job_application_models.ApplicationStatusAccepted,
+				job_application_models.ApplicationStatusWithdrawn,
+			} {
+				applications.applications = append(applications.applications, &job_application_models.JobApplication{ID: uuid.New(), JobID: job.ID, Status: status})
+			}
+			repos := database.JobTxRepositories{
+				Applications:      applications,
+				ApplicationEvents: ignoredEvents{},
+				Assignments:       activeAssignments{active: tt.accepted},
+			}
+
+			rejected, err := rejectRemainingIfFilled(context.Background(), repos, job)
+			if err != nil {
+				t.Fatalf("rejectRemainingIfFilled: %v", err)
+			}
+			if len(rejected) != tt.wantRejected {
+				t.Fatalf("rejected %d applicants, want %d", len(rejected), tt.wantRejected)
+			}
+			for _, id := range rejected {
+				for _, application := range applications.applications {
+					if application.ID.String() == id && application.Status != job_application_models.ApplicationStatusRejected {
+						t.Errorf("application %s is %s, want REJECTED", id, application.Status)
+					}
+				}
+			}
+		})
+	}
+}
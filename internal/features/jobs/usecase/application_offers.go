@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	job_application_usecase "github.com/yakka-backend/internal/features/job_applications/usecase"
+	job_assignment_models "github.com/yakka-backend/internal/features/job_assignments/models"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+)
+
+const (
+	// defaultOfferValidity is how long the labourer has to answer an offer sent without a deadline
+	defaultOfferValidity = 48 * time.Hour
+	// maxOfferValidity bounds how long an offer can hold a place on the job
+	maxOfferValidity = 14 * 24 * time.Hour
+	// offerExpiredReason is stored on the offers expired by the sweep
+	offerExpiredReason = "offer expired"
+	// expiredOffersBatch is how many expired offers the sweep reads at a time
+	expiredOffersBatch = 100
+)
+
+// ErrInvalidOffer is returned when the terms of an offer cannot be accepted
+var ErrInvalidOffer = errors.New("invalid offer")
+
+// offerTerms are the terms a builder offers; terms left out are taken from the job
+type offerTerms struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Rate      *float64
+	ExpiresAt *time.Time
+}
+
+// AcceptOffer accepts the builder's offer on one of the labour user's applications. Accepting
+// creates the active assignment with the offered dates, which can fill the job.
+func (u *jobUsecase) AcceptOffer(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID) (*payload.LabourApplicationInfo, error) {
+	application, err := u.getLabourApplication(ctx, applicationID, labourUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		// Lock the job first: the expiry sweep and other acceptances take the same lock
+		job, err := repos.Jobs.GetByIDForUpdate(ctx, application.JobID)
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+		application, err = repos.Applications.GetByID(ctx, applicationID)
+		if err != nil {
+			return fmt.Errorf("failed to get application: %w", err)
+		}
+		if application.Status == job_application_models.ApplicationStatusOffered && !application.OfferPending(time.Now()) {
+			return fmt.Errorf("offer has expired")
+		}
+		if !takesHires(job) {
+			return fmt.Errorf("job is not open for hiring")
+		}
+
+		// The offer already holds a place, unless the builder lowered the number of labourers since
+		active, err := countActiveAssignments(ctx, repos, job)
+		if err != nil {
+			return err
+		}
+		if active >= int64(job.ManyLabours) {
+			return fmt.Errorf("job has no places left")
+		}
+
+		if err := job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusAccepted, job_application_models.ApplicationActorLabour, &labourUserID, nil); err != nil {
+			return err
+		}
+		if _, err := startAssignment(ctx, repos, application); err != nil {
+			return err
+		}
+
+		// The last acceptance fills the job and, when the builder asked for it, rejects the
+		// applicants still waiting for a decision
+		if _, err := rejectRemainingIfFilled(ctx, repos, job); err != nil {
+			return err
+		}
+		return syncJobCapacity(ctx, repos, job)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.labourApplicationView(ctx, application)
+}
+
+// DeclineOffer turns down the builder's offer on one of the labour user's applications,
+// giving its place back to the job
+func (u *jobUsecase) DeclineOffer(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.DeclineOfferRequest) (*payload.LabourApplicationInfo, error) {
+	application, err := u.getLabourApplication(ctx, applicationID, labourUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		if err := job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusDeclined, job_application_models.ApplicationActorLabour, &labourUserID, req.Reason); err != nil {
+			return err
+		}
+		if application.JobChangedAt != nil {
+			if err := repos.Applications.ClearJobChanged(ctx, application.ID); err != nil {
+				return fmt.Errorf("failed to clear job changes: %w", err)
+			}
+			application.JobChangedAt = nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.labourApplicationView(ctx, application)
+}
+
+// ExpireOverdueOffers moves the offers not answered before their deadline to EXPIRED.
+// Expired offers stop holding a place as soon as their deadline passes; the sweep records it.
+func (u *jobUsecase) ExpireOverdueOffers(ctx context.Context) (int64, error) {
+	now := time.Now()
+	reason := offerExpiredReason
+
+	var expired int64
+	for {
+		applications, err := u.jobApplicationRepo.GetExpiredOffers(ctx, now, expiredOffersBatch)
+		if err != nil {
+			return expired, fmt.Errorf("failed to get expired offers: %w", err)
+		}
+
+		for _, application := range applications {
+			transitioned := false
+			err := u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+				// Lock the job so the offer cannot be accepted while it expires
+				if _, err := repos.Jobs.GetByIDForUpdate(ctx, application.JobID); err != nil {
+					return fmt.Errorf("failed to get job: %w", err)
+				}
+				current, err := repos.Applications.GetByID(ctx, application.ID)
+				if err != nil {
+					return fmt.Errorf("failed to get application: %w", err)
+				}
+				if current.Status != job_application_models.ApplicationStatusOffered || current.OfferPending(now) {
+					return nil
+				}
+				if err := job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, current, job_application_models.ApplicationStatusExpired, job_application_models.ApplicationActorSystem, nil, &reason); err != nil {
+					return err
+				}
+				transitioned = true
+				return nil
+			})
+			if err != nil {
+				return expired, fmt.Errorf("failed to expire offer: %w", err)
+			}
+			if transitioned {
+				expired++
+			}
+		}
+
+		if len(applications) < expiredOffersBatch {
+			break
+		}
+	}
+
+	if expired > 0 {
+		log.Printf("⌛ Expired %d offers past their deadline", expired)
+	}
+	return expired, nil
+}
+
+// sendOffer moves an application to OFFERED with the builder's terms. Callers check the job
+// has places left. The terms are checked before anything is stored, so a refused offer leaves
+// the unit of work untouched.
+func sendOffer(ctx context.Context, repos database.JobTxRepositories, job *models.Job, application *job_application_models.JobApplication, builderProfileID uuid.UUID, terms offerTerms, reason *string) error {
+	now := time.Now()
+
+	startDate := terms.StartDate
+	if startDate == nil {
+		startDate = job.StartDateWork
+	}
+	endDate := terms.EndDate
+	if endDate == nil {
+		endDate = job.EndDateWork
+	}
+	rate := terms.Rate
+	if rate == nil {
+		rate = job.WageHourlyRate
+	}
+	expiresAt := now.Add(defaultOfferValidity)
+	if terms.ExpiresAt != nil {
+		expiresAt = *terms.ExpiresAt
+	}
+
+	if rate == nil {
+		return fmt.Errorf("%w: a rate is required when the job has no hourly rate", ErrInvalidOffer)
+	}
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		return fmt.Errorf("%w: end date is before start date", ErrInvalidOffer)
+	}
+	if !expiresAt.After(now) {
+		return fmt.Errorf("%w: deadline must be in the future", ErrInvalidOffer)
+	}
+	if expiresAt.Sub(now) > maxOfferValidity {
+		return fmt.Errorf("%w: deadline must be within %d days", ErrInvalidOffer, int(maxOfferValidity.Hours()/24))
+	}
+
+	if err := job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusOffered, job_application_models.ApplicationActorBuilder, &builderProfileID, reason); err != nil {
+		return err
+	}
+
+	application.OfferStartDate = startDate
+	application.OfferEndDate = endDate
+	application.OfferRate = rate
+	application.OfferedAt = &now
+	application.OfferExpiresAt = &expiresAt
+	if err := repos.Applications.SaveOffer(ctx, application); err != nil {
+		return fmt.Errorf("failed to save offer: %w", err)
+	}
+	return nil
+}
+
+// startAssignment creates the active assignment of an accepted offer. Callers check the job
+// has places left and sync its capacity afterwards.
+func startAssignment(ctx context.Context, repos database.JobTxRepositories, application *job_application_models.JobApplication) (*job_assignment_models.JobAssignment, error) {
+	now := time.Now()
+	assignment := &job_assignment_models.JobAssignment{
+		JobID:         application.JobID,
+		LabourUserID:  application.LabourUserID,
+		ApplicationID: application.ID,
+		StartDate:     application.OfferStartDate,
+		EndDate:       application.OfferEndDate,
+		Status:        job_assignment_models.AssignmentStatusActive,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := repos.Assignments.Create(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("failed to create job assignment: %w", err)
+	}
+	return assignment, nil
+}
+
+// applicationOfferInfo converts the offer on an application to its response, nil when none was made
+func applicationOfferInfo(application *job_application_models.JobApplication) *payload.ApplicationOfferInfo {
+	if application.OfferedAt == nil || application.OfferExpiresAt == nil {
+		return nil
+	}
+	return &payload.ApplicationOfferInfo{
+		StartDate: application.OfferStartDate,
+		EndDate:   application.OfferEndDate,
+		Rate:      application.OfferRate,
+		OfferedAt: *application.OfferedAt,
+		ExpiresAt: *application.OfferExpiresAt,
+	}
+}
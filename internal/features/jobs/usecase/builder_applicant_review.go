@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	job_application_db "github.com/yakka-backend/internal/features/job_applications/entity/database"
+	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	job_application_usecase "github.com/yakka-backend/internal/features/job_applications/usecase"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+)
+
+// ShortlistApplicant moves an application of one of the builder's jobs to REVIEWED
+func (u *jobUsecase) ShortlistApplicant(ctx context.Context, builderProfileID uuid.UUID, applicationID uuid.UUID, req payload.ShortlistApplicantRequest) (*payload.ShortlistApplicantResponse, error) {
+	application, err := u.getBuilderApplication(ctx, builderProfileID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		return job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusReviewed, job_application_models.ApplicationActorBuilder, &builderProfileID, req.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &payload.ShortlistApplicantResponse{
+		ApplicationID: application.ID.String(),
+		Status:        string(application.Status),
+		Message:       "Applicant shortlisted successfully",
+	}, nil
+}
+
+// ReviewApplicant stores the builder's private notes, rating and tags on an application of one
+// of their jobs, replacing the previous review
+func (u *jobUsecase) ReviewApplicant(ctx context.Context, builderProfileID uuid.UUID, applicationID uuid.UUID, req payload.ApplicantReviewRequest) (*payload.ApplicantReviewResponse, error) {
+	application, err := u.getBuilderApplication(ctx, builderProfileID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	review := &job_application_models.ApplicationReview{
+		ApplicationID:    application.ID,
+		BuilderProfileID: builderProfileID,
+		Notes:            req.Notes,
+		Rating:           req.Rating,
+	}
+	if review.Notes != nil && strings.TrimSpace(*review.Notes) == "" {
+		review.Notes = nil
+	}
+	for _, tag := range normalizeReviewTags(req.Tags) {
+		review.Tags = append(review.Tags, job_application_models.ApplicationReviewTag{Tag: tag})
+	}
+
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		if err := repos.ApplicationReviews.Save(ctx, review); err != nil {
+			return fmt.Errorf("failed to save applicant review: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &payload.ApplicantReviewResponse{
+		ApplicationID: application.ID.String(),
+		Review:        *applicantReviewInfo(review),
+		Message:       "Applicant review saved successfully",
+	}, nil
+}
+
+// getBuilderApplication retrieves an application to one of the builder's jobs; applications
+// to other builders' jobs are reported as not found
+func (u *jobUsecase) getBuilderApplication(ctx context.Context, builderProfileID uuid.UUID, applicationID uuid.UUID) (*job_application_models.JobApplication, error) {
+	application, err := u.jobApplicationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		return nil, fmt.Errorf("application not found")
+	}
+	job, err := u.jobRepo.GetByID(ctx, application.JobID)
+	if err != nil || job.BuilderProfileID != builderProfileID {
+		return nil, fmt.Errorf("application not found")
+	}
+	return application, nil
+}
+
+// normalizeReviewTags lower-cases and trims tags, dropping empty and repeated ones
+func normalizeReviewTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// applicantReviewInfo converts a review to its response, nil when there is none
+func applicantReviewInfo(review *job_application_models.ApplicationReview) *payload.ApplicantReviewInfo {
+	if review == nil {
+		return nil
+	}
+	return &payload.ApplicantReviewInfo{
+		Notes:     review.Notes,
+		Rating:    review.Rating,
+		Tags:      review.TagNames(),
+		UpdatedAt: review.UpdatedAt,
+	}
+}
+
+// applicantFilterActive reports whether the filter narrows the applicants at all
+func applicantFilterActive(filter payload.BuilderApplicantFilter) bool {
+	return filter.Status != nil || filter.MinRating != nil || len(filter.Tags) > 0 || filter.Notes != nil
+}
+
+// applicantFilter converts the builder's applicant filter to a repository filter
+func applicantFilter(filter payload.BuilderApplicantFilter) job_application_db.ApplicantFilter {
+	converted := job_application_db.ApplicantFilter{
+		MinRating: filter.MinRating,
+		Tags:      normalizeReviewTags(filter.Tags),
+		Notes:     filter.Notes,
+	}
+	if filter.Status != nil {
+		status := job_application_models.ApplicationStatus(*filter.Status)
+		converted.Status = &status
+	}
+	return converted
+}
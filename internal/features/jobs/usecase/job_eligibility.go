@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+)
+
+// EligibilityError is returned by ApplyToJob when the labourer does not meet what the job requires
+type EligibilityError struct {
+	JobID   uuid.UUID
+	Missing []payload.JobMatchItem
+}
+
+// Error implements the error interface
+func (e *EligibilityError) Error() string {
+	return "labourer is not eligible for this job"
+}
+
+// Response describes the missing licenses and requirements for the client
+func (e *EligibilityError) Response() payload.ApplicationEligibilityResponse {
+	return payload.ApplicationEligibilityResponse{
+		JobID:   e.JobID.String(),
+		Missing: e.Missing,
+	}
+}
+
+// checkEligibility checks a labourer against the hard-required licenses and the active
+// requirements of a job, as configured by the eligibility policy. The job must be loaded
+// with its relations. It returns an *EligibilityError listing everything that is missing.
+func (u *jobUsecase) checkEligibility(ctx context.Context, job *models.Job, labourUserID uuid.UUID, confirmedRequirementIDs []string, now time.Time) error {
+	if !u.eligibility.RequireLicenses && !u.eligibility.RequireRequirements {
+		return nil
+	}
+
+	loader := &jobListingLoader{}
+	if err := u.loadJobMatching(ctx, loader, []*models.Job{job}, []uuid.UUID{labourUserID}); err != nil {
+		return err
+	}
+	profile := loader.matchProfiles[labourUserID]
+
+	confirmed := make(map[uuid.UUID]bool, len(confirmedRequirementIDs))
+	if u.eligibility.AllowRequirementConfirmation {
+		for _, id := range confirmedRequirementIDs {
+			if requirementID, err := uuid.Parse(id); err == nil {
+				confirmed[requirementID] = true
+			}
+		}
+	}
+
+	missing := []payload.JobMatchItem{}
+
+	if u.eligibility.RequireLicenses {
+		for _, jobLicense := range job.JobLicenses {
+			if !jobLicense.Required {
+				continue
+			}
+			valid, detail := matchJobLicense(jobLicense.LicenseID, profile.licenses, now)
+			if valid {
+				continue
+			}
+			item := payload.JobMatchItem{Type: payload.JobMatchItemLicense, ID: jobLicense.LicenseID.String(), Detail: detail}
+			if license, ok := loader.licenses[jobLicense.LicenseID]; ok {
+				item.Name = license.Name
+			}
+			if item.Detail == nil {
+				notHeld := "not held"
+				item.Detail = &notHeld
+			}
+			missing = append(missing, item)
+		}
+	}
+
+	if u.eligibility.RequireRequirements {
+		for _, jobRequirement := range job.JobRequirements {
+			requirement, ok := loader.requirements[jobRequirement.JobRequirementID]
+			// Requirements retired from the catalogue are no longer enforced
+			if !ok || !requirement.IsActive {
+				continue
+			}
+			if confirmed[requirement.ID] || hasQualification(requirement.Name, profile.qualifications, now) {
+				continue
+			}
+			detail := "no valid qualification"
+			if u.eligibility.AllowRequirementConfirmation {
+				detail = "no valid qualification and not confirmed"
+			}
+			missing = append(missing, payload.JobMatchItem{
+				Type:   payload.JobMatchItemRequirement,
+				ID:     requirement.ID.String(),
+				Name:   requirement.Name,
+				Detail: &detail,
+			})
+		}
+	}
+
+	if len(missing) > 0 {
+		return &EligibilityError{JobID: job.ID, Missing: missing}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	auth_user_db "github.com/yakka-backend/internal/features/auth/user/entity/database"
+	auth_user_models "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+	labour_db "github.com/yakka-backend/internal/features/labour_profiles/entity/database"
+	labour_models "github.com/yakka-backend/internal/features/labour_profiles/models"
+	job_requirement_db "github.com/yakka-backend/internal/features/masters/job_requirements/entity/database"
+	job_requirement_models "github.com/yakka-backend/internal/features/masters/job_requirements/models"
+	license_db "github.com/yakka-backend/internal/features/masters/licenses/entity/database"
+	license_models "github.com/yakka-backend/internal/features/masters/licenses/models"
+	qualification_db "github.com/yakka-backend/internal/features/qualifications/entity/database"
+	qualification_models "github.com/yakka-backend/internal/features/qualifications/models"
+	"github.com/yakka-backend/internal/infrastructure/config"
+)
+
+// licenseCatalogue serves licenses from memory
+type licenseCatalogue struct {
+	license_db.LicenseRepository
+	licenses []*license_models.License
+}
+
+func (r licenseCatalogue) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*license_models.License, error) {
+	return r.licenses, nil
+}
+
+// requirementCatalogue serves job requirements from memory
+type requirementCatalogue struct {
+	job_requirement_db.JobRequirementRepository
+	requirements []*job_requirement_models.JobRequirement
+}
+
+func (r requirementCatalogue) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*job_requirement_models.JobRequirement, error) {
+	return r.requirements, nil
+}
+
+// labourProfiles serves one labour profile
+type labourProfiles struct {
+	labour_db.LabourProfileRepository
+	profile *labour_models.LabourProfile
+}
+
+func (r labourProfiles) GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*labour_models.LabourProfile, error) {
+	return []*labour_models.LabourProfile{r.profile}, nil
+}
+
+// noLabourSkills reports a labourer without skills
+type noLabourSkills struct {
+	labour_db.LabourProfileSkillRepository
+}
+
+func (noLabourSkills) GetByLabourProfileIDs(ctx context.Context, labourProfileIDs []uuid.UUID) ([]*labour_models.LabourProfileSkill, error) {
+	return nil, nil
+}
+
+// userLicenses serves the licenses a labourer holds
+type userLicenses struct {
+	auth_user_db.UserLicenseRepository
+	licenses []*auth_user_models.UserLicense
+}
+
+func (r userLicenses) GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*auth_user_models.UserLicense, error) {
+	return r.licenses, nil
+}
+
+// labourQualifications serves the qualifications a labourer holds
+type labourQualifications struct {
+	qualification_db.LabourProfileQualificationRepository
+	qualifications []*qualification_models.LabourProfileQualification
+}
+
+func (r labourQualifications) GetByLabourProfileIDs(ctx context.Context, labourProfileIDs []uuid.UUID) ([]*qualification_models.LabourProfileQualification, error) {
+	return r.qualifications, nil
+}
+
+func TestCheckEligibility(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+	expiredOn := time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	labourUserID := uuid.New()
+	profile := &labour_models.LabourProfile{ID: uuid.New(), UserID: labourUserID}
+
+	whiteCard := &license_models.License{ID: uuid.New(), Name: "White Card"}
+	forklift := &license_models.License{ID: uuid.New(), Name: "Forklift"}
+	excavator := &license_models.License{ID: uuid.New(), Name: "Excavator"}
+
+	ppe := &job_requirement_models.JobRequirement{ID: uuid.New(), Name: "Own PPE", IsActive: true}
+	firstAid := &job_requirement_models.JobRequirement{ID: uuid.New(), Name: "First Aid", IsActive: true}
+	retired := &job_requirement_models.JobRequirement{ID: uuid.New(), Name: "Retired Ticket", IsActive: false}
+
+	newUsecase := func(eligibility config.EligibilityConfig) *jobUsecase {
+		return &jobUsecase{
+			licenseRepo:        licenseCatalogue{licenses: []*license_models.License{whiteCard, forklift, excavator}},
+			jobRequirementRepo: requirementCatalogue{requirements: []*job_requirement_models.JobRequirement{ppe, firstAid, retired}},
+			labourProfileRepo:  labourProfiles{profile: profile},
+			labourSkillRepo:    noLabourSkills{},
+			userLicenseRepo: userLicenses{licenses: []*auth_user_models.UserLicense{
+				{UserID: labourUserID, LicenseID: whiteCard.ID, ExpiresAt: &validUntil},
+				{UserID: labourUserID, LicenseID: forklift.ID, ExpiresAt: &expiredOn},
+			}},
+			labourQualificationRepo: labourQualifications{qualifications: []*qualification_models.LabourProfileQualification{
+				{LabourProfileID: profile.ID, Status: "valid", Qualification: &qualification_models.Qualification{Title: "own ppe"}},
+			}},
+			eligibility: eligibility,
+		}
+	}
+
+	license := func(l *license_models.License, required bool) models.JobLicense {
+		return models.JobLicense{LicenseID: l.ID, Required: required}
+	}
+	requirement := func(r *job_requirement_models.JobRequirement) models.JobJobRequirement {
+		return models.JobJobRequirement{JobRequirementID: r.ID}
+	}
+	missing := func(itemType, id, name, detail string) payload.JobMatchItem {
+		return payload.JobMatchItem{Type: itemType, ID: id, Name: name, Detail: &detail}
+	}
+
+	enforced := config.EligibilityConfig{RequireLicenses: true, RequireRequirements: true}
+	confirmable := config.EligibilityConfig{RequireLicenses: true, RequireRequirements: true, AllowRequirementConfirmation: true}
+
+	tests := []struct {
+		name         string
+		eligibility  config.EligibilityConfig
+		licenses     []models.JobLicense
+		requirements []models.JobJobRequirement
+		confirmed    []string
+		wantMissing  []payload.JobMatchItem // nil when the labourer is eligible
+	}{
+		{
+			name:        "valid license",
+			eligibility: enforced,
+			licenses:    []models.JobLicense{license(whiteCard, true)},
+		},
+		{
+			name:        "expired license",
+			eligibility: enforced,
+			licenses:    []models.JobLicense{license(forklift, true)},
+			wantMissing: []payload.JobMatchItem{
+				missing(payload.JobMatchItemLicense, forklift.ID.String(), "Forklift", "expired on 2026-01-31"),
+			},
+		},
+		{
+			name:        "preferred license ignored",
+			eligibility: enforced,
+			licenses:    []models.JobLicense{license(forklift, false), license(excavator, false)},
+		},
+		{
+			name:        "licenses not enforced",
+			eligibility: config.EligibilityConfig{RequireRequirements: true},
+			licenses:    []models.JobLicense{license(excavator, true)},
+		},
+		{
+			name:         "requirement met by a qualification",
+			eligibility:  enforced,
+			requirements: []models.JobJobRequirement{requirement(ppe)},
+		},
+		{
+			name:         "inactive requirement skipped",
+			eligibility:  enforced,
+			requirements: []models.JobJobRequirement{requirement(retired)},
+		},
+		{
+			name:         "confirmation ignored when not allowed",
+			eligibility:  enforced,
+			requirements: []models.JobJobRequirement{requirement(firstAid)},
+			confirmed:    []string{firstAid.ID.String()},
+			wantMissing: []payload.JobMatchItem{
+				missing(payload.JobMatchItemRequirement, firstAid.ID.String(), "First Aid", "no valid qualification"),
+			},
+		},
+		{
+			name:         "confirmed requirement",
+			eligibility:  confirmable,
+			requirements: []models.JobJobRequirement{requirement(firstAid)},
+			confirmed:    []string{"not-a-uuid", firstAid.ID.String()},
+		},
+		{
+			name:         "unconfirmed requirement",
+			eligibility:  confirmable,
+			requirements: []models.JobJobRequirement{requirement(firstAid)},
+			confirmed:    []string{ppe.ID.String()},
+			wantMissing: []payload.JobMatchItem{
+				missing(payload.JobMatchItemRequirement, firstAid.ID.String(), "First Aid", "no valid qualification and not confirmed"),
+			},
+		},
+		{
+			name:         "everything missing listed",
+			eligibility:  enforced,
+			licenses:     []models.JobLicense{license(whiteCard, true), license(forklift, true), license(excavator, true)},
+			requirements: []models.JobJobRequirement{requirement(ppe), requirement(firstAid), requirement(retired)},
+			wantMissing: []payload.JobMatchItem{
+				missing(payload.JobMatchItemLicense, forklift.ID.String(), "Forklift", "expired on 2026-01-31"),
+				missing(payload.JobMatchItemLicense, excavator.ID.String(), "Excavator", "not held"),
+				missing(payload.JobMatchItemRequirement, firstAid.ID.String(), "First Aid", "no valid qualification"),
+			},
+		},
+		{
+			name:         "policy off",
+			licenses:     []models.JobLicense{license(excavator, true)},
+			requirements: []models.JobJobRequirement{requirement(firstAid)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &models.Job{ID: uuid.New(), JobLicenses: tt.licenses, JobRequirements: tt.requirements}
+
+			err := newUsecase(tt.eligibility).checkEligibility(context.Background(), job, labourUserID, tt.confirmed, now)
+			if tt.wantMissing == nil {
+				if err != nil {
+					t.Fatalf("checkEligibility() = %v, want eligible", err)
+				}
+				return
+			}
+
+			var eligibilityErr *EligibilityError
+			if !errors.As(err, &eligibilityErr) {
+				t.Fatalf("checkEligibility() = %v, want *EligibilityError", err)
+			}
+			if eligibilityErr.JobID != job.ID {
+				t.Errorf("JobID = %s, want %s", eligibilityErr.JobID, job.ID)
+			}
+			if !reflect.DeepEqual(eligibilityErr.Missing, tt.wantMissing) {
+				t.Errorf("Missing = %s, want %s", formatMatchItems(eligibilityErr.Missing), formatMatchItems(tt.wantMissing))
+			}
+		})
+	}
+}
+
+// formatMatchItems prints match items with their details dereferenced
+func formatMatchItems(items []payload.JobMatchItem) []string {
+	formatted := make([]string, 0, len(items))
+	for _, item := range items {
+		detail := ""
+		if item.Detail != nil {
+			detail = *item.Detail
+		}
+		formatted = append(formatted, item.Type+" "+item.Name+": "+detail)
+	}
+	return formatted
+}
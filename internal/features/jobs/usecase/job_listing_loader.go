@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	auth_user_models "github.com/yakka-backend/internal/features/auth/user/models"
+	builder_models "github.com/yakka-backend/internal/features/builder_profiles/models"
+	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+	jobsite_models "github.com/yakka-backend/internal/features/jobsites/models"
+	job_requirement_models "github.com/yakka-backend/internal/features/masters/job_requirements/models"
+	job_type_models "github.com/yakka-backend/internal/features/masters/job_types/models"
+	license_models "github.com/yakka-backend/internal/features/masters/licenses/models"
+	skill_models "github.com/yakka-backend/internal/features/masters/skills/models"
+)
+
+// maxApplicantsPerJob caps the applicants listed under each job
+const maxApplicantsPerJob = 100
+
+// jobListingLoader holds everything a page of jobs refers to, loaded with one query per
+// kind of record. Listings are assembled from it so their query count does not grow with
+// the number of jobs, skills or applicants shown.
+type jobListingLoader struct {
+	builders           map[uuid.UUID]*builder_models.BuilderProfile
+	jobsites           map[uuid.UUID]*jobsite_models.Jobsite
+	jobTypes           map[uuid.UUID]*job_type_models.JobType
+	skillCategories    map[uuid.UUID]*skill_models.SkillCategory
+	skillSubcategories map[uuid.UUID]*skill_models.SkillSubcategory
+	applications       map[uuid.UUID][]*job_application_models.JobApplication  // by job ID, newest first
+	timelines          map[uuid.UUID][]payload.ApplicationEventInfo            // by application ID, oldest first
+	reviews            map[uuid.UUID]*job_application_models.ApplicationReview // by application ID, builder listings only
+	users              map[uuid.UUID]*auth_user_models.User
+	payRates           payRates
+
+	// Loaded by loadJobMatching
+	licenses      map[uuid.UUID]*license_models.License
+	requirements  map[uuid.UUID]*job_requirement_models.JobRequirement
+	matchProfiles map[uuid.UUID]*labourMatchProfile // by labour user ID
+}
+
+// loadLabourJobListing loads the builders, jobsites, job types, skills and the labour
+// user's own applications for a page of jobs: six queries whatever the page size
+func (u *jobUsecase) loadLabourJobListing(ctx context.Context, jobs []*models.Job, labourUserID uuid.UUID) (*jobListingLoader, error) {
+	loader := &jobListingLoader{}
+
+	var builderIDs, jobsiteIDs, jobTypeIDs []uuid.UUID
+	for _, job := range jobs {
+		builderIDs = append(builderIDs, job.BuilderProfileID)
+		jobsiteIDs = append(jobsiteIDs, job.JobsiteID)
+		jobTypeIDs = append(jobTypeIDs, job.JobTypeID)
+	}
+
+	builders, err := u.builderRepo.GetByIDs(ctx, uniqueIDs(builderIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get builder profiles: %w", err)
+	}
+	loader.builders = indexByID(builders, func(b *builder_models.BuilderProfile) uuid.UUID { return b.ID })
+
+	if err := u.loadJobsitesAndTypes(ctx, loader, jobsiteIDs, jobTypeIDs); err != nil {
+		return nil, err
+	}
+
+	if err := u.loadSkills(ctx, loader, jobs); err != nil {
+		return nil, err
+	}
+
+	applications, err := u.jobApplicationRepo.GetByLabourUserAndJobIDs(ctx, labourUserID, jobIDs(jobs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applications: %w", err)
+	}
+	loader.applications = groupApplicationsByJob(applications)
+
+	if loader.payRates, err = u.loadPayRates(ctx); err != nil {
+		return nil, err
+	}
+
+	return loader, nil
+}
+
+// loadBuilderApplicantListing loads the job types, jobsites, skills, the applications that pass
+// the filter with their applicant users, status histories and the builder's reviews for a
+// builder's jobs, then everything needed to match the applicants with the jobs: fifteen
+// queries whatever the number of jobs and applicants
+func (u *jobUsecase) loadBuilderApplicantListing(ctx context.Context, jobs []*models.Job, filter payload.BuilderApplicantFilter) (*jobListingLoader, error) {
+	loader := &jobListingLoader{}
+
+	var jobsiteIDs, jobTypeIDs []uuid.UUID
+	for _, job := range jobs {
+		jobsiteIDs = append(jobsiteIDs, job.JobsiteID)
+		jobTypeIDs = append(jobTypeIDs, job.JobTypeID)
+	}
+	if err := u.loadJobsitesAndTypes(ctx, loader, jobsiteIDs, jobTypeIDs); err != nil {
+		return nil, err
+	}
+	if err := u.loadSkills(ctx, loader, jobs); err != nil {
+		return nil, err
+	}
+
+	applications, err := u.jobApplicationRepo.GetByJobIDsFiltered(ctx, jobIDs(jobs), applicantFilter(filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applications: %w", err)
+	}
+	loader.applications = groupApplicationsByJob(applications)
+
+	userIDs := make([]uuid.UUID, 0, len(applications))
+	for _, application := range applications {
+		userIDs = append(userIDs, application.LabourUserID)
+	}
+	users, err := u.userRepo.GetByIDs(ctx, uniqueIDs(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applicant users: %w", err)
+	}
+	loader.users = indexByID(users, func(user *auth_user_models.User) uuid.UUID { return user.ID })
+
+	if loader.timelines, err = u.loadApplicationTimelines(ctx, applications); err != nil {
+		return nil, err
+	}
+
+	reviews, err := u.applicationReviewRepo.GetByApplicationIDs(ctx, applicationIDs(applications))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applicant reviews: %w", err)
+	}
+	loader.reviews = indexByID(reviews, func(r *job_application_models.ApplicationReview) uuid.UUID { return r.ApplicationID })
+
+	if err := u.loadJobMatching(ctx, loader, jobs, userIDs); err != nil {
+		return nil, err
+	}
+
+	return loader, nil
+}
+
+// loadJobsitesAndTypes loads the jobsites and job types shared by both listings
+func (u *jobUsecase) loadJobsitesAndTypes(ctx context.Context, loader *jobListingLoader, jobsiteIDs, jobTypeIDs []uuid.UUID) error {
+	jobsites, err := u.jobsiteRepo.GetByIDs(ctx, uniqueIDs(jobsiteIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get jobsites: %w", err)
+	}
+	loader.jobsites = indexByID(jobsites, func(j *jobsite_models.Jobsite) uuid.UUID { return j.ID })
+
+	jobTypes, err := u.jobTypeRepo.GetByIDs(ctx, uniqueIDs(jobTypeIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get job types: %w", err)
+	}
+	loader.jobTypes = indexByID(jobTypes, func(j *job_type_models.JobType) uuid.UUID { return j.ID })
+
+	return nil
+}
+
+// loadSkills loads the skill categories and subcategories the jobs ask for
+func (u *jobUsecase) loadSkills(ctx context.Context, loader *jobListingLoader, jobs []*models.Job) error {
+	var categoryIDs, subcategoryIDs []uuid.UUID
+	for _, job := range jobs {
+		for _, jobSkill := range job.JobSkills {
+			if jobSkill.SkillCategoryID != nil {
+				categoryIDs = append(categoryIDs, *jobSkill.SkillCategoryID)
+			}
+			if jobSkill.SkillSubcategoryID != nil {
+				subcategoryIDs = append(subcategoryIDs, *jobSkill.SkillSubcategoryID)
+			}
+		}
+	}
+
+	categories, err := u.skillCategoryRepo.GetByIDs(ctx, uniqueIDs(categoryIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get skill categories: %w", err)
+	}
+	loader.skillCategories = indexByID(categories, func(c *skill_models.SkillCategory) uuid.UUID { return c.ID })
+
+	subcategories, err := u.skillSubcategoryRepo.GetByIDs(ctx, uniqueIDs(subcategoryIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get skill subcategories: %w", err)
+	}
+	loader.skillSubcategories = indexByID(subcategories, func(s *skill_models.SkillSubcategory) uuid.UUID { return s.ID })
+
+	return nil
+}
+
+// jobApplicants builds the applicant list of a job, best match first
+func (l *jobListingLoader) jobApplicants(u *jobUsecase, job *models.Job, now time.Time) []payload.JobApplicantInfo {
+	applications := l.applications[job.ID]
+
+	applicants := make([]payload.JobApplicantInfo, 0, len(applications)) // Empty slice, not nil
+	for _, app := range applications {
+		review := l.reviews[app.ID]
+
+		var labourInfo payload.LabourApplicantInfo
+		if labourUser, ok := l.users[app.LabourUserID]; ok {
+			labourInfo = u.buildLabourApplicantInfoFromUser(labourUser)
+		} else {
+			log.Printf("Error getting labour user %s: not found", app.LabourUserID)
+			// Create a fallback labour info if user not found
+			labourInfo = payload.LabourApplicantInfo{
+				UserID:    app.LabourUserID.String(),
+				FullName:  "Usuario Labour",
+				AvatarURL: nil,
+				Phone:     nil,
+				Email:     "usuario@ejemplo.com",
+			}
+		}
+
+		applicants = append(applicants, payload.JobApplicantInfo{
+			ApplicationID: app.ID.String(),
+			Status:        string(app.Status),
+			CoverLetter:   app.CoverLetter,
+			ExpectedRate:  app.ExpectedRate,
+			ResumeURL:     app.ResumeURL,
+			AppliedAt:     app.CreatedAt,
+			JobChangedAt:  app.JobChangedAt,
+			Offer:         applicationOfferInfo(app),
+			Timeline:      l.timelines[app.ID],
+			Labour:        labourInfo,
+			Match:         scoreJobMatch(job, l.matchProfiles[app.LabourUserID], l, now),
+			Review:        applicantReviewInfo(review),
+		})
+	}
+
+	rankApplicants(applicants)
+	if len(applicants) > maxApplicantsPerJob {
+		applicants = applicants[:maxApplicantsPerJob]
+	}
+	return applicants
+}
+
+// jobIDs returns the IDs of a set of jobs
+func jobIDs(jobs []*models.Job) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+	return ids
+}
+
+// applicationIDs returns the IDs of a set of applications
+func applicationIDs(applications []*job_application_models.JobApplication) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(applications))
+	for _, application := range applications {
+		ids = append(ids, application.ID)
+	}
+	return ids
+}
+
+// groupApplicationsByJob groups applications by job ID, keeping their order
+func groupApplicationsByJob(applications []*job_application_models.JobApplication) map[uuid.UUID][]*job_application_models.JobApplication {
+	grouped := make(map[uuid.UUID][]*job_application_models.JobApplication)
+	for _, application := range applications {
+		grouped[application.JobID] = append(grouped[application.JobID], application)
+	}
+	return grouped
+}
+
+// loadApplicationTimelines loads the status histories of a set of applications in one query,
+// keyed by application ID
+func (u *jobUsecase) loadApplicationTimelines(ctx context.Context, applications []*job_application_models.JobApplication) (map[uuid.UUID][]payload.ApplicationEventInfo, error) {
+	timelines := make(map[uuid.UUID][]payload.ApplicationEventInfo, len(applications))
+	applicationIDs := make([]uuid.UUID, 0, len(applications))
+	for _, application := range applications {
+		timelines[application.ID] = []payload.ApplicationEventInfo{} // Empty slice, not nil
+		applicationIDs = append(applicationIDs, application.ID)
+	}
+
+	events, err := u.applicationEventRepo.GetByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application timelines: %w", err)
+	}
+	for _, event := range events {
+		var fromStatus *string
+		if event.FromStatus != nil {
+			status := string(*event.FromStatus)
+			fromStatus = &status
+		}
+		timelines[event.ApplicationID] = append(timelines[event.ApplicationID], payload.ApplicationEventInfo{
+			FromStatus: fromStatus,
+			ToStatus:   string(event.ToStatus),
+			Actor:      string(event.Actor),
+			Reason:     event.Reason,
+			CreatedAt:  event.CreatedAt,
+		})
+	}
+	return timelines, nil
+}
+
+// uniqueIDs removes duplicated IDs, keeping the first occurrence
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// indexByID maps records by their ID
+func indexByID[T any](records []T, id func(T) uuid.UUID) map[uuid.UUID]T {
+	index := make(map[uuid.UUID]T, len(records))
+	for _, record := range records {
+		index[id(record)] = record
+	}
+	return index
+}
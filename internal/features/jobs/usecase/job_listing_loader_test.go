@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	auth_user_db "github.com/yakka-backend/internal/features/auth/user/entity/database"
+	builder_db "github.com/yakka-backend/internal/features/builder_profiles/entity/database"
+	job_application_db "github.com/yakka-backend/internal/features/job_applications/entity/database"
+	job_assignment_db "github.com/yakka-backend/internal/features/job_assignments/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/jobstest"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+	jobsite_db "github.com/yakka-backend/internal/features/jobsites/entity/database"
+	labour_db "github.com/yakka-backend/internal/features/labour_profiles/entity/database"
+	job_requirement_db "github.com/yakka-backend/internal/features/masters/job_requirements/entity/database"
+	job_type_db "github.com/yakka-backend/internal/features/masters/job_types/entity/database"
+	license_db "github.com/yakka-backend/internal/features/masters/licenses/entity/database"
+	payment_constant_db "github.com/yakka-backend/internal/features/masters/payment_constants/entity/database"
+	skill_db "github.com/yakka-backend/internal/features/masters/skills/entity/database"
+	qualification_db "github.com/yakka-backend/internal/features/qualifications/entity/database"
+	"github.com/yakka-backend/internal/infrastructure/config"
+	"github.com/yakka-backend/internal/infrastructure/database/dbtest"
+	"gorm.io/gorm"
+)
+
+// listingSizes are the seeded data sizes the listing benchmarks run with; the query count
+// reported for each must stay the same
+var listingSizes = []jobstest.Options{
+	{Builders: 5, JobsitesPerBuilder: 2, JobsPerBuilder: 4, ApplicantsPerJob: 3, SpacingKm: 0.5},
+	{Builders: 20, JobsitesPerBuilder: 5, JobsPerBuilder: 25, ApplicantsPerJob: 10, SpacingKm: 0.5},
+}
+
+// newListingUsecase wires a job usecase to db the way main does
+func newListingUsecase(db *gorm.DB) JobUsecase {
+	return NewJobUsecase(
+		database.NewJobRepository(db),
+		database.NewJobLicenseRepository(db),
+		database.NewJobSkillRepository(db),
+		database.NewJobJobRequirementRepository(db),
+		database.NewJobRevisionRepository(db),
+		job_requirement_db.NewJobRequirementRepository(db),
+		builder_db.NewBuilderProfileRepository(db),
+		jobsite_db.NewJobsiteRepositoryImpl(db),
+		job_type_db.NewJobTypeRepository(db),
+		job_application_db.NewJobApplicationRepository(db),
+		job_application_db.NewApplicationStatusEventRepository(db),
+		job_application_db.NewApplicationReviewRepository(db),
+		job_assignment_db.NewJobAssignmentRepository(db),
+		license_db.NewLicenseRepository(db),
+		skill_db.NewSkillCategoryRepository(db),
+		skill_db.NewSkillSubcategoryRepository(db),
+		auth_user_db.NewUserRepository(db),
+		auth_user_db.NewUserLicenseRepository(db),
+		labour_db.NewLabourProfileRepository(db),
+		labour_db.NewLabourProfileSkillRepository(db),
+		qualification_db.NewLabourProfileQualificationRepository(db),
+		payment_constant_db.NewPaymentConstantRepository(db),
+		config.EligibilityConfig{},
+		database.NewJobUnitOfWork(db),
+	)
+}
+
+// benchmarkListing seeds a database for each size and runs list against it, reporting the
+// statements each call runs
+func benchmarkListing(b *testing.B, list func(ctx context.Context, u JobUsecase, seed *jobstest.Seed) error) {
+	for _, opts := range listingSizes {
+		jobs := opts.Builders * opts.JobsPerBuilder
+		b.Run(fmt.Sprintf("jobs=%d/applicants=%d", jobs, jobs*opts.ApplicantsPerJob), func(b *testing.B) {
+			db := dbtest.Open(b)
+			seed := jobstest.Create(b, db, opts)
+			counted, queries := dbtest.CountQueries(db)
+			u := newListingUsecase(counted)
+			ctx := context.Background()
+
+			queries.Reset()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := list(ctx, u, seed); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(queries.Count())/float64(b.N), "queries/op")
+		})
+	}
+}
+
+func BenchmarkGetLabourJobs(b *testing.B) {
+	benchmarkListing(b, func(ctx context.Context, u JobUsecase, seed *jobstest.Seed) error {
+		// A full page, so the page size and not the seeded data bounds the listing
+		_, err := u.GetLabourJobs(ctx, seed.LabourUserIDs[0], payload.LabourJobSearchRequest{Limit: 20})
+		return err
+	})
+}
+
+func BenchmarkGetBuilderApplicants(b *testing.B) {
+	benchmarkListing(b, func(ctx context.Context, u JobUsecase, seed *jobstest.Seed) error {
+		// Every builder has the same jobs and applicants, so any of them will do
+		_, err := u.GetBuilderApplicants(ctx, seed.BuilderProfileIDs[0], payload.BuilderApplicantFilter{})
+		return err
+	})
+}
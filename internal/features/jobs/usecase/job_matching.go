@@ -0,0 +1,392 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	auth_user_models "github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+	labour_models "github.com/yakka-backend/internal/features/labour_profiles/models"
+	job_requirement_models "github.com/yakka-backend/internal/features/masters/job_requirements/models"
+	license_models "github.com/yakka-backend/internal/features/masters/licenses/models"
+	qualification_models "github.com/yakka-backend/internal/features/qualifications/models"
+)
+
+// Weights of each part of the match score. Parts a job does not ask for are left out and
+// the others scaled up, so a job without requirements is scored on skills and licenses alone.
+const (
+	matchWeightSkills       = 50.0
+	matchWeightLicenses     = 35.0
+	matchWeightRequirements = 15.0
+)
+
+// Recommendations rank the newest open public jobs, up to recommendationCandidates of them
+const (
+	recommendationCandidates    = 200
+	defaultRecommendedJobsLimit = 20
+	maxRecommendedJobsLimit     = 50
+)
+
+// Credit a labour skill earns for a job skill, out of 1
+const (
+	matchedSkillCredit   = 0.8 // same subcategory, or same category when the job names no subcategory
+	relatedSkillCredit   = 0.4 // same category, different subcategory
+	primarySkillBonus    = 0.1
+	experienceBonus      = 0.1 // reached at experienceBonusYears
+	experienceBonusYears = 5.0
+)
+
+// qualificationStatusValid is the status of qualifications that can meet a requirement
+const qualificationStatusValid = "valid"
+
+// labourMatchProfile is what a labourer brings to a job
+type labourMatchProfile struct {
+	skills         []*labour_models.LabourProfileSkill
+	licenses       []*auth_user_models.UserLicense
+	qualifications []*qualification_models.LabourProfileQualification
+}
+
+// GetRecommendedJobs ranks the newest open public jobs by how well they match the labourer's
+// skills, licenses and qualifications. Jobs that match nothing are left out.
+func (u *jobUsecase) GetRecommendedJobs(ctx context.Context, labourUserID uuid.UUID, limit int) (*payload.LabourJobsResponse, error) {
+	if limit <= 0 {
+		limit = defaultRecommendedJobsLimit
+	}
+	if limit > maxRecommendedJobsLimit {
+		limit = maxRecommendedJobsLimit
+	}
+
+	hits, err := u.jobRepo.SearchPublic(ctx, database.JobSearchFilter{
+		Sort:  database.JobSearchSortRecent,
+		Limit: recommendationCandidates,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search jobs: %w", err)
+	}
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	jobs, err := u.jobRepo.GetByIDsWithRelations(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	loader, err := u.loadLabourJobListing(ctx, jobs, labourUserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.loadJobMatching(ctx, loader, jobs, []uuid.UUID{labourUserID}); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	profile := loader.matchProfiles[labourUserID]
+	recommended := make([]payload.LabourJobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		match := scoreJobMatch(job, profile, loader, now)
+		if match.Score == 0 || len(match.Matched) == 0 {
+			continue
+		}
+		labourJob, err := u.buildLabourJobInfo(job, loader)
+		if err != nil {
+			continue // Skip jobs with invalid builder profiles, jobsites or job types
+		}
+		labourJob.Match = match
+		recommended = append(recommended, *labourJob)
+	}
+
+	// Best match first, newest first among equal scores
+	sort.SliceStable(recommended, func(i, j int) bool {
+		if recommended[i].Match.Score != recommended[j].Match.Score {
+			return recommended[i].Match.Score > recommended[j].Match.Score
+		}
+		return recommended[i].CreatedAt.After(recommended[j].CreatedAt)
+	})
+	if len(recommended) > limit {
+		recommended = recommended[:limit]
+	}
+
+	return &payload.LabourJobsResponse{
+		Jobs:    recommended,
+		Total:   len(recommended),
+		Message: "Recommended jobs retrieved successfully",
+	}, nil
+}
+
+// loadJobMatching loads the license and requirement names of the jobs and the match
+// profiles of the labour users: six queries whatever the number of jobs and users
+func (u *jobUsecase) loadJobMatching(ctx context.Context, loader *jobListingLoader, jobs []*models.Job, labourUserIDs []uuid.UUID) error {
+	var licenseIDs, requirementIDs []uuid.UUID
+	for _, job := range jobs {
+		for _, jobLicense := range job.JobLicenses {
+			licenseIDs = append(licenseIDs, jobLicense.LicenseID)
+		}
+		for _, jobRequirement := range job.JobRequirements {
+			requirementIDs = append(requirementIDs, jobRequirement.JobRequirementID)
+		}
+	}
+
+	licenses, err := u.licenseRepo.GetByIDs(ctx, uniqueIDs(licenseIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get licenses: %w", err)
+	}
+	loader.licenses = indexByID(licenses, func(l *license_models.License) uuid.UUID { return l.ID })
+
+	requirements, err := u.jobRequirementRepo.GetByIDs(ctx, uniqueIDs(requirementIDs))
+	if err != nil {
+		return fmt.Errorf("failed to get job requirements: %w", err)
+	}
+	loader.requirements = indexByID(requirements, func(r *job_requirement_models.JobRequirement) uuid.UUID { return r.ID })
+
+	labourUserIDs = uniqueIDs(labourUserIDs)
+	loader.matchProfiles = make(map[uuid.UUID]*labourMatchProfile, len(labourUserIDs))
+	for _, userID := range labourUserIDs {
+		loader.matchProfiles[userID] = &labourMatchProfile{}
+	}
+
+	profiles, err := u.labourProfileRepo.GetByUserIDs(ctx, labourUserIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get labour profiles: %w", err)
+	}
+	userByProfile := make(map[uuid.UUID]uuid.UUID, len(profiles))
+	profileIDs := make([]uuid.UUID, 0, len(profiles))
+	for _, profile := range profiles {
+		userByProfile[profile.ID] = profile.UserID
+		profileIDs = append(profileIDs, profile.ID)
+	}
+
+	skills, err := u.labourSkillRepo.GetByLabourProfileIDs(ctx, profileIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get labour skills: %w", err)
+	}
+	for _, skill := range skills {
+		profile := loader.matchProfiles[userByProfile[skill.LabourProfileID]]
+		profile.skills = append(profile.skills, skill)
+	}
+
+	userLicenses, err := u.userLicenseRepo.GetByUserIDs(ctx, labourUserIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get user licenses: %w", err)
+	}
+	for _, userLicense := range userLicenses {
+		profile := loader.matchProfiles[userLicense.UserID]
+		profile.licenses = append(profile.licenses, userLicense)
+	}
+
+	qualifications, err := u.labourQualificationRepo.GetByLabourProfileIDs(ctx, profileIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get labour qualifications: %w", err)
+	}
+	for _, qualification := range qualifications {
+		profile := loader.matchProfiles[userByProfile[qualification.LabourProfileID]]
+		profile.qualifications = append(profile.qualifications, qualification)
+	}
+
+	return nil
+}
+
+// scoreJobMatch scores a labourer against the skills, licenses and requirements of a job.
+// Skills earn full credit on the exact subcategory, with a bonus for experience and primary
+// skills, and partial credit on the same category. Licenses must not be expired. Requirements
+// are met by a valid qualification with the same title.
+func scoreJobMatch(job *models.Job, profile *labourMatchProfile, loader *jobListingLoader, now time.Time) *payload.JobMatchResponse {
+	if profile == nil {
+		profile = &labourMatchProfile{}
+	}
+	match := &payload.JobMatchResponse{
+		Matched: []payload.JobMatchItem{},
+		Missing: []payload.JobMatchItem{},
+	}
+
+	var score, weights float64
+
+	if len(job.JobSkills) > 0 {
+		var credit float64
+		for _, jobSkill := range job.JobSkills {
+			item := skillMatchItem(jobSkill, loader)
+			skillCredit, detail := matchJobSkill(jobSkill, profile.skills, loader)
+			item.Detail = detail
+			if skillCredit == 0 {
+				match.Missing = append(match.Missing, item)
+				continue
+			}
+			credit += skillCredit
+			match.Matched = append(match.Matched, item)
+		}
+		score += matchWeightSkills * credit / float64(len(job.JobSkills))
+		weights += matchWeightSkills
+	}
+
+	if len(job.JobLicenses) > 0 {
+		var matched int
+		for _, jobLicense := range job.JobLicenses {
+			item := payload.JobMatchItem{Type: payload.JobMatchItemLicense, ID: jobLicense.LicenseID.String()}
+			if license, ok := loader.licenses[jobLicense.LicenseID]; ok {
+				item.Name = license.Name
+			}
+			valid, detail := matchJobLicense(jobLicense.LicenseID, profile.licenses, now)
+			item.Detail = detail
+			if !valid {
+				match.Missing = append(match.Missing, item)
+				continue
+			}
+			matched++
+			match.Matched = append(match.Matched, item)
+		}
+		score += matchWeightLicenses * float64(matched) / float64(len(job.JobLicenses))
+		weights += matchWeightLicenses
+	}
+
+	if len(job.JobRequirements) > 0 {
+		var matched int
+		for _, jobRequirement := range job.JobRequirements {
+			item := payload.JobMatchItem{Type: payload.JobMatchItemRequirement, ID: jobRequirement.JobRequirementID.String()}
+			if requirement, ok := loader.requirements[jobRequirement.JobRequirementID]; ok {
+				item.Name = requirement.Name
+			}
+			if !hasQualification(item.Name, profile.qualifications, now) {
+				match.Missing = append(match.Missing, item)
+				continue
+			}
+			matched++
+			match.Matched = append(match.Matched, item)
+		}
+		score += matchWeightRequirements * float64(matched) / float64(len(job.JobRequirements))
+		weights += matchWeightRequirements
+	}
+
+	// A job that asks for nothing suits everyone
+	if weights == 0 {
+		match.Score = 100
+		return match
+	}
+	match.Score = int(math.Round(score / weights * 100))
+	return match
+}
+
+// skillMatchItem describes a job skill by its subcategory, or its category when it has none
+func skillMatchItem(jobSkill models.JobSkill, loader *jobListingLoader) payload.JobMatchItem {
+	item := payload.JobMatchItem{Type: payload.JobMatchItemSkill}
+	if jobSkill.SkillSubcategoryID != nil {
+		item.ID = jobSkill.SkillSubcategoryID.String()
+		if subcategory, ok := loader.skillSubcategories[*jobSkill.SkillSubcategoryID]; ok {
+			item.Name = subcategory.Name
+		}
+	} else if jobSkill.SkillCategoryID != nil {
+		item.ID = jobSkill.SkillCategoryID.String()
+		if category, ok := loader.skillCategories[*jobSkill.SkillCategoryID]; ok {
+			item.Name = category.Name
+		}
+	}
+	return item
+}
+
+// matchJobSkill returns the best credit, from 0 to 1, the labourer's skills earn for a job skill
+func matchJobSkill(jobSkill models.JobSkill, skills []*labour_models.LabourProfileSkill, loader *jobListingLoader) (float64, *string) {
+	categoryID := jobSkill.SkillCategoryID
+	if categoryID == nil && jobSkill.SkillSubcategoryID != nil {
+		if subcategory, ok := loader.skillSubcategories[*jobSkill.SkillSubcategoryID]; ok {
+			categoryID = &subcategory.CategoryID
+		}
+	}
+
+	var best float64
+	var detail *string
+	for _, skill := range skills {
+		exact := false
+		if jobSkill.SkillSubcategoryID != nil {
+			exact = skill.SubcategoryID == *jobSkill.SkillSubcategoryID
+		} else if categoryID != nil {
+			exact = skill.CategoryID == *categoryID
+		}
+
+		if exact {
+			credit := matchedSkillCredit + experienceBonus*math.Min(skill.YearsExperience/experienceBonusYears, 1)
+			if skill.IsPrimary {
+				credit += primarySkillBonus
+			}
+			if credit > best {
+				best = credit
+				detail = formatSkillExperience(skill)
+			}
+			continue
+		}
+
+		if categoryID != nil && skill.CategoryID == *categoryID && relatedSkillCredit > best {
+			best = relatedSkillCredit
+			related := "related skill in the same category"
+			detail = &related
+		}
+	}
+	return best, detail
+}
+
+// formatSkillExperience describes the experience behind a matched skill
+func formatSkillExperience(skill *labour_models.LabourProfileSkill) *string {
+	detail := strconv.FormatFloat(skill.YearsExperience, 'f', -1, 64) + " years experience"
+	if skill.IsPrimary {
+		detail += ", primary skill"
+	}
+	return &detail
+}
+
+// matchJobLicense reports whether the labourer holds the license and it has not expired
+func matchJobLicense(licenseID uuid.UUID, licenses []*auth_user_models.UserLicense, now time.Time) (bool, *string) {
+	for _, userLicense := range licenses {
+		if userLicense.LicenseID != licenseID {
+			continue
+		}
+		if userLicense.ExpiresAt == nil {
+			return true, nil
+		}
+		if userLicense.ExpiresAt.Before(now) {
+			detail := "expired on " + userLicense.ExpiresAt.Format("2006-01-02")
+			return false, &detail
+		}
+		detail := "valid until " + userLicense.ExpiresAt.Format("2006-01-02")
+		return true, &detail
+	}
+	return false, nil
+}
+
+// hasQualification reports whether the labourer holds a valid qualification titled like a requirement
+func hasQualification(name string, qualifications []*qualification_models.LabourProfileQualification, now time.Time) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+	for _, labourQualification := range qualifications {
+		if labourQualification.Qualification == nil {
+			continue
+		}
+		if labourQualification.Status != "" && !strings.EqualFold(labourQualification.Status, qualificationStatusValid) {
+			continue
+		}
+		if labourQualification.ExpiresAt != nil && labourQualification.ExpiresAt.Before(now) {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(labourQualification.Qualification.Title), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// rankApplicants orders applicants by match score, keeping the newest first among equal scores
+func rankApplicants(applicants []payload.JobApplicantInfo) {
+	sort.SliceStable(applicants, func(i, j int) bool {
+		if applicants[i].Match == nil || applicants[j].Match == nil {
+			return false
+		}
+		return applicants[i].Match.Score > applicants[j].Match.Score
+	})
+}
@@ -13,18 +13,22 @@ import (
 	builder_models "github.com/yakka-backend/internal/features/builder_profiles/models"
 	job_application_db "github.com/yakka-backend/internal/features/job_applications/entity/database"
 	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	job_application_usecase "github.com/yakka-backend/internal/features/job_applications/usecase"
 	job_assignment_db "github.com/yakka-backend/internal/features/job_assignments/entity/database"
-	job_assignment_models "github.com/yakka-backend/internal/features/job_assignments/models"
 	"github.com/yakka-backend/internal/features/jobs/entity/database"
 	"github.com/yakka-backend/internal/features/jobs/models"
 	"github.com/yakka-backend/internal/features/jobs/payload"
 	jobsite_db "github.com/yakka-backend/internal/features/jobsites/entity/database"
 	jobsite_models "github.com/yakka-backend/internal/features/jobsites/models"
+	labour_db "github.com/yakka-backend/internal/features/labour_profiles/entity/database"
 	job_requirement_db "github.com/yakka-backend/internal/features/masters/job_requirements/entity/database"
 	job_type_db "github.com/yakka-backend/internal/features/masters/job_types/entity/database"
 	job_type_models "github.com/yakka-backend/internal/features/masters/job_types/models"
 	license_db "github.com/yakka-backend/internal/features/masters/licenses/entity/database"
+	payment_constant_db "github.com/yakka-backend/internal/features/masters/payment_constants/entity/database"
 	skill_category_db "github.com/yakka-backend/internal/features/masters/skills/entity/database"
+	qualification_db "github.com/yakka-backend/internal/features/qualifications/entity/database"
+	"github.com/yakka-backend/internal/infrastructure/config"
 	"gorm.io/gorm"
 )
 
@@ -36,37 +40,63 @@ type JobUsecase interface {
 	GetJobsByJobsite(ctx context.Context, jobsiteID uuid.UUID, visibility *models.JobVisibility) ([]*models.Job, error)
 	GetJobsByVisibility(ctx context.Context, visibility models.JobVisibility) ([]*models.Job, error)
 	GetAllJobs(ctx context.Context) ([]*models.Job, error)
-	UpdateJob(ctx context.Context, id uuid.UUID, req payload.UpdateJobRequest) (*models.Job, error)
-	DeleteJob(ctx context.Context, id uuid.UUID) error
+	UpdateJob(ctx context.Context, id uuid.UUID, builderProfileID uuid.UUID, req payload.UpdateJobRequest) (*models.Job, error)
+	DeleteJob(ctx context.Context, id uuid.UUID, builderProfileID uuid.UUID) (bool, error)
 	GetJobWithRelations(ctx context.Context, id uuid.UUID) (*models.Job, error)
-	GetBuilderApplicants(ctx context.Context, builderProfileID uuid.UUID) ([]payload.JobWithApplicants, error)
-	GetBuilderApplicantsByJobsite(ctx context.Context, builderProfileID uuid.UUID) ([]payload.JobsiteWithJobs, error)
+	GetBuilderApplicants(ctx context.Context, builderProfileID uuid.UUID, filter payload.BuilderApplicantFilter) ([]payload.JobWithApplicants, error)
+	GetBuilderApplicantsByJobsite(ctx context.Context, builderProfileID uuid.UUID, filter payload.BuilderApplicantFilter) ([]payload.JobsiteWithJobs, error)
+	BulkApplicantDecision(ctx context.Context, builderProfileID uuid.UUID, jobID uuid.UUID, req payload.BulkApplicantDecisionRequest) (*payload.BulkApplicantDecisionResponse, error)
+	ShortlistApplicant(ctx context.Context, builderProfileID uuid.UUID, applicationID uuid.UUID, req payload.ShortlistApplicantRequest) (*payload.ShortlistApplicantResponse, error)
+	ReviewApplicant(ctx context.Context, builderProfileID uuid.UUID, applicationID uuid.UUID, req payload.ApplicantReviewRequest) (*payload.ApplicantReviewResponse, error)
 	ProcessApplicantDecision(ctx context.Context, builderProfileID uuid.UUID, req payload.BuilderApplicantDecisionRequest) (*payload.BuilderApplicantDecisionResponse, error)
-	GetLabourJobs(ctx context.Context, labourUserID uuid.UUID) ([]payload.LabourJobInfo, error)
+	GetLabourJobs(ctx context.Context, labourUserID uuid.UUID, req payload.LabourJobSearchRequest) (*payload.LabourJobsResponse, error)
+	GetRecommendedJobs(ctx context.Context, labourUserID uuid.UUID, limit int) (*payload.LabourJobsResponse, error)
 	ApplyToJob(ctx context.Context, labourUserID uuid.UUID, req payload.LabourApplicationRequest) (*payload.LabourApplicationResponse, error)
 	GetLabourJobDetail(ctx context.Context, jobID uuid.UUID, labourUserID uuid.UUID) (*payload.LabourJobDetailResponse, error)
 	GetBuilderJobDetail(ctx context.Context, jobID uuid.UUID, builderProfileID uuid.UUID) (*payload.GetJobResponse, error)
 	UpdateJobVisibility(ctx context.Context, jobID uuid.UUID, builderProfileID uuid.UUID, req payload.UpdateJobVisibilityRequest) (*payload.UpdateJobVisibilityResponse, error)
 	GetLabourApplicants(ctx context.Context, labourUserID uuid.UUID) (*payload.LabourApplicantsResponse, error)
+	UpdateJobStatus(ctx context.Context, jobID uuid.UUID, builderProfileID uuid.UUID, req payload.UpdateJobStatusRequest) (*payload.UpdateJobStatusResponse, error)
+	ExpireOverdueJobs(ctx context.Context) (int64, error)
+	GetJobRevisions(ctx context.Context, jobID uuid.UUID) ([]payload.JobRevisionResponse, error)
+	RespondToJobChanges(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.JobChangesDecisionRequest) (*payload.JobChangesDecisionResponse, error)
+	GetLabourApplication(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID) (*payload.LabourApplicationInfo, error)
+	UpdateLabourApplication(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.UpdateLabourApplicationRequest) (*payload.LabourApplicationInfo, error)
+	WithdrawLabourApplication(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.WithdrawLabourApplicationRequest) (*payload.LabourApplicationInfo, error)
+	AcceptOffer(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID) (*payload.LabourApplicationInfo, error)
+	DeclineOffer(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.DeclineOfferRequest) (*payload.LabourApplicationInfo, error)
+	ExpireOverdueOffers(ctx context.Context) (int64, error)
+	EstimateJobPay(ctx context.Context, job *models.Job) (*payload.PayEstimateResponse, error)
 }
 
 // jobUsecase implements JobUsecase
 type jobUsecase struct {
-	jobRepo               database.JobRepository
-	jobLicenseRepo        database.JobLicenseRepository
-	jobSkillRepo          database.JobSkillRepository
-	jobJobRequirementRepo database.JobJobRequirementRepository
-	jobRequirementRepo    job_requirement_db.JobRequirementRepository
-	builderRepo           builder_db.BuilderProfileRepository
-	jobsiteRepo           jobsite_db.JobsiteRepository
-	jobTypeRepo           job_type_db.JobTypeRepository
-	jobApplicationRepo    job_application_db.JobApplicationRepository
-	jobAssignmentRepo     job_assignment_db.JobAssignmentRepository
-	licenseRepo           license_db.LicenseRepository
-	skillCategoryRepo     skill_category_db.SkillCategoryRepository
-	skillSubcategoryRepo  skill_category_db.SkillSubcategoryRepository
-	userRepo              auth_user_db.UserRepository
-	validator             *JobValidationService
+	jobRepo                 database.JobRepository
+	jobLicenseRepo          database.JobLicenseRepository
+	jobSkillRepo            database.JobSkillRepository
+	jobJobRequirementRepo   database.JobJobRequirementRepository
+	jobRevisionRepo         database.JobRevisionRepository
+	jobRequirementRepo      job_requirement_db.JobRequirementRepository
+	builderRepo             builder_db.BuilderProfileRepository
+	jobsiteRepo             jobsite_db.JobsiteRepository
+	jobTypeRepo             job_type_db.JobTypeRepository
+	jobApplicationRepo      job_application_db.JobApplicationRepository
+	applicationEventRepo    job_application_db.ApplicationStatusEventRepository
+	applicationReviewRepo   job_application_db.ApplicationReviewRepository
+	jobAssignmentRepo       job_assignment_db.JobAssignmentRepository
+	licenseRepo             license_db.LicenseRepository
+	skillCategoryRepo       skill_category_db.SkillCategoryRepository
+	skillSubcategoryRepo    skill_category_db.SkillSubcategoryRepository
+	userRepo                auth_user_db.UserRepository
+	userLicenseRepo         auth_user_db.UserLicenseRepository
+	labourProfileRepo       labour_db.LabourProfileRepository
+	labourSkillRepo         labour_db.LabourProfileSkillRepository
+	labourQualificationRepo qualification_db.LabourProfileQualificationRepository
+	paymentConstantRepo     payment_constant_db.PaymentConstantRepository
+	eligibility             config.EligibilityConfig
+	payRates                *payRatesCache
+	validator               *JobValidationService
+	uow                     database.JobUnitOfWork
 }
 
 // NewJobUsecase creates a new job usecase
@@ -75,33 +105,54 @@ func NewJobUsecase(
 	jobLicenseRepo database.JobLicenseRepository,
 	jobSkillRepo database.JobSkillRepository,
 	jobJobRequirementRepo database.JobJobRequirementRepository,
+	jobRevisionRepo database.JobRevisionRepository,
 	jobRequirementRepo job_requirement_db.JobRequirementRepository,
 	builderRepo builder_db.BuilderProfileRepository,
 	jobsiteRepo jobsite_db.JobsiteRepository,
 	jobTypeRepo job_type_db.JobTypeRepository,
 	jobApplicationRepo job_application_db.JobApplicationRepository,
+	applicationEventRepo job_application_db.ApplicationStatusEventRepository,
+	applicationReviewRepo job_application_db.ApplicationReviewRepository,
 	jobAssignmentRepo job_assignment_db.JobAssignmentRepository,
 	licenseRepo license_db.LicenseRepository,
 	skillCategoryRepo skill_category_db.SkillCategoryRepository,
 	skillSubcategoryRepo skill_category_db.SkillSubcategoryRepository,
 	userRepo auth_user_db.UserRepository,
+	userLicenseRepo auth_user_db.UserLicenseRepository,
+	labourProfileRepo labour_db.LabourProfileRepository,
+	labourSkillRepo labour_db.LabourProfileSkillRepository,
+	labourQualificationRepo qualification_db.LabourProfileQualificationRepository,
+	paymentConstantRepo payment_constant_db.PaymentConstantRepository,
+	eligibility config.EligibilityConfig,
+	uow database.JobUnitOfWork,
 ) JobUsecase {
 	return &jobUsecase{
-		jobRepo:               jobRepo,
-		jobLicenseRepo:        jobLicenseRepo,
-		jobSkillRepo:          jobSkillRepo,
-		jobJobRequirementRepo: jobJobRequirementRepo,
-		jobRequirementRepo:    jobRequirementRepo,
-		builderRepo:           builderRepo,
-		jobsiteRepo:           jobsiteRepo,
-		jobTypeRepo:           jobTypeRepo,
-		jobApplicationRepo:    jobApplicationRepo,
-		jobAssignmentRepo:     jobAssignmentRepo,
-		licenseRepo:           licenseRepo,
-		skillCategoryRepo:     skillCategoryRepo,
-		skillSubcategoryRepo:  skillSubcategoryRepo,
-		userRepo:              userRepo,
-		validator:             NewJobValidationService(builderRepo, jobsiteRepo, jobTypeRepo, licenseRepo, skillCategoryRepo, skillSubcategoryRepo, jobRequirementRepo),
+		jobRepo:                 jobRepo,
+		jobLicenseRepo:          jobLicenseRepo,
+		jobSkillRepo:            jobSkillRepo,
+		jobJobRequirementRepo:   jobJobRequirementRepo,
+		jobRevisionRepo:         jobRevisionRepo,
+		jobRequirementRepo:      jobRequirementRepo,
+		builderRepo:             builderRepo,
+		jobsiteRepo:             jobsiteRepo,
+		jobTypeRepo:             jobTypeRepo,
+		jobApplicationRepo:      jobApplicationRepo,
+		applicationEventRepo:    applicationEventRepo,
+		applicationReviewRepo:   applicationReviewRepo,
+		jobAssignmentRepo:       jobAssignmentRepo,
+		licenseRepo:             licenseRepo,
+		skillCategoryRepo:       skillCategoryRepo,
+		skillSubcategoryRepo:    skillSubcategoryRepo,
+		userRepo:                userRepo,
+		userLicenseRepo:         userLicenseRepo,
+		labourProfileRepo:       labourProfileRepo,
+		labourSkillRepo:         labourSkillRepo,
+		labourQualificationRepo: labourQualificationRepo,
+		paymentConstantRepo:     paymentConstantRepo,
+		eligibility:             eligibility,
+		payRates:                &payRatesCache{},
+		validator:               NewJobValidationService(builderRepo, jobsiteRepo, jobTypeRepo, licenseRepo, skillCategoryRepo, skillSubcategoryRepo, jobRequirementRepo),
+		uow:                     uow,
 	}
 }
 
@@ -138,21 +189,43 @@ func (u *jobUsecase) CreateJob(ctx context.Context, req payload.CreateJobRequest
 		SupervisorName:              req.SupervisorName,
 		Visibility:                  req.Visibility,
 		PaymentType:                 req.PaymentType,
+		Status:                      models.JobStatusOpen,
 	}
 
-	// Create the job
-	if err := u.jobRepo.Create(ctx, job); err != nil {
-		return nil, fmt.Errorf("failed to create job: %w", err)
+	// The job only becomes visible once all of its relationships are stored
+	err := u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		if err := repos.Jobs.Create(ctx, job); err != nil {
+			return fmt.Errorf("failed to create job: %w", err)
+		}
+		return createJobRelations(ctx, repos, job.ID, req)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Create job license relationships
+	return job, nil
+}
+
+// createJobRelations stores the license, skill and requirement links of a job
+func createJobRelations(ctx context.Context, repos database.JobTxRepositories, jobID uuid.UUID, req payload.CreateJobRequest) error {
+	// Create job license relationships, hard-required first
 	for _, licenseID := range req.LicenseIDs {
 		jobLicense := &models.JobLicense{
-			JobID:     job.ID,
+			JobID:     jobID,
+			LicenseID: licenseID,
+			Required:  true,
+		}
+		if err := repos.JobLicenses.Create(ctx, jobLicense); err != nil {
+			return fmt.Errorf("failed to create job license relationship: %w", err)
+		}
+	}
+	for _, licenseID := range req.PreferredLicenseIDs {
+		jobLicense := &models.JobLicense{
+			JobID:     jobID,
 			LicenseID: licenseID,
 		}
-		if err := u.jobLicenseRepo.Create(ctx, jobLicense); err != nil {
-			return nil, fmt.Errorf("failed to create job license relationship: %w", err)
+		if err := repos.JobLicenses.Create(ctx, jobLicense); err != nil {
+			return fmt.Errorf("failed to create preferred job license relationship: %w", err)
 		}
 	}
 
@@ -163,13 +236,13 @@ func (u *jobUsecase) CreateJob(ctx context.Context, req payload.CreateJobRequest
 		for i, jobSkillReq := range req.JobSkills {
 			log.Printf("🔍 CreateJob - Creating JobSkill %d: CategoryID=%v, SubcategoryID=%v", i, jobSkillReq.SkillCategoryID, jobSkillReq.SkillSubcategoryID)
 			jobSkill := &models.JobSkill{
-				JobID:              job.ID,
+				JobID:              jobID,
 				SkillCategoryID:    jobSkillReq.SkillCategoryID,
 				SkillSubcategoryID: jobSkillReq.SkillSubcategoryID,
 			}
-			if err := u.jobSkillRepo.Create(ctx, jobSkill); err != nil {
+			if err := repos.JobSkills.Create(ctx, jobSkill); err != nil {
 				log.Printf("🚫 CreateJob - Failed to create job skill relationship: %v", err)
-				return nil, fmt.Errorf("failed to create job skill relationship: %w", err)
+				return fmt.Errorf("failed to create job skill relationship: %w", err)
 			}
 			log.Printf("🔍 CreateJob - JobSkill created successfully: ID=%s", jobSkill.ID)
 		}
@@ -178,22 +251,22 @@ func (u *jobUsecase) CreateJob(ctx context.Context, req payload.CreateJobRequest
 		// Create records for skill categories only (without subcategories)
 		for _, skillCategoryID := range req.SkillCategoryIDs {
 			jobSkill := &models.JobSkill{
-				JobID:           job.ID,
+				JobID:           jobID,
 				SkillCategoryID: &skillCategoryID,
 			}
-			if err := u.jobSkillRepo.Create(ctx, jobSkill); err != nil {
-				return nil, fmt.Errorf("failed to create job skill category relationship: %w", err)
+			if err := repos.JobSkills.Create(ctx, jobSkill); err != nil {
+				return fmt.Errorf("failed to create job skill category relationship: %w", err)
 			}
 		}
 
 		// Create records for skill subcategories only (without categories)
 		for _, skillSubcategoryID := range req.SkillSubcategoryIDs {
 			jobSkill := &models.JobSkill{
-				JobID:              job.ID,
+				JobID:              jobID,
 				SkillSubcategoryID: &skillSubcategoryID,
 			}
-			if err := u.jobSkillRepo.Create(ctx, jobSkill); err != nil {
-				return nil, fmt.Errorf("failed to create job skill subcategory relationship: %w", err)
+			if err := repos.JobSkills.Create(ctx, jobSkill); err != nil {
+				return fmt.Errorf("failed to create job skill subcategory relationship: %w", err)
 			}
 		}
 	}
@@ -201,15 +274,15 @@ func (u *jobUsecase) CreateJob(ctx context.Context, req payload.CreateJobRequest
 	// Create job requirement relationships
 	for _, jobRequirementID := range req.JobRequirementIDs {
 		jobJobRequirement := &models.JobJobRequirement{
-			JobID:            job.ID,
+			JobID:            jobID,
 			JobRequirementID: jobRequirementID,
 		}
-		if err := u.jobJobRequirementRepo.Create(ctx, jobJobRequirement); err != nil {
-			return nil, fmt.Errorf("failed to create job requirement relationship: %w", err)
+		if err := repos.JobJobRequirements.Create(ctx, jobJobRequirement); err != nil {
+			return fmt.Errorf("failed to create job requirement relationship: %w", err)
 		}
 	}
 
-	return job, nil
+	return nil
 }
 
 // GetJobByID retrieves a job by ID
@@ -281,95 +354,318 @@ func (u *jobUsecase) GetAllJobs(ctx context.Context) ([]*models.Job, error) {
 	return jobs, nil
 }
 
-// UpdateJob updates a job
-func (u *jobUsecase) UpdateJob(ctx context.Context, id uuid.UUID, req payload.UpdateJobRequest) (*models.Job, error) {
-	// Get existing job
-	job, err := u.jobRepo.GetByID(ctx, id)
+// UpdateJob updates a job owned by the builder and replaces the link sets sent in the request.
+// The job row is locked and re-read inside the transaction, and only the edited columns are
+// written, so concurrent status and capacity changes are not overwritten.
+func (u *jobUsecase) UpdateJob(ctx context.Context, id uuid.UUID, builderProfileID uuid.UUID, req payload.UpdateJobRequest) (*models.Job, error) {
+	var job *models.Job
+	err := u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		if _, err := repos.Jobs.GetByIDForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("job not found")
+		}
+		// Load the links under the lock as well, they are merged into the validated job
+		var err error
+		job, err = repos.Jobs.GetWithRelations(ctx, id)
+		if err != nil {
+			return fmt.Errorf("job not found")
+		}
+
+		// Verify that the job belongs to the builder
+		if job.BuilderProfileID != builderProfileID {
+			return fmt.Errorf("invalid job - not owned by builder")
+		}
+
+		// Snapshot the job before merging so the revision can record what changed
+		before := mergedJobRequest(job, payload.UpdateJobRequest{})
+		updates := applyJobUpdate(job, req)
+
+		// Validate the merged job the same way a new one is validated
+		merged := mergedJobRequest(job, req)
+		if err := u.validator.ValidateCreateJobRequest(ctx, merged); err != nil {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+
+		changes := diffJobRequests(before, merged)
+
+		// Update the job, its links and its history together
+		job.UpdatedAt = time.Now()
+		updates["updated_at"] = job.UpdatedAt
+		if err := repos.Jobs.UpdateSpecificFields(ctx, job.ID, updates); err != nil {
+			return fmt.Errorf("failed to update job: %w", err)
+		}
+		if err := replaceJobRelations(ctx, repos, job.ID, req, merged); err != nil {
+			return err
+		}
+		if req.ManyLabours != nil {
+			// A new head count can fill or reopen the job
+			if err := syncJobCapacity(ctx, repos, job); err != nil {
+				return err
+			}
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		return recordJobRevision(ctx, repos, job.ID, builderProfileID, changes, job.UpdatedAt)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get job: %w", err)
+		return nil, err
 	}
 
-	// Update fields if provided
+	return job, nil
+}
+
+// applyJobUpdate merges the fields sent in an update into the job and returns the columns
+// they change
+func applyJobUpdate(job *models.Job, req payload.UpdateJobRequest) map[string]interface{} {
+	updates := map[string]interface{}{}
+
 	if req.ManyLabours != nil {
 		job.ManyLabours = *req.ManyLabours
+		updates["many_labours"] = job.ManyLabours
 	}
 	if req.OngoingWork != nil {
 		job.OngoingWork = *req.OngoingWork
+		updates["ongoing_work"] = job.OngoingWork
 	}
 	if req.WageSiteAllowance != nil {
 		job.WageSiteAllowance = req.WageSiteAllowance
+		updates["wage_site_allowance"] = job.WageSiteAllowance
 	}
 	if req.WageLeadingHandAllowance != nil {
 		job.WageLeadingHandAllowance = req.WageLeadingHandAllowance
+		updates["wage_leading_hand_allowance"] = job.WageLeadingHandAllowance
 	}
 	if req.WageProductivityAllowance != nil {
 		job.WageProductivityAllowance = req.WageProductivityAllowance
+		updates["wage_productivity_allowance"] = job.WageProductivityAllowance
 	}
 	if req.ExtrasOvertimeRate != nil {
 		job.ExtrasOvertimeRate = req.ExtrasOvertimeRate
+		updates["extras_overtime_rate"] = job.ExtrasOvertimeRate
 	}
 	if req.WageHourlyRate != nil {
 		job.WageHourlyRate = req.WageHourlyRate
+		updates["wage_hourly_rate"] = job.WageHourlyRate
 	}
 	if req.TravelAllowance != nil {
 		job.TravelAllowance = req.TravelAllowance
+		updates["travel_allowance"] = job.TravelAllowance
 	}
 	if req.GST != nil {
 		job.GST = req.GST
+		updates["gst"] = job.GST
 	}
 	if req.StartDateWork != nil {
 		job.StartDateWork = req.StartDateWork
+		updates["start_date_work"] = job.StartDateWork
 	}
 	if req.EndDateWork != nil {
 		job.EndDateWork = req.EndDateWork
+		updates["end_date_work"] = job.EndDateWork
 	}
 	if req.WorkSaturday != nil {
 		job.WorkSaturday = *req.WorkSaturday
+		updates["work_saturday"] = job.WorkSaturday
 	}
 	if req.WorkSunday != nil {
 		job.WorkSunday = *req.WorkSunday
+		updates["work_sunday"] = job.WorkSunday
 	}
 	if req.StartTime != nil {
 		job.StartTime = req.StartTime
+		updates["start_time"] = job.StartTime
 	}
 	if req.EndTime != nil {
 		job.EndTime = req.EndTime
+		updates["end_time"] = job.EndTime
 	}
 	if req.Description != nil {
 		job.Description = req.Description
+		updates["description"] = job.Description
 	}
 	if req.PaymentDay != nil {
 		job.PaymentDay = req.PaymentDay
+		updates["payment_day"] = job.PaymentDay
 	}
 	if req.RequiresSupervisorSignature != nil {
 		job.RequiresSupervisorSignature = *req.RequiresSupervisorSignature
+		updates["requires_supervisor_signature"] = job.RequiresSupervisorSignature
 	}
 	if req.SupervisorName != nil {
 		job.SupervisorName = req.SupervisorName
+		updates["supervisor_name"] = job.SupervisorName
 	}
 	if req.Visibility != nil {
 		job.Visibility = *req.Visibility
+		updates["visibility"] = job.Visibility
 	}
 	if req.PaymentType != nil {
 		job.PaymentType = *req.PaymentType
+		updates["payment_type"] = job.PaymentType
+	}
+
+	return updates
+}
+
+// mergedJobRequest builds the create request describing a job after an update,
+// taking the link sets from the request when sent and from the stored job otherwise
+func mergedJobRequest(job *models.Job, req payload.UpdateJobRequest) payload.CreateJobRequest {
+	merged := payload.CreateJobRequest{
+		BuilderProfileID:            job.BuilderProfileID,
+		JobsiteID:                   job.JobsiteID,
+		JobTypeID:                   job.JobTypeID,
+		ManyLabours:                 job.ManyLabours,
+		OngoingWork:                 job.OngoingWork,
+		WageSiteAllowance:           job.WageSiteAllowance,
+		WageLeadingHandAllowance:    job.WageLeadingHandAllowance,
+		WageProductivityAllowance:   job.WageProductivityAllowance,
+		ExtrasOvertimeRate:          job.ExtrasOvertimeRate,
+		WageHourlyRate:              job.WageHourlyRate,
+		TravelAllowance:             job.TravelAllowance,
+		GST:                         job.GST,
+		StartDateWork:               job.StartDateWork,
+		EndDateWork:                 job.EndDateWork,
+		WorkSaturday:                job.WorkSaturday,
+		WorkSunday:                  job.WorkSunday,
+		StartTime:                   job.StartTime,
+		EndTime:                     job.EndTime,
+		Description:                 job.Description,
+		PaymentDay:                  job.PaymentDay,
+		RequiresSupervisorSignature: job.RequiresSupervisorSignature,
+		SupervisorName:              job.SupervisorName,
+		Visibility:                  job.Visibility,
+		PaymentType:                 job.PaymentType,
+	}
+
+	if licensesReplaced(req) {
+		merged.LicenseIDs = req.LicenseIDs
+		merged.PreferredLicenseIDs = req.PreferredLicenseIDs
+	} else {
+		for _, jobLicense := range job.JobLicenses {
+			if jobLicense.Required {
+				merged.LicenseIDs = append(merged.LicenseIDs, jobLicense.LicenseID)
+			} else {
+				merged.PreferredLicenseIDs = append(merged.PreferredLicenseIDs, jobLicense.LicenseID)
+			}
+		}
 	}
 
-	// Update the job
-	if err := u.jobRepo.Update(ctx, job); err != nil {
-		return nil, fmt.Errorf("failed to update job: %w", err)
+	if skillsReplaced(req) {
+		merged.JobSkills = req.JobSkills
+		merged.SkillCategoryIDs = req.SkillCategoryIDs
+		merged.SkillSubcategoryIDs = req.SkillSubcategoryIDs
+	} else {
+		for _, jobSkill := range job.JobSkills {
+			merged.JobSkills = append(merged.JobSkills, payload.JobSkillRequest{
+				SkillCategoryID:    jobSkill.SkillCategoryID,
+				SkillSubcategoryID: jobSkill.SkillSubcategoryID,
+			})
+		}
 	}
 
-	// TODO: Handle job licenses and skills relationships updates
+	if req.JobRequirementIDs != nil {
+		merged.JobRequirementIDs = req.JobRequirementIDs
+	} else {
+		for _, jobRequirement := range job.JobRequirements {
+			merged.JobRequirementIDs = append(merged.JobRequirementIDs, jobRequirement.JobRequirementID)
+		}
+	}
 
-	return job, nil
+	return merged
 }
 
-// DeleteJob deletes a job
-func (u *jobUsecase) DeleteJob(ctx context.Context, id uuid.UUID) error {
-	if err := u.jobRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete job: %w", err)
+// licensesReplaced reports whether an update sends a new license set. Required and
+// preferred licenses share one table, so sending either list replaces both.
+func licensesReplaced(req payload.UpdateJobRequest) bool {
+	return req.LicenseIDs != nil || req.PreferredLicenseIDs != nil
+}
+
+// skillsReplaced reports whether an update sends a new skill set in either format
+func skillsReplaced(req payload.UpdateJobRequest) bool {
+	return req.JobSkills != nil || req.SkillCategoryIDs != nil || req.SkillSubcategoryIDs != nil
+}
+
+// replaceJobRelations deletes and recreates the link sets sent in an update
+func replaceJobRelations(ctx context.Context, repos database.JobTxRepositories, jobID uuid.UUID, req payload.UpdateJobRequest, merged payload.CreateJobRequest) error {
+	replaced := payload.CreateJobRequest{}
+
+	if licensesReplaced(req) {
+		if err := repos.JobLicenses.DeleteByJobID(ctx, jobID); err != nil {
+			return fmt.Errorf("failed to delete job license relationships: %w", err)
+		}
+		replaced.LicenseIDs = merged.LicenseIDs
+		replaced.PreferredLicenseIDs = merged.PreferredLicenseIDs
 	}
-	return nil
+
+	if skillsReplaced(req) {
+		if err := repos.JobSkills.DeleteByJobID(ctx, jobID); err != nil {
+			return fmt.Errorf("failed to delete job skill relationships: %w", err)
+		}
+		replaced.JobSkills = merged.JobSkills
+		replaced.SkillCategoryIDs = merged.SkillCategoryIDs
+		replaced.SkillSubcategoryIDs = merged.SkillSubcategoryIDs
+	}
+
+	if req.JobRequirementIDs != nil {
+		if err := repos.JobJobRequirements.DeleteByJobID(ctx, jobID); err != nil {
+			return fmt.Errorf("failed to delete job requirement relationships: %w", err)
+		}
+		replaced.JobRequirementIDs = merged.JobRequirementIDs
+	}
+
+	return createJobRelations(ctx, repos, jobID, replaced)
+}
+
+// DeleteJob deletes a job owned by the builder. Jobs that received any application, or
+// have active assignments, are archived instead: deleting them would cascade to the
+// applications and their status history that labourers still see. The returned flag
+// reports which happened.
+func (u *jobUsecase) DeleteJob(ctx context.Context, id uuid.UUID, builderProfileID uuid.UUID) (bool, error) {
+	archived := false
+	err := u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		// Lock the job so no application or acceptance arrives between the checks and the delete
+		job, err := repos.Jobs.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("job not found")
+		}
+
+		// Verify that the job belongs to the builder
+		if job.BuilderProfileID != builderProfileID {
+			return fmt.Errorf("invalid job - not owned by builder")
+		}
+
+		_, applications, err := repos.Applications.GetByJobID(ctx, id, 1, 1)
+		if err != nil {
+			return fmt.Errorf("failed to check job applications: %w", err)
+		}
+		activeAssignments, err := countActiveAssignments(ctx, repos, job)
+		if err != nil {
+			return err
+		}
+
+		if applications > 0 || activeAssignments > 0 {
+			updates := map[string]interface{}{
+				"visibility": models.JobVisibilityArchived,
+				"updated_at": time.Now(),
+			}
+			if err := repos.Jobs.UpdateSpecificFields(ctx, id, updates); err != nil {
+				return fmt.Errorf("failed to archive job: %w", err)
+			}
+			log.Printf("📦 Job %s archived instead of deleted: %d applications, %d active assignments", id, applications, activeAssignments)
+			archived = true
+			return nil
+		}
+
+		// Only the job's own links are left for the foreign key cascades to remove
+		if err := repos.Jobs.Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete job: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return archived, nil
 }
 
 // GetJobWithRelations retrieves a job with all its relations
@@ -381,197 +677,97 @@ func (u *jobUsecase) GetJobWithRelations(ctx context.Context, id uuid.UUID) (*mo
 	return job, nil
 }
 
-// GetBuilderApplicants retrieves all applicants for builder's jobs
-func (u *jobUsecase) GetBuilderApplicants(ctx context.Context, builderProfileID uuid.UUID) ([]payload.JobWithApplicants, error) {
+// GetBuilderApplicants retrieves the applicants for builder's jobs that pass the filter.
+// With a filter, jobs left without applicants are not listed.
+func (u *jobUsecase) GetBuilderApplicants(ctx context.Context, builderProfileID uuid.UUID, filter payload.BuilderApplicantFilter) ([]payload.JobWithApplicants, error) {
 	// Get all jobs for this builder
-	jobs, err := u.jobRepo.GetByBuilderProfileID(ctx, builderProfileID)
+	jobs, err := u.jobRepo.GetByBuilderProfileIDWithRelations(ctx, builderProfileID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get builder jobs: %w", err)
 	}
 
-	var jobsWithApplicants []payload.JobWithApplicants
+	loader, err := u.loadBuilderApplicantListing(ctx, jobs, filter)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
 
+	var jobsWithApplicants []payload.JobWithApplicants
 	for _, job := range jobs {
-		// Get job type for title
-		jobType, err := u.jobTypeRepo.GetByID(ctx, job.JobTypeID)
-		if err != nil {
+		jobType, ok := loader.jobTypes[job.JobTypeID]
+		if !ok {
 			continue // Skip jobs with invalid job types
 		}
 
-		// Get all applications for this job
-		applications, total, err := u.jobApplicationRepo.GetByJobID(ctx, job.ID, 1, 100) // Get up to 100 applications
-		if err != nil {
-			fmt.Printf("Error getting applications for job %s: %v\n", job.ID, err)
-			continue // Skip jobs with errors getting applications
-		}
-
-		fmt.Printf("Job %s has %d applications (total: %d)\n", job.ID, len(applications), total)
-
-		// Convert applications to JobApplicantInfo
-		applicants := make([]payload.JobApplicantInfo, 0) // Initialize as empty slice, not nil
-		for _, app := range applications {
-			// Get labour user information from users table
-			labourUser, err := u.userRepo.GetByID(ctx, app.LabourUserID)
-			if err != nil {
-				log.Printf("Error getting labour user %s: %v", app.LabourUserID, err)
-				// Create a fallback labour info if user not found
-				labourInfo := payload.LabourApplicantInfo{
-					UserID:    app.LabourUserID.String(),
-					FullName:  "Usuario Labour",
-					AvatarURL: nil,
-					Phone:     nil,
-					Email:     "usuario@ejemplo.com",
-				}
-
-				applicant := payload.JobApplicantInfo{
-					ApplicationID: app.ID.String(),
-					Status:        string(app.Status),
-					CoverLetter:   app.CoverLetter,
-					ExpectedRate:  app.ExpectedRate,
-					ResumeURL:     app.ResumeURL,
-					AppliedAt:     app.CreatedAt,
-					Labour:        labourInfo,
-				}
-				applicants = append(applicants, applicant)
-				continue
-			}
-
-			// Build labour info from user data
-			labourInfo := u.buildLabourApplicantInfoFromUser(labourUser)
-
-			applicant := payload.JobApplicantInfo{
-				ApplicationID: app.ID.String(),
-				Status:        string(app.Status),
-				CoverLetter:   app.CoverLetter,
-				ExpectedRate:  app.ExpectedRate,
-				ResumeURL:     app.ResumeURL,
-				AppliedAt:     app.CreatedAt,
-				Labour:        labourInfo,
-			}
-
-			applicants = append(applicants, applicant)
+		applicants := loader.jobApplicants(u, job, now)
+		if len(applicants) == 0 && applicantFilterActive(filter) {
+			continue
 		}
 
-		jobWithApplicants := payload.JobWithApplicants{
+		jobsWithApplicants = append(jobsWithApplicants, payload.JobWithApplicants{
 			JobID:      job.ID.String(),
 			JobTitle:   jobType.Name,
-			JobStatus:  "ACTIVE", // TODO: Add status field to job
+			JobStatus:  string(job.Status),
 			CreatedAt:  job.CreatedAt,
 			Applicants: applicants,
-		}
-
-		jobsWithApplicants = append(jobsWithApplicants, jobWithApplicants)
+		})
 	}
 
 	return jobsWithApplicants, nil
 }
 
-// GetBuilderApplicantsByJobsite retrieves all applicants for builder's jobs grouped by jobsite
-func (u *jobUsecase) GetBuilderApplicantsByJobsite(ctx context.Context, builderProfileID uuid.UUID) ([]payload.JobsiteWithJobs, error) {
+// GetBuilderApplicantsByJobsite retrieves the applicants for builder's jobs that pass the filter,
+// grouped by jobsite. With a filter, jobs left without applicants are not listed.
+func (u *jobUsecase) GetBuilderApplicantsByJobsite(ctx context.Context, builderProfileID uuid.UUID, filter payload.BuilderApplicantFilter) ([]payload.JobsiteWithJobs, error) {
 	// Get all jobs for this builder
-	jobs, err := u.jobRepo.GetByBuilderProfileID(ctx, builderProfileID)
+	jobs, err := u.jobRepo.GetByBuilderProfileIDWithRelations(ctx, builderProfileID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get builder jobs: %w", err)
 	}
 
+	loader, err := u.loadBuilderApplicantListing(ctx, jobs, filter)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
 	// Group jobs by jobsite
 	jobsiteMap := make(map[uuid.UUID]*payload.JobsiteWithJobs)
-
 	for _, job := range jobs {
-		// Get jobsite information
-		jobsite, err := u.jobsiteRepo.GetByID(ctx, job.JobsiteID)
-		if err != nil {
+		jobsite, ok := loader.jobsites[job.JobsiteID]
+		if !ok {
 			continue // Skip jobs with invalid jobsites
 		}
-
-		// Get job type for title
-		jobType, err := u.jobTypeRepo.GetByID(ctx, job.JobTypeID)
-		if err != nil {
+		jobType, ok := loader.jobTypes[job.JobTypeID]
+		if !ok {
 			continue // Skip jobs with invalid job types
 		}
 
-		// Get all applications for this job
-		applications, _, err := u.jobApplicationRepo.GetByJobID(ctx, job.ID, 1, 100) // Get up to 100 applications
-		if err != nil {
-			fmt.Printf("Error getting applications for job %s: %v\n", job.ID, err)
-			continue // Skip jobs with errors getting applications
+		applicants := loader.jobApplicants(u, job, now)
+		if len(applicants) == 0 && applicantFilterActive(filter) {
+			continue
 		}
 
-		// Convert applications to JobApplicantInfo
-		applicants := make([]payload.JobApplicantInfo, 0)
-		for _, app := range applications {
-			// Get labour user information from users table
-			labourUser, err := u.userRepo.GetByID(ctx, app.LabourUserID)
-			if err != nil {
-				log.Printf("Error getting labour user %s: %v", app.LabourUserID, err)
-				// Create a fallback labour info if user not found
-				labourInfo := payload.LabourApplicantInfo{
-					UserID:    app.LabourUserID.String(),
-					FullName:  "Usuario Labour",
-					AvatarURL: nil,
-					Phone:     nil,
-					Email:     "usuario@ejemplo.com",
-				}
-
-				applicant := payload.JobApplicantInfo{
-					ApplicationID: app.ID.String(),
-					Status:        string(app.Status),
-					CoverLetter:   app.CoverLetter,
-					ExpectedRate:  app.ExpectedRate,
-					ResumeURL:     app.ResumeURL,
-					AppliedAt:     app.CreatedAt,
-					Labour:        labourInfo,
-				}
-				applicants = append(applicants, applicant)
-				continue
-			}
-
-			// Build labour info from user data
-			labourInfo := u.buildLabourApplicantInfoFromUser(labourUser)
-
-			applicant := payload.JobApplicantInfo{
-				ApplicationID: app.ID.String(),
-				Status:        string(app.Status),
-				CoverLetter:   app.CoverLetter,
-				ExpectedRate:  app.ExpectedRate,
-				ResumeURL:     app.ResumeURL,
-				AppliedAt:     app.CreatedAt,
-				Labour:        labourInfo,
-			}
-
-			applicants = append(applicants, applicant)
+		jobWithApplicants := payload.JobWithApplicants{
+			JobID:      job.ID.String(),
+			JobTitle:   jobType.Name,
+			JobStatus:  string(job.Status),
+			CreatedAt:  job.CreatedAt,
+			Applicants: applicants,
 		}
 
 		// Create or get jobsite entry
 		if jobsiteEntry, exists := jobsiteMap[jobsite.ID]; exists {
-			// Add job to existing jobsite
-			jobWithApplicants := payload.JobWithApplicants{
-				JobID:      job.ID.String(),
-				JobTitle:   jobType.Name,
-				JobStatus:  "ACTIVE", // TODO: Add status field to job
-				CreatedAt:  job.CreatedAt,
-				Applicants: applicants,
-			}
 			jobsiteEntry.Jobs = append(jobsiteEntry.Jobs, jobWithApplicants)
-		} else {
-			// Create new jobsite entry
-			jobWithApplicants := payload.JobWithApplicants{
-				JobID:      job.ID.String(),
-				JobTitle:   jobType.Name,
-				JobStatus:  "ACTIVE", // TODO: Add status field to job
-				CreatedAt:  job.CreatedAt,
-				Applicants: applicants,
-			}
-
-			jobsiteEntry := &payload.JobsiteWithJobs{
-				JobsiteID:   jobsite.ID.String(),
-				JobsiteName: getStringValue(jobsite.Description), // Use description as name
-				Address:     jobsite.Address,
-				City:        jobsite.City,
-				Suburb:      jobsite.Suburb,
-				Jobs:        []payload.JobWithApplicants{jobWithApplicants},
-			}
-			jobsiteMap[jobsite.ID] = jobsiteEntry
+			continue
+		}
+		jobsiteMap[jobsite.ID] = &payload.JobsiteWithJobs{
+			JobsiteID:   jobsite.ID.String(),
+			JobsiteName: getStringValue(jobsite.Description), // Use description as name
+			Address:     jobsite.Address,
+			City:        jobsite.City,
+			Suburb:      jobsite.Suburb,
+			Jobs:        []payload.JobWithApplicants{jobWithApplicants},
 		}
 	}
 
@@ -609,190 +805,235 @@ func (u *jobUsecase) ProcessApplicantDecision(ctx context.Context, builderProfil
 		return nil, fmt.Errorf("application does not belong to this builder")
 	}
 
+	// Offers need a job that still takes people on
+	if *req.Hired && !takesHires(job) {
+		return nil, fmt.Errorf("job is not open for hiring")
+	}
+
 	response := &payload.BuilderApplicantDecisionResponse{
 		ApplicationID: req.ApplicationID,
 		Hired:         *req.Hired,
 		Message:       "Decision processed successfully",
 	}
 
-	if *req.Hired {
-		// Update application status to ACCEPTED
-		if err := u.jobApplicationRepo.UpdateStatus(ctx, applicationID, job_application_models.ApplicationStatusAccepted); err != nil {
-			return nil, fmt.Errorf("failed to update application status: %w", err)
+	// The status change and the offer are stored together or not at all
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		if !*req.Hired {
+			return job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusRejected, job_application_models.ApplicationActorBuilder, &builderProfileID, req.Reason)
 		}
 
-		// Create job assignment
-		assignment := &job_assignment_models.JobAssignment{
-			JobID:         application.JobID,
-			LabourUserID:  application.LabourUserID,
-			ApplicationID: applicationID,
-			StartDate:     req.StartDate,
-			EndDate:       req.EndDate,
-			Status:        job_assignment_models.AssignmentStatusActive,
-			CreatedAt:     time.Now(),
-			UpdatedAt:     time.Now(),
+		// Lock the job so concurrent offers cannot both take its last place
+		job, err := repos.Jobs.GetByIDForUpdate(ctx, job.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
 		}
-
-		// Save assignment to database
-		if err := u.jobAssignmentRepo.Create(ctx, assignment); err != nil {
-			return nil, fmt.Errorf("failed to create job assignment: %w", err)
+		places, err := remainingPlaces(ctx, repos, job)
+		if err != nil {
+			return err
+		}
+		if places == 0 {
+			return fmt.Errorf("job has no places left")
 		}
 
-		assignmentID := assignment.ID.String()
-		response.AssignmentID = &assignmentID
-	} else {
-		// Update application status to REJECTED
-		if err := u.jobApplicationRepo.UpdateStatus(ctx, applicationID, job_application_models.ApplicationStatusRejected); err != nil {
-			return nil, fmt.Errorf("failed to update application status: %w", err)
+		// Hiring sends an offer; the assignment is created once the labourer accepts it
+		terms := offerTerms{StartDate: req.StartDate, EndDate: req.EndDate, Rate: req.Rate, ExpiresAt: req.OfferExpiresAt}
+		if err := sendOffer(ctx, repos, job, application, builderProfileID, terms, req.Reason); err != nil {
+			return err
 		}
+		response.Offer = applicationOfferInfo(application)
+		response.Message = "Offer sent to the applicant"
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	response.Status = string(application.Status)
 	return response, nil
 }
 
-// GetLabourJobs retrieves all jobs with application status for a labour user
-func (u *jobUsecase) GetLabourJobs(ctx context.Context, labourUserID uuid.UUID) ([]payload.LabourJobInfo, error) {
-	// Get all public jobs with relations
-	jobs, err := u.jobRepo.GetByVisibilityWithRelations(ctx, models.JobVisibilityPublic)
+// GetLabourJobs searches open public jobs for a labour user, one page at a time, with their application status
+func (u *jobUsecase) GetLabourJobs(ctx context.Context, labourUserID uuid.UUID, req payload.LabourJobSearchRequest) (*payload.LabourJobsResponse, error) {
+	filter, err := buildJobSearchFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ask for one extra job to know whether another page follows
+	limit := filter.Limit
+	filter.Limit = limit + 1
+	hits, err := u.jobRepo.SearchPublic(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get public jobs: %w", err)
+		return nil, fmt.Errorf("failed to search jobs: %w", err)
 	}
 
-	// Convert to LabourJobInfo with application status
-	var labourJobs []payload.LabourJobInfo
+	var nextCursor *string
+	if len(hits) > limit {
+		hits = hits[:limit]
+		cursor := encodeJobSearchCursor(filter.Sort, hits[len(hits)-1])
+		nextCursor = &cursor
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	jobs, err := u.jobRepo.GetByIDsWithRelations(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+	jobsByID := make(map[uuid.UUID]*models.Job, len(jobs))
 	for _, job := range jobs {
-		// Get builder profile for this job
-		builderProfile, err := u.builderRepo.GetByID(ctx, job.BuilderProfileID)
-		if err != nil {
-			// Skip jobs with invalid builder profiles
-			continue
-		}
+		jobsByID[job.ID] = job
+	}
 
-		// Get jobsite information for location
-		jobsite, err := u.jobsiteRepo.GetByID(ctx, job.JobsiteID)
-		if err != nil {
-			// Skip jobs with invalid jobsites
+	loader, err := u.loadLabourJobListing(ctx, jobs, labourUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the order of the search
+	labourJobs := make([]payload.LabourJobInfo, 0, len(hits))
+	for _, hit := range hits {
+		job, ok := jobsByID[hit.ID]
+		if !ok {
 			continue
 		}
-
-		// Get job type information
-		jobType, err := u.jobTypeRepo.GetByID(ctx, job.JobTypeID)
+		labourJob, err := u.buildLabourJobInfo(job, loader)
 		if err != nil {
-			// Skip jobs with invalid job types
+			// Skip jobs with invalid builder profiles, jobsites or job types
 			continue
 		}
+		labourJob.DistanceKm = hit.DistanceKm
+		labourJobs = append(labourJobs, *labourJob)
+	}
 
-		// Check if labour has applied to this job
-		hasApplied := false
-		var applicationStatus *string
-		var applicationID *string
-
-		// Check if labour has already applied to this job
-		application, err := u.jobApplicationRepo.GetByJobAndLabourUser(ctx, job.ID, labourUserID)
-		if err == nil && application != nil {
-			// User has applied to this job
-			hasApplied = true
-			status := string(application.Status)
-			applicationStatus = &status
-			id := application.ID.String()
-			applicationID = &id
-		}
-
-		// Calculate total wage for budget
-		totalWage := u.calculateTotalWage(job)
+	return &payload.LabourJobsResponse{
+		Jobs:       labourJobs,
+		Total:      len(labourJobs),
+		NextCursor: nextCursor,
+		Message:    "Jobs retrieved successfully",
+	}, nil
+}
 
-		// Create jobsite info
-		jobsiteInfo := &payload.JobsiteInfo{
-			ID:          jobsite.ID.String(),
-			Name:        getStringValue(jobsite.Description), // Use description as name
-			Address:     jobsite.Address,
-			City:        jobsite.City,
-			Suburb:      jobsite.Suburb,
-			Description: jobsite.Description,
-			Latitude:    jobsite.Latitude,
-			Longitude:   jobsite.Longitude,
-			Phone:       jobsite.Phone,
-			CreatedAt:   jobsite.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:   jobsite.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+// buildLabourJobInfo converts a job to its labour listing with the labourer's application status
+func (u *jobUsecase) buildLabourJobInfo(job *models.Job, loader *jobListingLoader) (*payload.LabourJobInfo, error) {
+	builderProfile, ok := loader.builders[job.BuilderProfileID]
+	if !ok {
+		return nil, fmt.Errorf("builder profile not found")
+	}
+	jobsite, ok := loader.jobsites[job.JobsiteID]
+	if !ok {
+		return nil, fmt.Errorf("jobsite not found")
+	}
+	jobType, ok := loader.jobTypes[job.JobTypeID]
+	if !ok {
+		return nil, fmt.Errorf("job type not found")
+	}
+
+	// Check if labour has already applied to this job, latest application first
+	hasApplied := false
+	var applicationStatus *string
+	var applicationID *string
+	if applications := loader.applications[job.ID]; len(applications) > 0 {
+		hasApplied = true
+		status := string(applications[0].Status)
+		applicationStatus = &status
+		id := applications[0].ID.String()
+		applicationID = &id
+	}
+
+	// Estimate the pay with the payment constants loaded for the page
+	payEstimate := estimateJobPay(job, loader.payRates)
+
+	// Create jobsite info
+	jobsiteInfo := &payload.JobsiteInfo{
+		ID:          jobsite.ID.String(),
+		Name:        getStringValue(jobsite.Description), // Use description as name
+		Address:     jobsite.Address,
+		City:        jobsite.City,
+		Suburb:      jobsite.Suburb,
+		Description: jobsite.Description,
+		Latitude:    jobsite.Latitude,
+		Longitude:   jobsite.Longitude,
+		Phone:       jobsite.Phone,
+		CreatedAt:   jobsite.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   jobsite.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	// Create skills info
+	var skillsInfo []payload.JobSkillInfo
+	for _, jobSkill := range job.JobSkills {
+		skillInfo := payload.JobSkillInfo{
+			ID:                 jobSkill.ID.String(),
+			JobID:              jobSkill.JobID.String(),
+			SkillCategoryID:    nil,
+			SkillSubcategoryID: nil,
+			CreatedAt:          jobSkill.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 
-		// Create skills info
-		var skillsInfo []payload.JobSkillInfo
-		for _, jobSkill := range job.JobSkills {
-			skillInfo := payload.JobSkillInfo{
-				ID:                 jobSkill.ID.String(),
-				JobID:              jobSkill.JobID.String(),
-				SkillCategoryID:    nil,
-				SkillSubcategoryID: nil,
-				CreatedAt:          jobSkill.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			}
+		// Get skill category details if available
+		if jobSkill.SkillCategoryID != nil {
+			categoryID := jobSkill.SkillCategoryID.String()
+			skillInfo.SkillCategoryID = &categoryID
 
-			// Get skill category details if available
-			if jobSkill.SkillCategoryID != nil {
-				categoryID := jobSkill.SkillCategoryID.String()
-				skillInfo.SkillCategoryID = &categoryID
-
-				skillCategory, err := u.skillCategoryRepo.GetByID(ctx, *jobSkill.SkillCategoryID)
-				if err == nil {
-					skillInfo.SkillCategory = &payload.SkillCategoryInfo{
-						ID:          skillCategory.ID.String(),
-						Name:        skillCategory.Name,
-						Description: &skillCategory.Description,
-					}
+			if skillCategory, ok := loader.skillCategories[*jobSkill.SkillCategoryID]; ok {
+				skillInfo.SkillCategory = &payload.SkillCategoryInfo{
+					ID:          skillCategory.ID.String(),
+					Name:        skillCategory.Name,
+					Description: &skillCategory.Description,
 				}
 			}
+		}
 
-			// Get skill subcategory details if available
-			if jobSkill.SkillSubcategoryID != nil {
-				subcategoryID := jobSkill.SkillSubcategoryID.String()
-				skillInfo.SkillSubcategoryID = &subcategoryID
-
-				skillSubcategory, err := u.skillSubcategoryRepo.GetByID(ctx, *jobSkill.SkillSubcategoryID)
-				if err == nil {
-					skillInfo.SkillSubcategory = &payload.SkillSubcategoryInfo{
-						ID:          skillSubcategory.ID.String(),
-						Name:        skillSubcategory.Name,
-						Description: &skillSubcategory.Description,
-					}
+		// Get skill subcategory details if available
+		if jobSkill.SkillSubcategoryID != nil {
+			subcategoryID := jobSkill.SkillSubcategoryID.String()
+			skillInfo.SkillSubcategoryID = &subcategoryID
+
+			if skillSubcategory, ok := loader.skillSubcategories[*jobSkill.SkillSubcategoryID]; ok {
+				skillInfo.SkillSubcategory = &payload.SkillSubcategoryInfo{
+					ID:          skillSubcategory.ID.String(),
+					Name:        skillSubcategory.Name,
+					Description: &skillSubcategory.Description,
 				}
 			}
-
-			skillsInfo = append(skillsInfo, skillInfo)
-		}
-
-		labourJob := payload.LabourJobInfo{
-			JobID:           job.ID.String(),
-			Title:           jobType.Name, // Get from job type
-			Description:     getStringValue(job.Description),
-			Location:        jobsite.Address, // Get from jobsite
-			JobType:         jobType.Name,
-			ManyLabours:     job.ManyLabours,
-			ExperienceLevel: "INTERMEDIATE", // TODO: Get from job requirements
-			Status:          "ACTIVE",       // TODO: Add status field to job
-			Visibility:      string(job.Visibility),
-			TotalWage:       &totalWage,
-			StartDate:       job.StartDateWork,
-			EndDate:         job.EndDateWork,
-			CreatedAt:       job.CreatedAt,
-			UpdatedAt:       job.UpdatedAt,
-			Builder: payload.BuilderInfo{
-				BuilderID:   builderProfile.ID.String(),
-				CompanyName: getCompanyName(builderProfile.Company),
-				DisplayName: getStringValue(builderProfile.DisplayName),
-				Location:    getStringValue(builderProfile.Location),
-				AvatarURL:   nil, // TODO: Get from user table
-			},
-			Jobsite:           jobsiteInfo,
-			Skills:            skillsInfo,
-			HasApplied:        hasApplied,
-			ApplicationStatus: applicationStatus,
-			ApplicationID:     applicationID,
 		}
 
-		labourJobs = append(labourJobs, labourJob)
-	}
-
-	return labourJobs, nil
+		skillsInfo = append(skillsInfo, skillInfo)
+	}
+
+	labourJob := payload.LabourJobInfo{
+		JobID:           job.ID.String(),
+		Title:           jobType.Name, // Get from job type
+		Description:     getStringValue(job.Description),
+		Location:        jobsite.Address, // Get from jobsite
+		JobType:         jobType.Name,
+		ManyLabours:     job.ManyLabours,
+		ExperienceLevel: "INTERMEDIATE", // TODO: Get from job requirements
+		Status:          string(job.Status),
+		Visibility:      string(job.Visibility),
+		TotalWage:       payPeriodWage(payEstimate),
+		PayEstimate:     payEstimate,
+		StartDate:       job.StartDateWork,
+		EndDate:         job.EndDateWork,
+		CreatedAt:       job.CreatedAt,
+		UpdatedAt:       job.UpdatedAt,
+		Builder: payload.BuilderInfo{
+			BuilderID:   builderProfile.ID.String(),
+			CompanyName: getCompanyName(builderProfile.Company),
+			DisplayName: getStringValue(builderProfile.DisplayName),
+			Location:    getStringValue(builderProfile.Location),
+			AvatarURL:   nil, // TODO: Get from user table
+		},
+		Jobsite:           jobsiteInfo,
+		Skills:            skillsInfo,
+		HasApplied:        hasApplied,
+		ApplicationStatus: applicationStatus,
+		ApplicationID:     applicationID,
+	}
+
+	return &labourJob, nil
 }
 
 // getStringValue safely dereferences a string pointer
@@ -826,11 +1067,12 @@ func (u *jobUsecase) buildLabourApplicantInfoFromUser(user *auth_user_models.Use
 	}
 
 	return payload.LabourApplicantInfo{
-		UserID:    user.ID.String(),
-		FullName:  fullName,
-		AvatarURL: user.Photo,
-		Phone:     user.Phone,
-		Email:     user.Email,
+		UserID:        user.ID.String(),
+		FullName:      fullName,
+		AvatarURL:     user.Photo,
+		Phone:         user.Phone,
+		PhoneVerified: user.Phone != nil && user.PhoneVerifiedAt != nil,
+		Email:         user.Email,
 	}
 }
 
@@ -851,12 +1093,31 @@ func (u *jobUsecase) ApplyToJob(ctx context.Context, labourUserID uuid.UUID, req
 		return nil, fmt.Errorf("user has already applied to this job")
 	}
 
-	// Get job information for response
-	job, err := u.jobRepo.GetByID(ctx, jobID)
+	// Get job information for response, with the licenses and requirements checked below
+	job, err := u.jobRepo.GetWithRelations(ctx, jobID)
 	if err != nil {
 		return nil, fmt.Errorf("job not found: %w", err)
 	}
 
+	// Open jobs past their end date expire on first use, even before the periodic sweep
+	now := time.Now()
+	if isOverdue(job, now) {
+		if err := u.jobRepo.UpdateStatus(ctx, job.ID, models.JobStatusExpired); err != nil {
+			return nil, fmt.Errorf("failed to expire job: %w", err)
+		}
+		job.Status = models.JobStatusExpired
+	}
+
+	// Only open jobs take new applications
+	if !job.Status.AcceptsApplications() {
+		return nil, fmt.Errorf("job is not open for applications")
+	}
+
+	// The labourer must hold the required licenses and meet the requirements
+	if err := u.checkEligibility(ctx, job, labourUserID, req.ConfirmedRequirementIDs, now); err != nil {
+		return nil, err
+	}
+
 	// Get job type for title
 	jobType, err := u.jobTypeRepo.GetByID(ctx, job.JobTypeID)
 	if err != nil {
@@ -875,8 +1136,15 @@ func (u *jobUsecase) ApplyToJob(ctx context.Context, labourUserID uuid.UUID, req
 	}
 
 	// Save application to database
-	if err := u.jobApplicationRepo.Create(ctx, application); err != nil {
-		return nil, fmt.Errorf("failed to create application: %w", err)
+	// The application and the first event of its history are stored together
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		if err := repos.Applications.Create(ctx, application); err != nil {
+			return fmt.Errorf("failed to create application: %w", err)
+		}
+		return job_application_usecase.RecordApplicationCreated(ctx, repos.ApplicationEvents, application)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	response := &payload.LabourApplicationResponse{
@@ -926,9 +1194,15 @@ func (u *jobUsecase) GetLabourJobDetail(ctx context.Context, jobID uuid.UUID, la
 		return nil, fmt.Errorf("failed to check application: %w", err)
 	}
 
+	revisions, err := u.GetJobRevisions(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
 	response := &payload.LabourJobDetailResponse{
-		Job:     jobResp,
-		Message: "Job detail retrieved successfully",
+		Job:       jobResp,
+		Revisions: revisions,
+		Message:   "Job detail retrieved successfully",
 	}
 
 	// If application exists, add it to response
@@ -942,6 +1216,8 @@ func (u *jobUsecase) GetLabourJobDetail(ctx context.Context, jobID uuid.UUID, la
 			CreatedAt:    application.CreatedAt,
 			UpdatedAt:    application.UpdatedAt,
 			WithdrawnAt:  application.WithdrawnAt,
+			JobChangedAt: application.JobChangedAt,
+			Offer:        applicationOfferInfo(application),
 		}
 		response.Application = applicationInfo
 	}
@@ -973,14 +1249,19 @@ func (u *jobUsecase) convertToJobResponseWithRelations(ctx context.Context, job
 		RequiresSupervisorSignature: job.RequiresSupervisorSignature,
 		SupervisorName:              job.SupervisorName,
 		Visibility:                  job.Visibility,
+		Status:                      job.Status,
 		PaymentType:                 job.PaymentType,
 		CreatedAt:                   job.CreatedAt,
 		UpdatedAt:                   job.UpdatedAt,
 	}
 
-	// Calculate total wage
-	totalWage := u.calculateTotalWage(job)
-	jobResp.TotalWage = &totalWage
+	// Estimate the pay per day, week and pay period
+	payEstimate, err := u.EstimateJobPay(ctx, job)
+	if err != nil {
+		log.Printf("🚫 convertToJobResponseWithRelations - Failed to estimate pay: %v", err)
+	}
+	jobResp.PayEstimate = payEstimate
+	jobResp.TotalWage = payPeriodWage(payEstimate)
 
 	// Add builder profile information
 	if builderProfile != nil {
@@ -1044,6 +1325,7 @@ func (u *jobUsecase) convertToJobResponseWithRelations(ctx context.Context, job
 			ID:        jobLicense.ID,
 			JobID:     jobLicense.JobID,
 			LicenseID: jobLicense.LicenseID,
+			Required:  jobLicense.Required,
 			License: &payload.LicenseResponse{
 				ID:          licenseDetails.ID,
 				Name:        licenseDetails.Name,
@@ -1142,6 +1424,7 @@ func (u *jobUsecase) convertToJobResponse(ctx context.Context, job *models.Job)
 		RequiresSupervisorSignature: job.RequiresSupervisorSignature,
 		SupervisorName:              job.SupervisorName,
 		Visibility:                  job.Visibility,
+		Status:                      job.Status,
 		PaymentType:                 job.PaymentType,
 		CreatedAt:                   job.CreatedAt,
 		UpdatedAt:                   job.UpdatedAt,
@@ -1160,6 +1443,7 @@ func (u *jobUsecase) convertToJobResponse(ctx context.Context, job *models.Job)
 			ID:        jobLicense.ID,
 			JobID:     jobLicense.JobID,
 			LicenseID: jobLicense.LicenseID,
+			Required:  jobLicense.Required,
 			License: &payload.LicenseResponse{
 				ID:          licenseDetails.ID,
 				Name:        licenseDetails.Name,
@@ -1253,12 +1537,14 @@ func (u *jobUsecase) UpdateJobVisibility(ctx context.Context, jobID uuid.UUID, b
 		return nil, fmt.Errorf("invalid job - not owned by builder")
 	}
 
-	// Update only the visibility field
+	// Update only the visibility field, leaving the status and capacity columns to their owners
 	job.Visibility = req.Visibility
 	job.UpdatedAt = time.Now()
-
-	// Save the updated job
-	if err := u.jobRepo.Update(ctx, job); err != nil {
+	updates := map[string]interface{}{
+		"visibility": job.Visibility,
+		"updated_at": job.UpdatedAt,
+	}
+	if err := u.jobRepo.UpdateSpecificFields(ctx, job.ID, updates); err != nil {
 		return nil, fmt.Errorf("failed to update job visibility: %w", err)
 	}
 
@@ -1273,32 +1559,6 @@ func (u *jobUsecase) UpdateJobVisibility(ctx context.Context, jobID uuid.UUID, b
 	return response, nil
 }
 
-// calculateTotalWage calculates the total wage by summing all wage components
-func (u *jobUsecase) calculateTotalWage(job *models.Job) float64 {
-	var total float64
-
-	if job.WageSiteAllowance != nil {
-		total += *job.WageSiteAllowance
-	}
-	if job.WageLeadingHandAllowance != nil {
-		total += *job.WageLeadingHandAllowance
-	}
-	if job.WageProductivityAllowance != nil {
-		total += *job.WageProductivityAllowance
-	}
-	if job.WageHourlyRate != nil {
-		total += *job.WageHourlyRate
-	}
-	if job.TravelAllowance != nil {
-		total += *job.TravelAllowance
-	}
-	if job.ExtrasOvertimeRate != nil {
-		total += *job.ExtrasOvertimeRate
-	}
-
-	return total
-}
-
 // GetLabourApplicants retrieves all applications for a labour user with job information
 func (u *jobUsecase) GetLabourApplicants(ctx context.Context, labourUserID uuid.UUID) (*payload.LabourApplicantsResponse, error) {
 	// Get all applications for this labour user (with pagination - using large limit to get all)
@@ -1307,6 +1567,11 @@ func (u *jobUsecase) GetLabourApplicants(ctx context.Context, labourUserID uuid.
 		return nil, fmt.Errorf("failed to get applications: %w", err)
 	}
 
+	timelines, err := u.loadApplicationTimelines(ctx, applications)
+	if err != nil {
+		return nil, err
+	}
+
 	var labourApplicants []payload.LabourApplicationInfo
 
 	for _, application := range applications {
@@ -1321,62 +1586,7 @@ func (u *jobUsecase) GetLabourApplicants(ctx context.Context, labourUserID uuid.
 			continue
 		}
 
-		// Build job info with basic data
-		jobInfo := payload.JobInfo{
-			ID:             job.ID.String(),
-			Description:    getStringValue(job.Description),
-			StartDate:      job.StartDateWork,
-			EndDate:        job.EndDateWork,
-			WageHourlyRate: job.WageHourlyRate,
-			Visibility:     string(job.Visibility),
-			CreatedAt:      job.CreatedAt,
-		}
-
-		// Try to get additional relations separately
-		// Get builder profile info
-		if builderProfile, err := u.builderRepo.GetByID(ctx, job.BuilderProfileID); err == nil {
-			companyName := getCompanyName(builderProfile.Company)
-			jobInfo.BuilderProfile = &payload.BuilderProfileInfo{
-				ID:          builderProfile.ID.String(),
-				CompanyName: &companyName,
-				DisplayName: getStringValue(builderProfile.DisplayName),
-				Location:    builderProfile.Location,
-			}
-		}
-
-		// Get jobsite info
-		if jobsite, err := u.jobsiteRepo.GetByID(ctx, job.JobsiteID); err == nil {
-			jobInfo.Jobsite = &payload.JobsiteApplicationInfo{
-				ID:          jobsite.ID.String(),
-				Name:        jobsite.Description,
-				Address:     jobsite.Address,
-				City:        jobsite.City,
-				Suburb:      jobsite.Suburb,
-				Description: jobsite.Description,
-			}
-		}
-
-		// Get job type info
-		if jobType, err := u.jobTypeRepo.GetByID(ctx, job.JobTypeID); err == nil {
-			jobInfo.JobType = &payload.JobTypeInfo{
-				ID:          jobType.ID.String(),
-				Name:        jobType.Name,
-				Description: jobType.Description,
-			}
-		}
-
-		// Build labour applicant info
-		labourApplicant := payload.LabourApplicationInfo{
-			ApplicationID: application.ID.String(),
-			JobID:         application.JobID.String(),
-			Status:        string(application.Status),
-			CoverLetter:   application.CoverLetter,
-			ExpectedRate:  application.ExpectedRate,
-			ResumeURL:     application.ResumeURL,
-			AppliedAt:     application.CreatedAt,
-			Job:           jobInfo,
-		}
-
+		labourApplicant := u.buildLabourApplicationInfo(ctx, application, job, timelines[application.ID])
 		labourApplicants = append(labourApplicants, labourApplicant)
 	}
 
@@ -1388,3 +1598,67 @@ func (u *jobUsecase) GetLabourApplicants(ctx context.Context, labourUserID uuid.
 
 	return response, nil
 }
+
+// buildLabourApplicationInfo builds the labourer's view of an application with the job it is for.
+// Builder, jobsite and job type are left out when they cannot be loaded.
+func (u *jobUsecase) buildLabourApplicationInfo(ctx context.Context, application *job_application_models.JobApplication, job *models.Job, timeline []payload.ApplicationEventInfo) payload.LabourApplicationInfo {
+	// Build job info with basic data
+	jobInfo := payload.JobInfo{
+		ID:             job.ID.String(),
+		Description:    getStringValue(job.Description),
+		StartDate:      job.StartDateWork,
+		EndDate:        job.EndDateWork,
+		WageHourlyRate: job.WageHourlyRate,
+		Visibility:     string(job.Visibility),
+		Status:         string(job.Status),
+		CreatedAt:      job.CreatedAt,
+	}
+
+	// Try to get additional relations separately
+	// Get builder profile info
+	if builderProfile, err := u.builderRepo.GetByID(ctx, job.BuilderProfileID); err == nil {
+		companyName := getCompanyName(builderProfile.Company)
+		jobInfo.BuilderProfile = &payload.BuilderProfileInfo{
+			ID:          builderProfile.ID.String(),
+			CompanyName: &companyName,
+			DisplayName: getStringValue(builderProfile.DisplayName),
+			Location:    builderProfile.Location,
+		}
+	}
+
+	// Get jobsite info
+	if jobsite, err := u.jobsiteRepo.GetByID(ctx, job.JobsiteID); err == nil {
+		jobInfo.Jobsite = &payload.JobsiteApplicationInfo{
+			ID:          jobsite.ID.String(),
+			Name:        jobsite.Description,
+			Address:     jobsite.Address,
+			City:        jobsite.City,
+			Suburb:      jobsite.Suburb,
+			Description: jobsite.Description,
+		}
+	}
+
+	// Get job type info
+	if jobType, err := u.jobTypeRepo.GetByID(ctx, job.JobTypeID); err == nil {
+		jobInfo.JobType = &payload.JobTypeInfo{
+			ID:          jobType.ID.String(),
+			Name:        jobType.Name,
+			Description: jobType.Description,
+		}
+	}
+
+	return payload.LabourApplicationInfo{
+		ApplicationID: application.ID.String(),
+		JobID:         application.JobID.String(),
+		Status:        string(application.Status),
+		CoverLetter:   application.CoverLetter,
+		ExpectedRate:  application.ExpectedRate,
+		ResumeURL:     application.ResumeURL,
+		AppliedAt:     application.CreatedAt,
+		JobChangedAt:  application.JobChangedAt,
+		WithdrawnAt:   application.WithdrawnAt,
+		Offer:         applicationOfferInfo(application),
+		Timeline:      timeline,
+		Job:           jobInfo,
+	}
+}
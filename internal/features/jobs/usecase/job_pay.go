@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+	"github.com/yakka-backend/internal/shared/money"
+)
+
+// Names of the payment constants read by the pay calculator
+const (
+	paymentConstantGST             = "GST"                    // percent
+	paymentConstantWageHourly      = "WAGE_HOURLY"            // dollars an hour, for jobs without an hourly rate
+	paymentConstantSaturdayPenalty = "SATURDAY_PENALTY"       // percent of the hourly rate
+	paymentConstantSundayPenalty   = "SUNDAY_PENALTY"         // percent of the hourly rate
+	paymentConstantOvertimePenalty = "OVERTIME_PENALTY"       // percent of the hourly rate, for jobs without an overtime rate
+	paymentConstantOrdinaryHours   = "ORDINARY_HOURS_PER_DAY" // weekday hours paid at the hourly rate before overtime
+)
+
+// payRatesTTL is how long payment constants are reused before they are read again
+const payRatesTTL = 5 * time.Minute
+
+// payCurrency is the currency of every job
+const payCurrency = "AUD"
+
+// payRates holds the payment constants used to estimate pay
+type payRates struct {
+	gstPercent        int64
+	defaultHourlyRate money.Amount
+	saturdayPercent   int64
+	sundayPercent     int64
+	overtimePercent   int64
+	ordinaryMinutes   int64
+}
+
+// defaultPayRates apply to the constants missing from payment_constants
+var defaultPayRates = payRates{
+	gstPercent:      10,
+	saturdayPercent: 150,
+	sundayPercent:   200,
+	overtimePercent: 150,
+	ordinaryMinutes: 8 * 60,
+}
+
+// payRatesCache keeps the payment constants between requests so listings do not read
+// them once per job
+type payRatesCache struct {
+	mu       sync.Mutex
+	rates    payRates
+	loadedAt time.Time
+}
+
+// loadPayRates returns the payment constants, reading them again once they are older than payRatesTTL
+func (u *jobUsecase) loadPayRates(ctx context.Context) (payRates, error) {
+	cache := u.payRates
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if !cache.loadedAt.IsZero() && time.Since(cache.loadedAt) < payRatesTTL {
+		return cache.rates, nil
+	}
+
+	constants, err := u.paymentConstantRepo.GetActive(ctx)
+	if err != nil {
+		return payRates{}, fmt.Errorf("failed to get payment constants: %w", err)
+	}
+
+	rates := defaultPayRates
+	for _, constant := range constants {
+		value := int64(constant.Value)
+		switch constant.Name {
+		case paymentConstantGST:
+			rates.gstPercent = value
+		case paymentConstantWageHourly:
+			rates.defaultHourlyRate = money.Amount(value * 100)
+		case paymentConstantSaturdayPenalty:
+			rates.saturdayPercent = value
+		case paymentConstantSundayPenalty:
+			rates.sundayPercent = value
+		case paymentConstantOvertimePenalty:
+			rates.overtimePercent = value
+		case paymentConstantOrdinaryHours:
+			rates.ordinaryMinutes = value * 60
+		}
+	}
+
+	cache.rates = rates
+	cache.loadedAt = time.Now()
+	return rates, nil
+}
+
+// EstimateJobPay estimates the pay of a job per day, per week and per pay period
+func (u *jobUsecase) EstimateJobPay(ctx context.Context, job *models.Job) (*payload.PayEstimateResponse, error) {
+	rates, err := u.loadPayRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return estimateJobPay(job, rates), nil
+}
+
+// estimateJobPay works out the pay of a job from its rates, allowances, shift and days of work.
+//   - Weekday hours up to the ordinary hours are paid at the hourly rate, the rest at the job's
+//     overtime rate, or at the overtime penalty when the job has none.
+//   - Saturday and Sunday hours are all paid at their penalty percentage of the hourly rate.
+//   - Site, leading hand and productivity allowances are paid per hour worked, travel allowance per day.
+//   - GST is the job's GST percentage when set, the GST payment constant otherwise.
+//
+// It returns nil when neither the job nor the payment constants give an hourly rate.
+func estimateJobPay(job *models.Job, rates payRates) *payload.PayEstimateResponse {
+	var notes []string
+
+	hourlyRate := rates.defaultHourlyRate
+	if job.WageHourlyRate != nil {
+		hourlyRate = money.FromFloat(*job.WageHourlyRate)
+	} else {
+		notes = append(notes, "no hourly rate set, using the default of "+hourlyRate.String())
+	}
+	if hourlyRate <= 0 {
+		return nil
+	}
+
+	gstPercent := rates.gstPercent
+	if job.GST != nil {
+		gstPercent = int64(math.Round(*job.GST))
+	}
+
+	shift, ok := shiftMinutes(job.StartTime, job.EndTime)
+	if !ok {
+		shift = rates.ordinaryMinutes
+		notes = append(notes, fmt.Sprintf("no start and end time set, assuming %s hours a day", formatHours(shift)))
+	}
+
+	estimate := &payload.PayEstimateResponse{
+		Currency:    payCurrency,
+		PaymentType: string(job.PaymentType),
+		HourlyRate:  hourlyRate,
+		GSTPercent:  int(gstPercent),
+		Notes:       notes,
+	}
+
+	weekday := dayPay(job, rates, payload.PayDayWeekday, hourlyRate, shift, gstPercent)
+	estimate.Days = append(estimate.Days, weekday)
+	week := weekday.Subtotal.Times(5)
+
+	var saturday, sunday payload.DayPayEstimate
+	if job.WorkSaturday {
+		saturday = dayPay(job, rates, payload.PayDaySaturday, hourlyRate, shift, gstPercent)
+		estimate.Days = append(estimate.Days, saturday)
+		week += saturday.Subtotal
+	}
+	if job.WorkSunday {
+		sunday = dayPay(job, rates, payload.PayDaySunday, hourlyRate, shift, gstPercent)
+		estimate.Days = append(estimate.Days, sunday)
+		week += sunday.Subtotal
+	}
+	estimate.PerWeek = withGST(week, gstPercent)
+
+	switch job.PaymentType {
+	case models.PaymentTypeFortnightly:
+		estimate.PayPeriod = payload.PayPeriodFortnight
+		estimate.PerPayPeriod = withGST(week.Times(2), gstPercent)
+	case models.PaymentTypeFixedDay:
+		estimate.PayPeriod = payload.PayPeriodMonth
+		estimate.PerPayPeriod = withGST(week.MulDiv(52, 12), gstPercent)
+	default:
+		estimate.PayPeriod = payload.PayPeriodWeek
+		estimate.PerPayPeriod = estimate.PerWeek
+	}
+
+	if !job.OngoingWork && job.StartDateWork != nil && job.EndDateWork != nil && !job.EndDateWork.Before(*job.StartDateWork) {
+		weekdays, saturdays, sundays := countWorkDays(*job.StartDateWork, *job.EndDateWork)
+		whole := weekday.Subtotal.Times(weekdays)
+		workingDays := weekdays
+		if job.WorkSaturday {
+			whole += saturday.Subtotal.Times(saturdays)
+			workingDays += saturdays
+		}
+		if job.WorkSunday {
+			whole += sunday.Subtotal.Times(sundays)
+			workingDays += sundays
+		}
+		estimate.WholeJob = &payload.JobPayEstimate{
+			WorkingDays: int(workingDays),
+			PayAmount:   withGST(whole, gstPercent),
+		}
+	}
+
+	return estimate
+}
+
+// payPeriodWage returns the pay per pay period before GST, for the total_wage field
+func payPeriodWage(estimate *payload.PayEstimateResponse) *float64 {
+	if estimate == nil {
+		return nil
+	}
+	wage := estimate.PerPayPeriod.Subtotal.Float64()
+	return &wage
+}
+
+// dayPay works out the pay of one day of work of the given kind
+func dayPay(job *models.Job, rates payRates, day string, hourlyRate money.Amount, shift int64, gstPercent int64) payload.DayPayEstimate {
+	ordinary, overtime := shift, int64(0)
+	var ordinaryPay, overtimePay money.Amount
+
+	switch day {
+	case payload.PayDaySaturday:
+		ordinaryPay = hourlyRate.MulDiv(rates.saturdayPercent*shift, 100*60)
+	case payload.PayDaySunday:
+		ordinaryPay = hourlyRate.MulDiv(rates.sundayPercent*shift, 100*60)
+	default:
+		if shift > rates.ordinaryMinutes {
+			ordinary, overtime = rates.ordinaryMinutes, shift-rates.ordinaryMinutes
+		}
+		ordinaryPay = hourlyRate.ForMinutes(ordinary)
+		if job.ExtrasOvertimeRate != nil {
+			overtimePay = money.FromFloat(*job.ExtrasOvertimeRate).ForMinutes(overtime)
+		} else {
+			overtimePay = hourlyRate.MulDiv(rates.overtimePercent*overtime, 100*60)
+		}
+	}
+
+	var hourlyAllowances money.Amount
+	for _, allowance := range []*float64{job.WageSiteAllowance, job.WageLeadingHandAllowance, job.WageProductivityAllowance} {
+		if allowance != nil {
+			hourlyAllowances += money.FromFloat(*allowance)
+		}
+	}
+	allowances := hourlyAllowances.ForMinutes(shift)
+	if job.TravelAllowance != nil {
+		allowances += money.FromFloat(*job.TravelAllowance)
+	}
+
+	return payload.DayPayEstimate{
+		Day:           day,
+		OrdinaryHours: minutesToHours(ordinary),
+		OvertimeHours: minutesToHours(overtime),
+		OrdinaryPay:   ordinaryPay,
+		OvertimePay:   overtimePay,
+		Allowances:    allowances,
+		PayAmount:     withGST(ordinaryPay+overtimePay+allowances, gstPercent),
+	}
+}
+
+// withGST adds GST to an amount of pay
+func withGST(subtotal money.Amount, gstPercent int64) payload.PayAmount {
+	gst := subtotal.Percent(gstPercent)
+	return payload.PayAmount{
+		Subtotal: subtotal,
+		GST:      gst,
+		Total:    subtotal + gst,
+	}
+}
+
+// shiftMinutes returns the length of the daily shift. A shift ending at or before its
+// start time runs past midnight.
+func shiftMinutes(startTime, endTime *string) (int64, bool) {
+	if startTime == nil || endTime == nil {
+		return 0, false
+	}
+	start, ok := parseClock(*startTime)
+	if !ok {
+		return 0, false
+	}
+	end, ok := parseClock(*endTime)
+	if !ok {
+		return 0, false
+	}
+	if end <= start {
+		end += 24 * 60
+	}
+	return end - start, true
+}
+
+// parseClock parses a "HH:MM:SS" or "HH:MM" time of day into minutes after midnight
+func parseClock(value string) (int64, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return int64(t.Hour()*60 + t.Minute()), true
+		}
+	}
+	return 0, false
+}
+
+// countWorkDays counts the weekdays, Saturdays and Sundays between two dates, both included
+func countWorkDays(start, end time.Time) (weekdays, saturdays, sundays int64) {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	days := int64(end.Sub(start).Hours()/24) + 1
+
+	weeks := days / 7
+	weekdays, saturdays, sundays = weeks*5, weeks, weeks
+	for day := start.AddDate(0, 0, int(weeks*7)); !day.After(end); day = day.AddDate(0, 0, 1) {
+		switch day.Weekday() {
+		case time.Saturday:
+			saturdays++
+		case time.Sunday:
+			sundays++
+		default:
+			weekdays++
+		}
+	}
+	return weekdays, saturdays, sundays
+}
+
+// minutesToHours converts minutes to hours rounded to two decimals
+func minutesToHours(minutes int64) float64 {
+	return math.Round(float64(minutes)/60*100) / 100
+}
+
+// formatHours formats minutes as hours, e.g. "7.5"
+func formatHours(minutes int64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", minutesToHours(minutes)), "0"), ".")
+}
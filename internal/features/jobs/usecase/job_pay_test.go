@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+	"github.com/yakka-backend/internal/shared/money"
+)
+
+func floatPtr(v float64) *float64 { return &v }
+
+func stringPtr(v string) *string { return &v }
+
+func datePtr(year int, month time.Month, day int) *time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestEstimateJobPay(t *testing.T) {
+	withDefaultRate := defaultPayRates
+	withDefaultRate.defaultHourlyRate = 3000
+
+	tests := []struct {
+		name  string
+		job   models.Job
+		rates payRates
+		want  *payload.PayEstimateResponse
+	}{
+		{
+			// The worked example in docs/jobs-pay-estimate.md
+			name: "docs example",
+			job: models.Job{
+				WageHourlyRate:    floatPtr(35.50),
+				WageSiteAllowance: floatPtr(2.25),
+				TravelAllowance:   floatPtr(15),
+				StartTime:         stringPtr("07:00:00"),
+				EndTime:           stringPtr("17:30:00"),
+				WorkSaturday:      true,
+				PaymentType:       models.PaymentTypeFortnightly,
+				StartDateWork:     datePtr(2026, time.March, 2),
+				EndDateWork:       datePtr(2026, time.March, 15),
+			},
+			rates: defaultPayRates,
+			want: &payload.PayEstimateResponse{
+				Currency:    "AUD",
+				PaymentType: "FORTNIGHTLY",
+				HourlyRate:  3550,
+				GSTPercent:  10,
+				Days: []payload.DayPayEstimate{
+					{
+						Day:           payload.PayDayWeekday,
+						OrdinaryHours: 8,
+						OvertimeHours: 2.5,
+						OrdinaryPay:   28400,
+						OvertimePay:   13313,
+						Allowances:    3863,
+						PayAmount:     payload.PayAmount{Subtotal: 45576, GST: 4558, Total: 50134},
+					},
+					{
+						Day:           payload.PayDaySaturday,
+						OrdinaryHours: 10.5,
+						OrdinaryPay:   55913,
+						Allowances:    3863,
+						PayAmount:     payload.PayAmount{Subtotal: 59776, GST: 5978, Total: 65754},
+					},
+				},
+				PerWeek:      payload.PayAmount{Subtotal: 287656, GST: 28766, Total: 316422},
+				PayPeriod:    payload.PayPeriodFortnight,
+				PerPayPeriod: payload.PayAmount{Subtotal: 575312, GST: 57531, Total: 632843},
+				WholeJob: &payload.JobPayEstimate{
+					WorkingDays: 12,
+					PayAmount:   payload.PayAmount{Subtotal: 575312, GST: 57531, Total: 632843},
+				},
+			},
+		},
+		{
+			name: "monthly ongoing job on the default rate and shift",
+			job: models.Job{
+				GST:           floatPtr(0),
+				PaymentType:   models.PaymentTypeFixedDay,
+				OngoingWork:   true,
+				StartDateWork: datePtr(2026, time.March, 2),
+			},
+			rates: withDefaultRate,
+			want: &payload.PayEstimateResponse{
+				Currency:    "AUD",
+				PaymentType: "FIXED_DAY",
+				HourlyRate:  3000,
+				GSTPercent:  0,
+				Days: []payload.DayPayEstimate{
+					{
+						Day:           payload.PayDayWeekday,
+						OrdinaryHours: 8,
+						OrdinaryPay:   24000,
+						PayAmount:     payload.PayAmount{Subtotal: 24000, Total: 24000},
+					},
+				},
+				PerWeek:      payload.PayAmount{Subtotal: 120000, Total: 120000},
+				PayPeriod:    payload.PayPeriodMonth,
+				PerPayPeriod: payload.PayAmount{Subtotal: 520000, Total: 520000},
+				Notes: []string{
+					"no hourly rate set, using the default of 30.00",
+					"no start and end time set, assuming 8 hours a day",
+				},
+			},
+		},
+		{
+			name:  "no hourly rate anywhere",
+			job:   models.Job{PaymentType: models.PaymentTypeWeekly},
+			rates: defaultPayRates,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := estimateJobPay(&tt.job, tt.rates)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("estimateJobPay() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftMinutes(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end *string
+		want       int64
+		wantOK     bool
+	}{
+		{"day shift", stringPtr("07:00:00"), stringPtr("17:30:00"), 630, true},
+		{"overnight", stringPtr("22:00"), stringPtr("06:00"), 480, true},
+		{"just past midnight", stringPtr("23:30:00"), stringPtr("00:15:00"), 45, true},
+		{"same start and end is a full day", stringPtr("08:00"), stringPtr("08:00"), 24 * 60, true},
+		{"missing end", stringPtr("07:00"), nil, 0, false},
+		{"invalid start", stringPtr("25:00"), stringPtr("06:00"), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := shiftMinutes(tt.start, tt.end)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("shiftMinutes() = %d, %v, want %d, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCountWorkDays(t *testing.T) {
+	day := func(d int, hour int) time.Time { return time.Date(2026, time.March, d, hour, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name                         string
+		start, end                   time.Time
+		weekdays, saturdays, sundays int64
+	}{
+		{"single monday", day(2, 0), day(2, 0), 1, 0, 0},
+		{"weekend only", day(7, 0), day(8, 0), 0, 1, 1},
+		{"week starting midweek", day(4, 0), day(10, 0), 5, 1, 1},
+		{"week and a partial week", day(5, 0), day(16, 0), 8, 2, 2},
+		{"two full weeks", day(2, 0), day(15, 0), 10, 2, 2},
+		{"time of day ignored", day(2, 23), day(3, 1), 2, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weekdays, saturdays, sundays := countWorkDays(tt.start, tt.end)
+			if weekdays != tt.weekdays || saturdays != tt.saturdays || sundays != tt.sundays {
+				t.Errorf("countWorkDays() = %d, %d, %d, want %d, %d, %d",
+					weekdays, saturdays, sundays, tt.weekdays, tt.saturdays, tt.sundays)
+			}
+		})
+	}
+}
+
+func TestDayPay(t *testing.T) {
+	tests := []struct {
+		name  string
+		job   models.Job
+		day   string
+		shift int64
+		want  payload.DayPayEstimate
+	}{
+		{
+			name:  "weekday within ordinary hours",
+			day:   payload.PayDayWeekday,
+			shift: 7*60 + 30,
+			want: payload.DayPayEstimate{
+				Day: payload.PayDayWeekday, OrdinaryHours: 7.5, OrdinaryPay: 22500,
+				PayAmount: payload.PayAmount{Subtotal: 22500, GST: 2250, Total: 24750},
+			},
+		},
+		{
+			name:  "overtime at the overtime penalty",
+			day:   payload.PayDayWeekday,
+			shift: 10 * 60,
+			want: payload.DayPayEstimate{
+				Day: payload.PayDayWeekday, OrdinaryHours: 8, OvertimeHours: 2, OrdinaryPay: 24000, OvertimePay: 9000,
+				PayAmount: payload.PayAmount{Subtotal: 33000, GST: 3300, Total: 36300},
+			},
+		},
+		{
+			name:  "overtime at the job's overtime rate",
+			job:   models.Job{ExtrasOvertimeRate: floatPtr(50)},
+			day:   payload.PayDayWeekday,
+			shift: 10 * 60,
+			want: payload.DayPayEstimate{
+				Day: payload.PayDayWeekday, OrdinaryHours: 8, OvertimeHours: 2, OrdinaryPay: 24000, OvertimePay: 10000,
+				PayAmount: payload.PayAmount{Subtotal: 34000, GST: 3400, Total: 37400},
+			},
+		},
+		{
+			name:  "sunday has no overtime",
+			job:   models.Job{ExtrasOvertimeRate: floatPtr(50)},
+			day:   payload.PayDaySunday,
+			shift: 10 * 60,
+			want: payload.DayPayEstimate{
+				Day: payload.PayDaySunday, OrdinaryHours: 10, OrdinaryPay: 60000,
+				PayAmount: payload.PayAmount{Subtotal: 60000, GST: 6000, Total: 66000},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dayPay(&tt.job, defaultPayRates, tt.day, money.Amount(3000), tt.shift, 10)
+			if got != tt.want {
+				t.Errorf("dayPay() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
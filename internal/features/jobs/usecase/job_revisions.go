@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+)
+
+// jobFieldDiff compares one field of a job before and after an edit
+type jobFieldDiff struct {
+	field    string
+	material bool // applicants must confirm the job again when it changes
+	value    func(req payload.CreateJobRequest) *string
+}
+
+// jobFieldDiffs lists the fields tracked in job revisions. Pay, dates and head count
+// are material: they change what the labourer agreed to when applying.
+var jobFieldDiffs = []jobFieldDiff{
+	{"many_labours", true, func(r payload.CreateJobRequest) *string { return formatInt(r.ManyLabours) }},
+	{"wage_hourly_rate", true, func(r payload.CreateJobRequest) *string { return formatFloat(r.WageHourlyRate) }},
+	{"wage_site_allowance", true, func(r payload.CreateJobRequest) *string { return formatFloat(r.WageSiteAllowance) }},
+	{"wage_leading_hand_allowance", true, func(r payload.CreateJobRequest) *string { return formatFloat(r.WageLeadingHandAllowance) }},
+	{"wage_productivity_allowance", true, func(r payload.CreateJobRequest) *string { return formatFloat(r.WageProductivityAllowance) }},
+	{"extras_overtime_rate", true, func(r payload.CreateJobRequest) *string { return formatFloat(r.ExtrasOvertimeRate) }},
+	{"travel_allowance", true, func(r payload.CreateJobRequest) *string { return formatFloat(r.TravelAllowance) }},
+	{"start_date_work", true, func(r payload.CreateJobRequest) *string { return formatTime(r.StartDateWork) }},
+	{"end_date_work", true, func(r payload.CreateJobRequest) *string { return formatTime(r.EndDateWork) }},
+	{"gst", false, func(r payload.CreateJobRequest) *string { return formatFloat(r.GST) }},
+	{"ongoing_work", false, func(r payload.CreateJobRequest) *string { return formatBool(r.OngoingWork) }},
+	{"work_saturday", false, func(r payload.CreateJobRequest) *string { return formatBool(r.WorkSaturday) }},
+	{"work_sunday", false, func(r payload.CreateJobRequest) *string { return formatBool(r.WorkSunday) }},
+	{"start_time", false, func(r payload.CreateJobRequest) *string { return r.StartTime }},
+	{"end_time", false, func(r payload.CreateJobRequest) *string { return r.EndTime }},
+	{"description", false, func(r payload.CreateJobRequest) *string { return r.Description }},
+	{"payment_day", false, func(r payload.CreateJobRequest) *string { return formatTime(r.PaymentDay) }},
+	{"payment_type", false, func(r payload.CreateJobRequest) *string { return formatString(string(r.PaymentType)) }},
+	{"requires_supervisor_signature", false, func(r payload.CreateJobRequest) *string { return formatBool(r.RequiresSupervisorSignature) }},
+	{"supervisor_name", false, func(r payload.CreateJobRequest) *string { return r.SupervisorName }},
+	{"license_ids", false, func(r payload.CreateJobRequest) *string { return formatIDs(r.LicenseIDs) }},
+	{"preferred_license_ids", false, func(r payload.CreateJobRequest) *string { return formatIDs(r.PreferredLicenseIDs) }},
+	{"skills", false, func(r payload.CreateJobRequest) *string { return formatSkills(r) }},
+	{"job_requirement_ids", false, func(r payload.CreateJobRequest) *string { return formatIDs(r.JobRequirementIDs) }},
+}
+
+// diffJobRequests returns the field-level changes between two states of a job
+func diffJobRequests(before, after payload.CreateJobRequest) []models.JobRevisionChange {
+	var changes []models.JobRevisionChange
+	for _, diff := range jobFieldDiffs {
+		oldValue, newValue := diff.value(before), diff.value(after)
+		if equalValues(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, models.JobRevisionChange{
+			Field:    diff.field,
+			OldValue: oldValue,
+			NewValue: newValue,
+			Material: diff.material,
+		})
+	}
+	return changes
+}
+
+// recordJobRevision stores the next revision of a job and, when it is material, flags the
+// applications made before it so the labourers confirm or withdraw
+func recordJobRevision(ctx context.Context, repos database.JobTxRepositories, jobID, builderProfileID uuid.UUID, changes []models.JobRevisionChange, changedAt time.Time) error {
+	version, err := repos.Revisions.GetLatestVersion(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to get job revision version: %w", err)
+	}
+
+	revision := &models.JobRevision{
+		JobID:            jobID,
+		Version:          version + 1,
+		BuilderProfileID: builderProfileID,
+		CreatedAt:        changedAt,
+		Changes:          changes,
+	}
+	for _, change := range changes {
+		if change.Material {
+			revision.Material = true
+			break
+		}
+	}
+
+	if err := repos.Revisions.Create(ctx, revision); err != nil {
+		return fmt.Errorf("failed to create job revision: %w", err)
+	}
+
+	if revision.Material {
+		flagged, err := repos.Applications.FlagJobChanged(ctx, jobID, changedAt)
+		if err != nil {
+			return fmt.Errorf("failed to flag applications of changed job: %w", err)
+		}
+		log.Printf("📝 Job %s revision %d changed materially, %d applications need confirmation", jobID, revision.Version, flagged)
+	}
+
+	return nil
+}
+
+// GetJobRevisions retrieves the edit history of a job, newest first
+func (u *jobUsecase) GetJobRevisions(ctx context.Context, jobID uuid.UUID) ([]payload.JobRevisionResponse, error) {
+	revisions, err := u.jobRevisionRepo.GetByJobID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job revisions: %w", err)
+	}
+	return convertToJobRevisionResponses(revisions), nil
+}
+
+// RespondToJobChanges lets a labourer keep or withdraw an application after the job changed materially.
+// Withdrawing an accepted application also cancels the labourer's active assignment.
+func (u *jobUsecase) RespondToJobChanges(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.JobChangesDecisionRequest) (*payload.JobChangesDecisionResponse, error) {
+	application, err := u.jobApplicationRepo.GetByID(ctx, applicationID)
+	if err != nil || application.LabourUserID != labourUserID {
+		return nil, fmt.Errorf("application not found")
+	}
+	if application.JobChangedAt == nil {
+		return nil, fmt.Errorf("no pending job changes")
+	}
+
+	response := &payload.JobChangesDecisionResponse{
+		ApplicationID: application.ID.String(),
+		Status:        string(application.Status),
+	}
+
+	switch req.Decision {
+	case payload.JobChangesDecisionConfirm:
+		if err := u.jobApplicationRepo.ClearJobChanged(ctx, application.ID); err != nil {
+			return nil, fmt.Errorf("failed to confirm job changes: %w", err)
+		}
+		response.Message = "Job changes confirmed, your application stays active"
+	case payload.JobChangesDecisionWithdraw:
+		reason := "withdrawn after the job changed"
+		err := u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+			return withdrawLabourApplication(ctx, repos, application, labourUserID, &reason)
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.Status = string(job_application_models.ApplicationStatusWithdrawn)
+		response.Message = "Application withdrawn after job changes"
+	default:
+		return nil, fmt.Errorf("invalid decision")
+	}
+
+	return response, nil
+}
+
+// convertToJobRevisionResponses converts stored revisions to responses
+func convertToJobRevisionResponses(revisions []*models.JobRevision) []payload.JobRevisionResponse {
+	responses := make([]payload.JobRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		changes := make([]payload.JobRevisionChangeResponse, 0, len(revision.Changes))
+		for _, change := range revision.Changes {
+			changes = append(changes, payload.JobRevisionChangeResponse{
+				Field:    change.Field,
+				OldValue: change.OldValue,
+				NewValue: change.NewValue,
+				Material: change.Material,
+			})
+		}
+		responses = append(responses, payload.JobRevisionResponse{
+			Version:   revision.Version,
+			Material:  revision.Material,
+			CreatedAt: revision.CreatedAt,
+			Changes:   changes,
+		})
+	}
+	return responses
+}
+
+// equalValues compares two optional values
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// formatString returns nil for empty strings
+func formatString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// formatInt formats an integer value
+func formatInt(value int) *string {
+	return formatString(strconv.Itoa(value))
+}
+
+// formatFloat formats an optional amount without trailing zeros
+func formatFloat(value *float64) *string {
+	if value == nil {
+		return nil
+	}
+	return formatString(strconv.FormatFloat(*value, 'f', -1, 64))
+}
+
+// formatBool formats a boolean value
+func formatBool(value bool) *string {
+	return formatString(strconv.FormatBool(value))
+}
+
+// formatTime formats an optional timestamp as RFC 3339
+func formatTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	return formatString(value.UTC().Format(time.RFC3339))
+}
+
+// formatIDs formats a set of IDs in a stable order
+func formatIDs(ids []uuid.UUID) *string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return formatSet(values)
+}
+
+// formatSkills formats the skills of a job in either request format as "category:subcategory" pairs
+func formatSkills(req payload.CreateJobRequest) *string {
+	var values []string
+	for _, jobSkill := range req.JobSkills {
+		values = append(values, formatOptionalID(jobSkill.SkillCategoryID)+":"+formatOptionalID(jobSkill.SkillSubcategoryID))
+	}
+	for _, skillCategoryID := range req.SkillCategoryIDs {
+		values = append(values, skillCategoryID.String()+":")
+	}
+	for _, skillSubcategoryID := range req.SkillSubcategoryIDs {
+		values = append(values, ":"+skillSubcategoryID.String())
+	}
+	return formatSet(values)
+}
+
+// formatOptionalID formats an optional ID, empty when missing
+func formatOptionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// formatSet sorts and joins the values of a set
+func formatSet(values []string) *string {
+	sort.Strings(values)
+	return formatString(strings.Join(values, ","))
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+)
+
+// defaultJobSearchLimit is the page size when the request does not set one
+const defaultJobSearchLimit = 20
+
+// jobSearchCursor is the opaque cursor handed to clients. It remembers the sort it was
+// issued for so a page cannot be continued with a different order.
+type jobSearchCursor struct {
+	Sort database.JobSearchSort `json:"sort"`
+	database.JobSearchCursor
+}
+
+// buildJobSearchFilter checks a labour job search request and converts it to a repository filter
+func buildJobSearchFilter(req payload.LabourJobSearchRequest) (database.JobSearchFilter, error) {
+	filter := database.JobSearchFilter{
+		JobTypeID:          req.JobTypeID,
+		SkillCategoryID:    req.SkillCategoryID,
+		SkillSubcategoryID: req.SkillSubcategoryID,
+		LicenseID:          req.LicenseID,
+		MinHourlyRate:      req.MinWage,
+		MaxHourlyRate:      req.MaxWage,
+		StartFrom:          req.StartFrom,
+		StartTo:            req.StartTo,
+		WorkSaturday:       req.WorkSaturday,
+		WorkSunday:         req.WorkSunday,
+		PaymentType:        req.PaymentType,
+		RadiusKm:           req.RadiusKm,
+		Sort:               database.JobSearchSort(req.Sort),
+		Limit:              req.Limit,
+	}
+	if filter.Sort == "" {
+		filter.Sort = database.JobSearchSortRecent
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultJobSearchLimit
+	}
+
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		return filter, fmt.Errorf("invalid search: lat and lng must be sent together")
+	}
+	if req.Latitude != nil {
+		filter.Near = &database.JobSearchPoint{Latitude: *req.Latitude, Longitude: *req.Longitude}
+	}
+	if filter.Near == nil && (filter.RadiusKm != nil || filter.Sort == database.JobSearchSortDistance) {
+		return filter, fmt.Errorf("invalid search: lat and lng are required to search by distance")
+	}
+	if req.MinWage != nil && req.MaxWage != nil && *req.MinWage > *req.MaxWage {
+		return filter, fmt.Errorf("invalid search: min_wage cannot be greater than max_wage")
+	}
+	if req.StartFrom != nil && req.StartTo != nil && req.StartFrom.After(*req.StartTo) {
+		return filter, fmt.Errorf("invalid search: start_from cannot be after start_to")
+	}
+
+	if req.Cursor != "" {
+		after, err := decodeJobSearchCursor(req.Cursor, filter.Sort)
+		if err != nil {
+			return filter, err
+		}
+		filter.After = after
+	}
+
+	return filter, nil
+}
+
+// encodeJobSearchCursor returns the cursor of the page that starts after a hit
+func encodeJobSearchCursor(sort database.JobSearchSort, hit database.JobSearchHit) string {
+	cursor := jobSearchCursor{
+		Sort:            sort,
+		JobSearchCursor: database.JobSearchCursor{ID: hit.ID},
+	}
+	switch sort {
+	case database.JobSearchSortDistance:
+		if hit.DistanceKm != nil {
+			cursor.Value = *hit.DistanceKm
+		}
+	case database.JobSearchSortPay:
+		cursor.Value = hit.HourlyRate
+	default:
+		cursor.CreatedAt = hit.CreatedAt
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeJobSearchCursor reads a cursor issued for the same sort
+func decodeJobSearchCursor(value string, sort database.JobSearchSort) (*database.JobSearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search: invalid cursor")
+	}
+
+	var cursor jobSearchCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("invalid search: invalid cursor")
+	}
+	if cursor.Sort != sort {
+		return nil, fmt.Errorf("invalid search: cursor was issued for another sort")
+	}
+
+	return &cursor.JobSearchCursor, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+)
+
+func TestJobSearchCursorRoundTrip(t *testing.T) {
+	distance := 12.75
+	hit := database.JobSearchHit{
+		ID:         uuid.New(),
+		CreatedAt:  time.Date(2026, 3, 4, 5, 6, 7, 891011000, time.UTC),
+		HourlyRate: 42.5,
+		DistanceKm: &distance,
+	}
+	tests := []struct {
+		sort database.JobSearchSort
+		want database.JobSearchCursor
+	}{
+		{database.JobSearchSortRecent, database.JobSearchCursor{CreatedAt: hit.CreatedAt, ID: hit.ID}},
+		{database.JobSearchSortPay, database.JobSearchCursor{Value: hit.HourlyRate, ID: hit.ID}},
+		{database.JobSearchSortDistance, database.JobSearchCursor{Value: distance, ID: hit.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			got, err := decodeJobSearchCursor(encodeJobSearchCursor(tt.sort, hit), tt.sort)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if !got.CreatedAt.Equal(tt.want.CreatedAt) || got.Value != tt.want.Value || got.ID != tt.want.ID {
+				t.Errorf("cursor = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeJobSearchCursorErrors(t *testing.T) {
+	hit := database.JobSearchHit{ID: uuid.New(), HourlyRate: 30}
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{"not base64", "%%%", "invalid search: invalid cursor"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("page 2")), "invalid search: invalid cursor"},
+		{"issued for another sort", encodeJobSearchCursor(database.JobSearchSortPay, hit), "invalid search: cursor was issued for another sort"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeJobSearchCursor(tt.value, database.JobSearchSortDistance)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
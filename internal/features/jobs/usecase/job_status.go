@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	job_assignment_models "github.com/yakka-backend/internal/features/job_assignments/models"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/models"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+)
+
+// UpdateJobStatus moves a job owned by the builder to IN_PROGRESS, COMPLETED or CANCELLED
+func (u *jobUsecase) UpdateJobStatus(ctx context.Context, jobID uuid.UUID, builderProfileID uuid.UUID, req payload.UpdateJobStatusRequest) (*payload.UpdateJobStatusResponse, error) {
+	job, err := u.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("job not found")
+	}
+
+	// Verify that the job belongs to the builder
+	if job.BuilderProfileID != builderProfileID {
+		return nil, fmt.Errorf("invalid job - not owned by builder")
+	}
+
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		// Re-read the job under the same lock offers and hires take, so the transition is
+		// checked against the status they may have just changed
+		locked, err := repos.Jobs.GetByIDForUpdate(ctx, jobID)
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+		job = locked
+		return transitionJob(ctx, repos, job, req.Status)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &payload.UpdateJobStatusResponse{
+		Job:     u.convertToJobResponse(ctx, job),
+		Message: "Job status updated successfully",
+	}, nil
+}
+
+// transitionJob moves a job to the next status when the lifecycle allows it. Completing or
+// cancelling a job ends the assignments that are still active on it.
+func transitionJob(ctx context.Context, repos database.JobTxRepositories, job *models.Job, next models.JobStatus) error {
+	if !job.Status.CanTransitionTo(next) {
+		return fmt.Errorf("invalid status transition from %s to %s", job.Status, next)
+	}
+
+	if err := repos.Jobs.UpdateStatus(ctx, job.ID, next); err != nil {
+		return fmt.Errorf("failed to update job status: %w", err)
+	}
+	log.Printf("🔄 Job %s moved from %s to %s", job.ID, job.Status, next)
+	job.Status = next
+	job.UpdatedAt = time.Now()
+
+	var assignmentStatus job_assignment_models.AssignmentStatus
+	switch next {
+	case models.JobStatusCompleted:
+		assignmentStatus = job_assignment_models.AssignmentStatusCompleted
+	case models.JobStatusCancelled:
+		assignmentStatus = job_assignment_models.AssignmentStatusCancelled
+	default:
+		return nil
+	}
+
+	activeStatus := job_assignment_models.AssignmentStatusActive
+	assignments, _, err := repos.Assignments.GetWithFilters(ctx, &job.ID, nil, nil, &activeStatus, 1, 1000)
+	if err != nil {
+		return fmt.Errorf("failed to get job assignments: %w", err)
+	}
+	for _, assignment := range assignments {
+		if err := repos.Assignments.UpdateStatus(ctx, assignment.ID, assignmentStatus); err != nil {
+			return fmt.Errorf("failed to update job assignment status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// syncJobCapacity moves a job between OPEN and FILLED as its active assignments reach or
+// drop below ManyLabours. Jobs in any other status are left alone.
+func syncJobCapacity(ctx context.Context, repos database.JobTxRepositories, job *models.Job) error {
+	if job.Status != models.JobStatusOpen && job.Status != models.JobStatusFilled {
+		return nil
+	}
+
+	active, err := countActiveAssignments(ctx, repos, job)
+	if err != nil {
+		return err
+	}
+
+	next := models.JobStatusOpen
+	if active >= int64(job.ManyLabours) {
+		next = models.JobStatusFilled
+	}
+	if next == job.Status {
+		return nil
+	}
+	return transitionJob(ctx, repos, job, next)
+}
+
+// ExpireOverdueJobs moves open jobs whose end date has passed to EXPIRED
+func (u *jobUsecase) ExpireOverdueJobs(ctx context.Context) (int64, error) {
+	expired, err := u.jobRepo.ExpireOverdue(ctx, startOfDay(time.Now()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire jobs: %w", err)
+	}
+	if expired > 0 {
+		log.Printf("⌛ Expired %d open jobs past their end date", expired)
+	}
+	return expired, nil
+}
+
+// isOverdue reports whether an open job's last work day is already over
+func isOverdue(job *models.Job, now time.Time) bool {
+	return job.Status == models.JobStatusOpen && job.EndDateWork != nil && job.EndDateWork.Before(startOfDay(now))
+}
+
+// startOfDay truncates a time to midnight in its location
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -74,6 +74,20 @@ func (v *JobValidationService) ValidateCreateJobRequest(ctx context.Context, req
 		}
 	}
 
+	// Validate preferred licenses exist and are not also required
+	required := make(map[uuid.UUID]bool, len(req.LicenseIDs))
+	for _, licenseID := range req.LicenseIDs {
+		required[licenseID] = true
+	}
+	for _, licenseID := range req.PreferredLicenseIDs {
+		if required[licenseID] {
+			return fmt.Errorf("license ID %s cannot be both required and preferred", licenseID)
+		}
+		if err := v.validateLicense(ctx, licenseID); err != nil {
+			return fmt.Errorf("invalid license ID %s: %w", licenseID, err)
+		}
+	}
+
 	// Validate skill categories exist
 	for _, skillCategoryID := range req.SkillCategoryIDs {
 		if err := v.validateSkillCategory(ctx, skillCategoryID); err != nil {
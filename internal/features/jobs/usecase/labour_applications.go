@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	job_application_models "github.com/yakka-backend/internal/features/job_applications/models"
+	job_application_usecase "github.com/yakka-backend/internal/features/job_applications/usecase"
+	job_assignment_models "github.com/yakka-backend/internal/features/job_assignments/models"
+	"github.com/yakka-backend/internal/features/jobs/entity/database"
+	"github.com/yakka-backend/internal/features/jobs/payload"
+	"gorm.io/gorm"
+)
+
+// GetLabourApplication retrieves one of the labour user's applications with its job and timeline
+func (u *jobUsecase) GetLabourApplication(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID) (*payload.LabourApplicationInfo, error) {
+	application, err := u.getLabourApplication(ctx, applicationID, labourUserID)
+	if err != nil {
+		return nil, err
+	}
+	return u.labourApplicationView(ctx, application)
+}
+
+// UpdateLabourApplication edits the cover letter, expected rate and resume of an application.
+// Only applications the builder has not acted on yet can be edited.
+func (u *jobUsecase) UpdateLabourApplication(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.UpdateLabourApplicationRequest) (*payload.LabourApplicationInfo, error) {
+	application, err := u.getLabourApplication(ctx, applicationID, labourUserID)
+	if err != nil {
+		return nil, err
+	}
+	if application.Status != job_application_models.ApplicationStatusApplied {
+		return nil, fmt.Errorf("application can no longer be edited")
+	}
+
+	if req.CoverLetter != nil {
+		application.CoverLetter = req.CoverLetter
+	}
+	if req.ExpectedRate != nil {
+		application.ExpectedRate = req.ExpectedRate
+	}
+	if req.ResumeURL != nil {
+		application.ResumeURL = req.ResumeURL
+	}
+
+	// The builder may have acted on the application since it was read
+	updated, err := u.jobApplicationRepo.UpdateDetails(ctx, application)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update application: %w", err)
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("application can no longer be edited")
+	}
+
+	return u.labourApplicationView(ctx, application)
+}
+
+// WithdrawLabourApplication withdraws one of the labour user's applications. A withdrawn
+// application frees the labourer to apply to the job again, and withdrawing an accepted
+// application frees its place on the job.
+func (u *jobUsecase) WithdrawLabourApplication(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID, req payload.WithdrawLabourApplicationRequest) (*payload.LabourApplicationInfo, error) {
+	application, err := u.getLabourApplication(ctx, applicationID, labourUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(repos database.JobTxRepositories) error {
+		return withdrawLabourApplication(ctx, repos, application, labourUserID, req.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.labourApplicationView(ctx, application)
+}
+
+// getLabourApplication retrieves an application of the labour user; applications of other
+// users are reported as not found
+func (u *jobUsecase) getLabourApplication(ctx context.Context, applicationID uuid.UUID, labourUserID uuid.UUID) (*job_application_models.JobApplication, error) {
+	application, err := u.jobApplicationRepo.GetByID(ctx, applicationID)
+	if err != nil || application.LabourUserID != labourUserID {
+		return nil, fmt.Errorf("application not found")
+	}
+	return application, nil
+}
+
+// labourApplicationView loads the job and timeline of an application for the labourer
+func (u *jobUsecase) labourApplicationView(ctx context.Context, application *job_application_models.JobApplication) (*payload.LabourApplicationInfo, error) {
+	job, err := u.jobRepo.GetByID(ctx, application.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	timelines, err := u.loadApplicationTimelines(ctx, []*job_application_models.JobApplication{application})
+	if err != nil {
+		return nil, err
+	}
+	info := u.buildLabourApplicationInfo(ctx, application, job, timelines[application.ID])
+	return &info, nil
+}
+
+// withdrawLabourApplication withdraws an application on behalf of its labourer and clears any
+// job changes waiting for confirmation. Withdrawing an accepted application cancels the active
+// assignment, which can reopen a filled job.
+func withdrawLabourApplication(ctx context.Context, repos database.JobTxRepositories, application *job_application_models.JobApplication, labourUserID uuid.UUID, reason *string) error {
+	// Lock the job first, like acceptances and bulk decisions do, so the freed place is
+	// counted against the job's current assignments
+	job, err := repos.Jobs.GetByIDForUpdate(ctx, application.JobID)
+	if err != nil {
+		return fmt.Errorf("failed to get job: %w", err)
+	}
+
+	wasAccepted := application.Status == job_application_models.ApplicationStatusAccepted
+	if err := job_application_usecase.TransitionApplication(ctx, repos.Applications, repos.ApplicationEvents, application, job_application_models.ApplicationStatusWithdrawn, job_application_models.ApplicationActorLabour, &labourUserID, reason); err != nil {
+		return err
+	}
+	if application.JobChangedAt != nil {
+		if err := repos.Applications.ClearJobChanged(ctx, application.ID); err != nil {
+			return fmt.Errorf("failed to clear job changes: %w", err)
+		}
+		application.JobChangedAt = nil
+	}
+	if !wasAccepted {
+		return nil
+	}
+
+	assignment, err := repos.Assignments.GetByApplicationID(ctx, application.ID)
+	if err == gorm.ErrRecordNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get assignment: %w", err)
+	}
+	if assignment.Status != job_assignment_models.AssignmentStatusActive {
+		return nil
+	}
+	if err := repos.Assignments.UpdateStatus(ctx, assignment.ID, job_assignment_models.AssignmentStatusCancelled); err != nil {
+		return fmt.Errorf("failed to cancel assignment: %w", err)
+	}
+
+	// The freed slot can reopen a filled job
+	return syncJobCapacity(ctx, repos, job)
+}
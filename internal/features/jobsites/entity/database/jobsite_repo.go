@@ -11,6 +11,7 @@ import (
 type JobsiteRepository interface {
 	Create(ctx context.Context, jobsite *models.Jobsite) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Jobsite, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Jobsite, error)
 	GetByBuilderID(ctx context.Context, builderID uuid.UUID) ([]*models.Jobsite, error)
 	Update(ctx context.Context, jobsite *models.Jobsite) error
 	Delete(ctx context.Context, id uuid.UUID) error
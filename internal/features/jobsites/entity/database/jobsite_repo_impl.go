@@ -33,6 +33,19 @@ func (r *JobsiteRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*mod
 	return &jobsite, nil
 }
 
+// GetByIDs retrieves jobsites by ID, in no particular order
+func (r *JobsiteRepositoryImpl) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Jobsite, error) {
+	var jobsites []*models.Jobsite
+	if len(ids) == 0 {
+		return jobsites, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&jobsites).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobsites, nil
+}
+
 // GetByBuilderID retrieves all jobsites for a specific builder
 func (r *JobsiteRepositoryImpl) GetByBuilderID(ctx context.Context, builderID uuid.UUID) ([]*models.Jobsite, error) {
 	var jobsites []*models.Jobsite
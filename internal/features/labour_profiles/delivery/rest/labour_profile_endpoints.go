@@ -81,6 +81,9 @@ func (h *LabourProfileHandler) CreateLabourProfile(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// The user now has a new role and profile
+	middleware.InvalidatePrincipal(userID)
+
 	// Convert to response
 	profileResp := payload.LabourProfileResponse{
 		ID:        profile.ID.String(),
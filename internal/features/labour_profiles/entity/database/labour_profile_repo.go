@@ -12,6 +12,7 @@ type LabourProfileRepository interface {
 	Create(ctx context.Context, profile *models.LabourProfile) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.LabourProfile, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*models.LabourProfile, error)
+	GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.LabourProfile, error)
 	Update(ctx context.Context, profile *models.LabourProfile) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
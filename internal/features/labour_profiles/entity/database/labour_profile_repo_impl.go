@@ -45,6 +45,19 @@ func (r *labourProfileRepository) GetByUserID(ctx context.Context, userID uuid.U
 	return &profile, nil
 }
 
+// GetByUserIDs retrieves the labour profiles of a set of users
+func (r *labourProfileRepository) GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*models.LabourProfile, error) {
+	var profiles []*models.LabourProfile
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+	err := r.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
 // Update updates a labour profile
 func (r *labourProfileRepository) Update(ctx context.Context, profile *models.LabourProfile) error {
 	return r.db.WithContext(ctx).Save(profile).Error
@@ -12,6 +12,7 @@ type LabourProfileSkillRepository interface {
 	Create(ctx context.Context, skill *models.LabourProfileSkill) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.LabourProfileSkill, error)
 	GetByLabourProfileID(ctx context.Context, labourProfileID uuid.UUID) ([]*models.LabourProfileSkill, error)
+	GetByLabourProfileIDs(ctx context.Context, labourProfileIDs []uuid.UUID) ([]*models.LabourProfileSkill, error)
 	Update(ctx context.Context, skill *models.LabourProfileSkill) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	DeleteByLabourProfileID(ctx context.Context, labourProfileID uuid.UUID) error
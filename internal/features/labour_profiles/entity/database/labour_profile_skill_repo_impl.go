@@ -39,6 +39,18 @@ func (r *labourProfileSkillRepository) GetByLabourProfileID(ctx context.Context,
 	return skills, nil
 }
 
+func (r *labourProfileSkillRepository) GetByLabourProfileIDs(ctx context.Context, labourProfileIDs []uuid.UUID) ([]*models.LabourProfileSkill, error) {
+	var skills []*models.LabourProfileSkill
+	if len(labourProfileIDs) == 0 {
+		return skills, nil
+	}
+	err := r.db.WithContext(ctx).Where("labour_profile_id IN ?", labourProfileIDs).Find(&skills).Error
+	if err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
 func (r *labourProfileSkillRepository) Update(ctx context.Context, skill *models.LabourProfileSkill) error {
 	return r.db.WithContext(ctx).Save(skill).Error
 }
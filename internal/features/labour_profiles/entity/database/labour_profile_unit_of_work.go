@@ -0,0 +1,30 @@
+package database
+
+import (
+	authUserRepo "github.com/yakka-backend/internal/features/auth/user/entity/database"
+	dbInfra "github.com/yakka-backend/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// LabourProfileTxRepositories are the repositories written when a labour profile is created
+type LabourProfileTxRepositories struct {
+	Profiles     LabourProfileRepository
+	Skills       LabourProfileSkillRepository
+	UserLicenses authUserRepo.UserLicenseRepository
+	Users        authUserRepo.UserRepository
+}
+
+// LabourProfileUnitOfWork runs labour profile writes in a single transaction
+type LabourProfileUnitOfWork = dbInfra.UnitOfWork[LabourProfileTxRepositories]
+
+// NewLabourProfileUnitOfWork creates a unit of work for the labour profile repositories
+func NewLabourProfileUnitOfWork(db *gorm.DB) LabourProfileUnitOfWork {
+	return dbInfra.NewUnitOfWork(db, func(tx *gorm.DB) LabourProfileTxRepositories {
+		return LabourProfileTxRepositories{
+			Profiles:     NewLabourProfileRepository(tx),
+			Skills:       NewLabourProfileSkillRepository(tx),
+			UserLicenses: authUserRepo.NewUserLicenseRepository(tx),
+			Users:        authUserRepo.NewUserRepository(tx),
+		}
+	})
+}
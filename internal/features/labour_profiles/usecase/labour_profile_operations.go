@@ -32,9 +32,10 @@ type labourProfileUsecase struct {
 	skillCategoryRepo    skillRepo.SkillCategoryRepository
 	skillSubcategoryRepo skillRepo.SkillSubcategoryRepository
 	experienceRepo       experienceRepo.ExperienceLevelRepository
+	uow                  database.LabourProfileUnitOfWork
 }
 
-func NewLabourProfileUsecase(labourRepo database.LabourProfileRepository, labourSkillRepo database.LabourProfileSkillRepository, userLicenseRepo authUserRepo.UserLicenseRepository, userRepo authUserRepo.UserRepository, licenseRepo licenseRepo.LicenseRepository, skillCategoryRepo skillRepo.SkillCategoryRepository, skillSubcategoryRepo skillRepo.SkillSubcategoryRepository, experienceRepo experienceRepo.ExperienceLevelRepository) LabourProfileUsecase {
+func NewLabourProfileUsecase(labourRepo database.LabourProfileRepository, labourSkillRepo database.LabourProfileSkillRepository, userLicenseRepo authUserRepo.UserLicenseRepository, userRepo authUserRepo.UserRepository, licenseRepo licenseRepo.LicenseRepository, skillCategoryRepo skillRepo.SkillCategoryRepository, skillSubcategoryRepo skillRepo.SkillSubcategoryRepository, experienceRepo experienceRepo.ExperienceLevelRepository, uow database.LabourProfileUnitOfWork) LabourProfileUsecase {
 	return &labourProfileUsecase{
 		labourRepo:           labourRepo,
 		labourSkillRepo:      labourSkillRepo,
@@ -44,6 +45,7 @@ func NewLabourProfileUsecase(labourRepo database.LabourProfileRepository, labour
 		skillCategoryRepo:    skillCategoryRepo,
 		skillSubcategoryRepo: skillSubcategoryRepo,
 		experienceRepo:       experienceRepo,
+		uow:                  uow,
 	}
 }
 
@@ -88,156 +90,157 @@ func (u *labourProfileUsecase) CreateProfile(ctx context.Context, userID uuid.UU
 		UpdatedAt: time.Now(),
 	}
 
-	// Create profile in database
-	if err := u.labourRepo.Create(ctx, profile); err != nil {
-		return nil, err
-	}
+	// The profile, the role change and the skills/licenses are stored together or not at all
+	err = u.uow.Do(ctx, func(repos database.LabourProfileTxRepositories) error {
+		// Create profile in database
+		if err := repos.Profiles.Create(ctx, profile); err != nil {
+			return err
+		}
 
-	// Update user role to labour
-	user.Role = authUserModels.UserRoleLabour
-	user.RoleChangedAt = &time.Time{}
-	*user.RoleChangedAt = time.Now()
+		// Update user role to labour
+		user.Role = authUserModels.UserRoleLabour
+		user.RoleChangedAt = &time.Time{}
+		*user.RoleChangedAt = time.Now()
 
-	// Update user fields that are now in the user table
-	user.Photo = req.AvatarURL
+		// Update user fields that are now in the user table
+		user.Photo = req.AvatarURL
 
-	// Update user phone if provided
-	if req.Phone != nil {
-		user.Phone = req.Phone
-	}
+		// Update user phone if provided, a new number needs verifying again
+		phoneChanged := req.Phone != nil && user.SetPhone(req.Phone)
 
-	// Use Updates instead of Save to avoid overwriting existing fields
-	updates := map[string]interface{}{
-		"role":            user.Role,
-		"role_changed_at": user.RoleChangedAt,
-		"photo":           user.Photo,
-		"first_name":      req.FirstName,
-		"last_name":       req.LastName,
-	}
+		// Use Updates instead of Save to avoid overwriting existing fields
+		updates := map[string]interface{}{
+			"role":            user.Role,
+			"role_changed_at": user.RoleChangedAt,
+			"photo":           user.Photo,
+			"first_name":      req.FirstName,
+			"last_name":       req.LastName,
+		}
 
-	// Only update phone if provided
-	if req.Phone != nil {
-		updates["phone"] = user.Phone
-	}
+		// Only update phone if it changed
+		if phoneChanged {
+			updates["phone"] = user.Phone
+			updates["phone_verified_at"] = nil
+		}
 
-	if err := u.userRepo.UpdateSpecificFields(ctx, user.ID, updates); err != nil {
-		return nil, err
-	}
+		if err := repos.Users.UpdateSpecificFields(ctx, user.ID, updates); err != nil {
+			return err
+		}
 
-	// Create skills if provided
-	if len(req.Skills) > 0 {
-		var skills []*labourModels.LabourProfileSkill
-		for _, skillReq := range req.Skills {
-			categoryID, err := uuid.Parse(skillReq.CategoryID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid category_id: %w", err)
-			}
+		// Create skills if provided
+		if len(req.Skills) > 0 {
+			var skills []*labourModels.LabourProfileSkill
+			for _, skillReq := range req.Skills {
+				categoryID, err := uuid.Parse(skillReq.CategoryID)
+				if err != nil {
+					return fmt.Errorf("invalid category_id: %w", err)
+				}
 
-			subcategoryID, err := uuid.Parse(skillReq.SubcategoryID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid subcategory_id: %w", err)
-			}
+				subcategoryID, err := uuid.Parse(skillReq.SubcategoryID)
+				if err != nil {
+					return fmt.Errorf("invalid subcategory_id: %w", err)
+				}
 
-			experienceLevelID, err := uuid.Parse(skillReq.ExperienceLevelID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid experience_level_id: %w", err)
-			}
+				experienceLevelID, err := uuid.Parse(skillReq.ExperienceLevelID)
+				if err != nil {
+					return fmt.Errorf("invalid experience_level_id: %w", err)
+				}
 
-			// Validate that category exists
-			_, err = u.skillCategoryRepo.GetByID(ctx, categoryID)
-			if err != nil {
-				if err == gorm.ErrRecordNotFound {
-					return nil, fmt.Errorf("skill category not found")
+				// Validate that category exists
+				_, err = u.skillCategoryRepo.GetByID(ctx, categoryID)
+				if err != nil {
+					if err == gorm.ErrRecordNotFound {
+						return fmt.Errorf("skill category not found")
+					}
+					return fmt.Errorf("failed to validate skill category: %w", err)
 				}
-				return nil, fmt.Errorf("failed to validate skill category: %w", err)
-			}
 
-			// Validate that subcategory exists
-			_, err = u.skillSubcategoryRepo.GetByID(ctx, subcategoryID)
-			if err != nil {
-				if err == gorm.ErrRecordNotFound {
-					return nil, fmt.Errorf("skill subcategory not found")
+				// Validate that subcategory exists
+				_, err = u.skillSubcategoryRepo.GetByID(ctx, subcategoryID)
+				if err != nil {
+					if err == gorm.ErrRecordNotFound {
+						return fmt.Errorf("skill subcategory not found")
+					}
+					return fmt.Errorf("failed to validate skill subcategory: %w", err)
 				}
-				return nil, fmt.Errorf("failed to validate skill subcategory: %w", err)
-			}
 
-			// Validate that experience level exists
-			_, err = u.experienceRepo.GetByID(ctx, experienceLevelID)
-			if err != nil {
-				if err == gorm.ErrRecordNotFound {
-					return nil, fmt.Errorf("experience level not found")
+				// Validate that experience level exists
+				_, err = u.experienceRepo.GetByID(ctx, experienceLevelID)
+				if err != nil {
+					if err == gorm.ErrRecordNotFound {
+						return fmt.Errorf("experience level not found")
+					}
+					return fmt.Errorf("failed to validate experience level: %w", err)
 				}
-				return nil, fmt.Errorf("failed to validate experience level: %w", err)
-			}
 
-			skill := &labourModels.LabourProfileSkill{
-				LabourProfileID:   profile.ID,
-				CategoryID:        categoryID,
-				SubcategoryID:     subcategoryID,
-				ExperienceLevelID: experienceLevelID,
-				YearsExperience:   skillReq.YearsExperience,
-				IsPrimary:         skillReq.IsPrimary,
-				CreatedAt:         time.Now(),
-				UpdatedAt:         time.Now(),
+				skill := &labourModels.LabourProfileSkill{
+					LabourProfileID:   profile.ID,
+					CategoryID:        categoryID,
+					SubcategoryID:     subcategoryID,
+					ExperienceLevelID: experienceLevelID,
+					YearsExperience:   skillReq.YearsExperience,
+					IsPrimary:         skillReq.IsPrimary,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+				skills = append(skills, skill)
 			}
-			skills = append(skills, skill)
-		}
 
-		if err := u.labourSkillRepo.CreateBatch(ctx, skills); err != nil {
-			// If skills creation fails, we should rollback the profile creation
-			// For now, we'll log the error but not fail the entire operation
-			// In a production system, you might want to use database transactions
-			fmt.Printf("Warning: Failed to create skills for profile %s: %v\n", profile.ID, err)
+			if err := repos.Skills.CreateBatch(ctx, skills); err != nil {
+				return fmt.Errorf("failed to create skills: %w", err)
+			}
 		}
-	}
 
-	// Create licenses if provided
-	if len(req.Licenses) > 0 {
-		var licenses []*authUserModels.UserLicense
-		for _, licenseReq := range req.Licenses {
-			licenseID, err := uuid.Parse(licenseReq.LicenseID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid license_id: %w", err)
-			}
+		// Create licenses if provided
+		if len(req.Licenses) > 0 {
+			var licenses []*authUserModels.UserLicense
+			for _, licenseReq := range req.Licenses {
+				licenseID, err := uuid.Parse(licenseReq.LicenseID)
+				if err != nil {
+					return fmt.Errorf("invalid license_id: %w", err)
+				}
 
-			// Validate that license exists
-			_, err = u.licenseRepo.GetByID(ctx, licenseID)
-			if err != nil {
-				if err == gorm.ErrRecordNotFound {
-					return nil, fmt.Errorf("license not found")
+				// Validate that license exists
+				_, err = u.licenseRepo.GetByID(ctx, licenseID)
+				if err != nil {
+					if err == gorm.ErrRecordNotFound {
+						return fmt.Errorf("license not found")
+					}
+					return fmt.Errorf("failed to validate license: %w", err)
 				}
-				return nil, fmt.Errorf("failed to validate license: %w", err)
-			}
 
-			license := &authUserModels.UserLicense{
-				UserID:    userID,
-				LicenseID: licenseID,
-				PhotoURL:  licenseReq.PhotoURL,
-				CreatedAt: time.Now(),
-				UpdatedAt: time.Now(),
-			}
+				license := &authUserModels.UserLicense{
+					UserID:    userID,
+					LicenseID: licenseID,
+					PhotoURL:  licenseReq.PhotoURL,
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				}
 
-			// Parse dates if provided
-			if licenseReq.IssuedAt != nil {
-				if issuedAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.IssuedAt); err == nil {
-					license.IssuedAt = &issuedAt
+				// Parse dates if provided
+				if licenseReq.IssuedAt != nil {
+					if issuedAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.IssuedAt); err == nil {
+						license.IssuedAt = &issuedAt
+					}
 				}
-			}
-			if licenseReq.ExpiresAt != nil {
-				if expiresAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.ExpiresAt); err == nil {
-					license.ExpiresAt = &expiresAt
+				if licenseReq.ExpiresAt != nil {
+					if expiresAt, err := time.Parse("2006-01-02T15:04:05Z07:00", *licenseReq.ExpiresAt); err == nil {
+						license.ExpiresAt = &expiresAt
+					}
 				}
+
+				licenses = append(licenses, license)
 			}
 
-			licenses = append(licenses, license)
+			if err := repos.UserLicenses.CreateBatch(ctx, licenses); err != nil {
+				return fmt.Errorf("failed to create licenses: %w", err)
+			}
 		}
 
-		if err := u.userLicenseRepo.CreateBatch(ctx, licenses); err != nil {
-			// If licenses creation fails, we should rollback the profile creation
-			// For now, we'll log the error but not fail the entire operation
-			// In a production system, you might want to use database transactions
-			fmt.Printf("Warning: Failed to create licenses for user %s: %v\n", userID, err)
-		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return profile, nil
@@ -12,4 +12,5 @@ type JobRequirementRepository interface {
 	GetAll(ctx context.Context) ([]*models.JobRequirement, error)
 	GetActive(ctx context.Context) ([]*models.JobRequirement, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.JobRequirement, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.JobRequirement, error)
 }
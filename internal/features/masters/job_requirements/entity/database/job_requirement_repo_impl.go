@@ -49,3 +49,16 @@ func (r *jobRequirementRepository) GetByID(ctx context.Context, id uuid.UUID) (*
 	}
 	return &requirement, nil
 }
+
+// GetByIDs retrieves job requirements by ID, in no particular order
+func (r *jobRequirementRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.JobRequirement, error) {
+	var requirements []*models.JobRequirement
+	if len(ids) == 0 {
+		return requirements, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&requirements).Error
+	if err != nil {
+		return nil, err
+	}
+	return requirements, nil
+}
@@ -12,4 +12,5 @@ type JobTypeRepository interface {
 	GetAll(ctx context.Context) ([]*models.JobType, error)
 	GetActive(ctx context.Context) ([]*models.JobType, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.JobType, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.JobType, error)
 }
@@ -49,3 +49,16 @@ func (r *jobTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.
 	}
 	return &jobType, nil
 }
+
+// GetByIDs retrieves job types by ID, in no particular order
+func (r *jobTypeRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.JobType, error) {
+	var jobTypes []*models.JobType
+	if len(ids) == 0 {
+		return jobTypes, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&jobTypes).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobTypes, nil
+}
@@ -10,6 +10,7 @@ import (
 type LicenseRepository interface {
 	Create(ctx context.Context, license *models.License) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.License, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.License, error)
 	GetAll(ctx context.Context) ([]*models.License, error)
 	Update(ctx context.Context, license *models.License) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -29,6 +29,15 @@ func (r *licenseRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.
 	return &license, nil
 }
 
+func (r *licenseRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.License, error) {
+	var licenses []*models.License
+	if len(ids) == 0 {
+		return licenses, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&licenses).Error
+	return licenses, err
+}
+
 func (r *licenseRepository) GetAll(ctx context.Context) ([]*models.License, error) {
 	var licenses []*models.License
 	err := r.db.WithContext(ctx).Find(&licenses).Error
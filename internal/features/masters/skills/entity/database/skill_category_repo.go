@@ -10,6 +10,7 @@ import (
 type SkillCategoryRepository interface {
 	Create(ctx context.Context, category *models.SkillCategory) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.SkillCategory, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.SkillCategory, error)
 	GetAll(ctx context.Context) ([]*models.SkillCategory, error)
 	Update(ctx context.Context, category *models.SkillCategory) error
 	Delete(ctx context.Context, id uuid.UUID) error
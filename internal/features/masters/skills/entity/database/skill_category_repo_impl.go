@@ -29,6 +29,15 @@ func (r *skillCategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*m
 	return &category, nil
 }
 
+func (r *skillCategoryRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.SkillCategory, error) {
+	var categories []*models.SkillCategory
+	if len(ids) == 0 {
+		return categories, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&categories).Error
+	return categories, err
+}
+
 func (r *skillCategoryRepository) GetAll(ctx context.Context) ([]*models.SkillCategory, error) {
 	var categories []*models.SkillCategory
 	err := r.db.WithContext(ctx).Find(&categories).Error
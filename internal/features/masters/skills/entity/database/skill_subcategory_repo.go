@@ -10,6 +10,7 @@ import (
 type SkillSubcategoryRepository interface {
 	Create(ctx context.Context, subcategory *models.SkillSubcategory) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.SkillSubcategory, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.SkillSubcategory, error)
 	GetAll(ctx context.Context) ([]*models.SkillSubcategory, error)
 	GetByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]*models.SkillSubcategory, error)
 	Update(ctx context.Context, subcategory *models.SkillSubcategory) error
@@ -29,6 +29,15 @@ func (r *skillSubcategoryRepository) GetByID(ctx context.Context, id uuid.UUID)
 	return &subcategory, nil
 }
 
+func (r *skillSubcategoryRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.SkillSubcategory, error) {
+	var subcategories []*models.SkillSubcategory
+	if len(ids) == 0 {
+		return subcategories, nil
+	}
+	err := r.db.WithContext(ctx).Preload("Category").Where("id IN ?", ids).Find(&subcategories).Error
+	return subcategories, err
+}
+
 func (r *skillSubcategoryRepository) GetAll(ctx context.Context) ([]*models.SkillSubcategory, error) {
 	var subcategories []*models.SkillSubcategory
 	err := r.db.WithContext(ctx).Preload("Category").Find(&subcategories).Error
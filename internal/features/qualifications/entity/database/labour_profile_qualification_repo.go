@@ -12,6 +12,7 @@ type LabourProfileQualificationRepository interface {
 	Create(ctx context.Context, profileQualification *models.LabourProfileQualification) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.LabourProfileQualification, error)
 	GetByLabourProfileID(ctx context.Context, labourProfileID uuid.UUID) ([]*models.LabourProfileQualification, error)
+	GetByLabourProfileIDs(ctx context.Context, labourProfileIDs []uuid.UUID) ([]*models.LabourProfileQualification, error)
 	GetByQualificationID(ctx context.Context, qualificationID uuid.UUID) ([]*models.LabourProfileQualification, error)
 	GetByLabourProfileAndQualification(ctx context.Context, labourProfileID, qualificationID uuid.UUID) (*models.LabourProfileQualification, error)
 	Update(ctx context.Context, profileQualification *models.LabourProfileQualification) error
@@ -50,6 +51,18 @@ func (r *labourProfileQualificationRepository) GetByLabourProfileID(ctx context.
 	return profileQualifications, nil
 }
 
+func (r *labourProfileQualificationRepository) GetByLabourProfileIDs(ctx context.Context, labourProfileIDs []uuid.UUID) ([]*models.LabourProfileQualification, error) {
+	var profileQualifications []*models.LabourProfileQualification
+	if len(labourProfileIDs) == 0 {
+		return profileQualifications, nil
+	}
+	err := r.db.WithContext(ctx).Preload("Qualification").Where("labour_profile_id IN ?", labourProfileIDs).Find(&profileQualifications).Error
+	if err != nil {
+		return nil, err
+	}
+	return profileQualifications, nil
+}
+
 func (r *labourProfileQualificationRepository) GetByQualificationID(ctx context.Context, qualificationID uuid.UUID) ([]*models.LabourProfileQualification, error) {
 	var profileQualifications []*models.LabourProfileQualification
 	err := r.db.WithContext(ctx).Preload("Qualification.Sport").Where("qualification_id = ?", qualificationID).Find(&profileQualifications).Error
@@ -4,15 +4,24 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 // Config holds all configuration for our application
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	Logging  LoggingConfig
+	Database    DatabaseConfig
+	Server      ServerConfig
+	Logging     LoggingConfig
+	Mail        MailConfig
+	SMS         SMSConfig
+	JWT         JWTConfig
+	Login       LoginThrottleConfig
+	MFA         MFAConfig
+	OIDC        OIDCConfig
+	Eligibility EligibilityConfig
 }
 
 // DatabaseConfig holds database configuration
@@ -27,8 +36,9 @@ type DatabaseConfig struct {
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	Port        string
-	Environment string
+	Port           string
+	Environment    string
+	TrustedProxies []string // IPs or CIDRs of reverse proxies whose forwarding headers are trusted
 }
 
 // LoggingConfig holds logging configuration
@@ -36,6 +46,71 @@ type LoggingConfig struct {
 	Level string
 }
 
+// MailConfig holds outgoing email configuration
+type MailConfig struct {
+	Driver       string // "smtp" or "file"
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUser     string
+	SMTPPassword string
+	OutboxDir    string
+	AppBaseURL   string // used to build links inside emails
+}
+
+// SMSConfig holds outgoing text message configuration
+type SMSConfig struct {
+	Driver    string // "log" or "file"
+	OutboxDir string
+}
+
+// JWTConfig holds access token signing configuration
+type JWTConfig struct {
+	PrivateKeyFile   string // PEM encoded RSA or Ed25519 private key used for signing
+	KeyID            string // kid of the signing key, derived from the key when empty
+	VerificationKeys string // extra public keys still accepted, as "kid=path.pem,kid2=path2.pem"
+	Issuer           string
+}
+
+// LoginThrottleConfig holds the brute-force protection limits for login
+type LoginThrottleConfig struct {
+	FailureWindow   time.Duration // failures older than this are forgotten
+	BackoffAfter    int           // account failures before backoff starts
+	BackoffBase     time.Duration // first backoff delay, doubled on every further failure
+	BackoffMax      time.Duration
+	LockoutAfter    int // account failures that lock the account
+	LockoutDuration time.Duration
+	IPBackoffAfter  int // failures from one IP before backoff starts
+	IPMaxFailures   int // failures from one IP that block it for the whole window
+}
+
+// MFAConfig holds two-factor authentication configuration
+type MFAConfig struct {
+	Issuer        string        // shown by authenticator apps next to the account
+	EncryptionKey string        // base64 encoded 32 byte key used to encrypt TOTP secrets at rest
+	ChallengeTTL  time.Duration // lifetime of the login challenge issued after the password step
+}
+
+// OIDCConfig holds the OpenID Connect providers accepted for social login
+type OIDCConfig struct {
+	Providers []OIDCProviderConfig
+}
+
+// OIDCProviderConfig holds the settings of one OpenID Connect provider
+type OIDCProviderConfig struct {
+	Name      string   // path segment of the login endpoint, e.g. "google"
+	Issuers   []string // accepted iss values
+	ClientIDs []string // accepted aud values, one per web/iOS/Android client
+	JWKSURL   string
+}
+
+// EligibilityConfig holds what a labourer must meet before applying to a job
+type EligibilityConfig struct {
+	RequireLicenses              bool // every hard-required license of the job must be held and not expired
+	RequireRequirements          bool // every active requirement of the job must be met
+	AllowRequirementConfirmation bool // a requirement can be met by the applicant confirming it, not only by a matching qualification
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Determine which environment file to load
@@ -62,12 +137,69 @@ func Load() (*Config, error) {
 			SSLMode:  getEnv("DB_SSLMODE", ""),
 		},
 		Server: ServerConfig{
-			Port:        getEnv("PORT", ""),
-			Environment: env,
+			Port:           getEnv("PORT", ""),
+			Environment:    env,
+			TrustedProxies: getEnvAsList("TRUSTED_PROXIES", ""),
 		},
 		Logging: LoggingConfig{
 			Level: getEnv("LOG_LEVEL", ""),
 		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "file"),
+			From:         getEnv("MAIL_FROM", "Yakka <no-reply@yakka.com.au>"),
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
+			SMTPUser:     getEnv("SMTP_USER", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			OutboxDir:    getEnv("MAIL_OUTBOX_DIR", "tmp/outbox"),
+			AppBaseURL:   getEnv("APP_BASE_URL", "http://localhost:3000"),
+		},
+		SMS: SMSConfig{
+			Driver:    getEnv("SMS_DRIVER", "log"),
+			OutboxDir: getEnv("SMS_OUTBOX_DIR", "tmp/sms"),
+		},
+		JWT: JWTConfig{
+			PrivateKeyFile:   getEnv("JWT_PRIVATE_KEY_FILE", ""),
+			KeyID:            getEnv("JWT_KEY_ID", ""),
+			VerificationKeys: getEnv("JWT_VERIFICATION_KEYS", ""),
+			Issuer:           getEnv("JWT_ISSUER", "yakka-backend"),
+		},
+		Login: LoginThrottleConfig{
+			FailureWindow:   getEnvAsDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			BackoffAfter:    getEnvAsInt("LOGIN_BACKOFF_AFTER", 3),
+			BackoffBase:     getEnvAsDuration("LOGIN_BACKOFF_BASE", time.Second),
+			BackoffMax:      getEnvAsDuration("LOGIN_BACKOFF_MAX", time.Minute),
+			LockoutAfter:    getEnvAsInt("LOGIN_LOCKOUT_AFTER", 10),
+			LockoutDuration: getEnvAsDuration("LOGIN_LOCKOUT_DURATION", 30*time.Minute),
+			IPBackoffAfter:  getEnvAsInt("LOGIN_IP_BACKOFF_AFTER", 10),
+			IPMaxFailures:   getEnvAsInt("LOGIN_IP_MAX_FAILURES", 50),
+		},
+		MFA: MFAConfig{
+			Issuer:        getEnv("MFA_ISSUER", "Yakka"),
+			EncryptionKey: getEnv("MFA_ENCRYPTION_KEY", ""),
+			ChallengeTTL:  getEnvAsDuration("MFA_CHALLENGE_TTL", 5*time.Minute),
+		},
+		OIDC: OIDCConfig{
+			Providers: oidcProviders(
+				OIDCProviderConfig{
+					Name:      "google",
+					Issuers:   getEnvAsList("OIDC_GOOGLE_ISSUERS", "https://accounts.google.com,accounts.google.com"),
+					ClientIDs: getEnvAsList("OIDC_GOOGLE_CLIENT_IDS", ""),
+					JWKSURL:   getEnv("OIDC_GOOGLE_JWKS_URL", "https://www.googleapis.com/oauth2/v3/certs"),
+				},
+				OIDCProviderConfig{
+					Name:      "apple",
+					Issuers:   getEnvAsList("OIDC_APPLE_ISSUERS", "https://appleid.apple.com"),
+					ClientIDs: getEnvAsList("OIDC_APPLE_CLIENT_IDS", ""),
+					JWKSURL:   getEnv("OIDC_APPLE_JWKS_URL", "https://appleid.apple.com/auth/keys"),
+				},
+			),
+		},
+		Eligibility: EligibilityConfig{
+			RequireLicenses:              getEnvAsBool("ELIGIBILITY_REQUIRE_LICENSES", true),
+			RequireRequirements:          getEnvAsBool("ELIGIBILITY_REQUIRE_REQUIREMENTS", true),
+			AllowRequirementConfirmation: getEnvAsBool("ELIGIBILITY_ALLOW_REQUIREMENT_CONFIRMATION", true),
+		},
 	}
 
 	// Validate required configuration
@@ -96,6 +228,48 @@ func getEnvAsInt(key string, fallback int) int {
 	return fallback
 }
 
+// getEnvAsBool gets an environment variable as boolean (e.g. "true", "0") with a fallback value
+func getEnvAsBool(key string, fallback bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return fallback
+}
+
+// getEnvAsDuration gets an environment variable as duration (e.g. "15m") with a fallback value
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return fallback
+}
+
+// getEnvAsList gets a comma separated environment variable as a list with a fallback value
+func getEnvAsList(key, fallback string) []string {
+	var list []string
+	for _, item := range strings.Split(getEnv(key, fallback), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// oidcProviders keeps the providers that have at least one client ID configured
+func oidcProviders(providers ...OIDCProviderConfig) []OIDCProviderConfig {
+	var enabled []OIDCProviderConfig
+	for _, provider := range providers {
+		if len(provider.ClientIDs) > 0 {
+			enabled = append(enabled, provider)
+		}
+	}
+	return enabled
+}
+
 // validateConfig validates that all required configuration is present
 func validateConfig(config *Config) error {
 	// Validate database configuration
@@ -128,5 +302,48 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("LOG_LEVEL is required")
 	}
 
+	// Validate JWT configuration (development falls back to an ephemeral key)
+	if config.Server.Environment == "production" && config.JWT.PrivateKeyFile == "" {
+		return fmt.Errorf("JWT_PRIVATE_KEY_FILE is required in production")
+	}
+
+	// Validate MFA configuration (development falls back to a fixed key)
+	if config.Server.Environment == "production" && config.MFA.EncryptionKey == "" {
+		return fmt.Errorf("MFA_ENCRYPTION_KEY is required in production")
+	}
+
+	// Validate OIDC configuration
+	for _, provider := range config.OIDC.Providers {
+		if len(provider.Issuers) == 0 || provider.JWKSURL == "" {
+			return fmt.Errorf("OIDC provider %s needs issuers and a JWKS URL", provider.Name)
+		}
+	}
+
+	// Validate login throttle configuration
+	if config.Login.BackoffAfter < 1 || config.Login.LockoutAfter < config.Login.BackoffAfter {
+		return fmt.Errorf("LOGIN_LOCKOUT_AFTER must be greater than or equal to LOGIN_BACKOFF_AFTER (>= 1)")
+	}
+	if config.Login.IPBackoffAfter < 1 || config.Login.IPMaxFailures < config.Login.IPBackoffAfter {
+		return fmt.Errorf("LOGIN_IP_MAX_FAILURES must be greater than or equal to LOGIN_IP_BACKOFF_AFTER (>= 1)")
+	}
+
+	// Validate mail configuration
+	switch config.Mail.Driver {
+	case "smtp":
+		if config.Mail.SMTPHost == "" {
+			return fmt.Errorf("SMTP_HOST is required when MAIL_DRIVER is smtp")
+		}
+	case "file":
+	default:
+		return fmt.Errorf("MAIL_DRIVER must be smtp or file")
+	}
+
+	// Validate SMS configuration
+	switch config.SMS.Driver {
+	case "log", "file":
+	default:
+		return fmt.Errorf("SMS_DRIVER must be log or file")
+	}
+
 	return nil
 }
@@ -0,0 +1,99 @@
+// Package dbtest gives integration tests and benchmarks a Postgres schema of their own.
+//
+// Set TEST_DATABASE_URL to a postgres:// URL to run them; without it they are skipped.
+// Every call creates a fresh schema that is dropped when the test ends.
+package dbtest
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/infrastructure/database"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// EnvURL names the environment variable holding the test database URL
+const EnvURL = "TEST_DATABASE_URL"
+
+// OpenEmpty connects to a new, empty schema
+func OpenEmpty(tb testing.TB) *gorm.DB {
+	tb.Helper()
+
+	raw := os.Getenv(EnvURL)
+	if raw == "" {
+		tb.Skipf("%s is not set", EnvURL)
+	}
+	base, err := url.Parse(raw)
+	if err != nil {
+		tb.Fatalf("parse %s: %v", EnvURL, err)
+	}
+
+	admin := open(tb, base.String())
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if err := admin.Exec(fmt.Sprintf(`CREATE SCHEMA %q`, schema)).Error; err != nil {
+		tb.Fatalf("create schema: %v", err)
+	}
+
+	scoped := *base
+	query := scoped.Query()
+	query.Set("search_path", schema)
+	scoped.RawQuery = query.Encode()
+	db := open(tb, scoped.String())
+
+	tb.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		if err := admin.Exec(fmt.Sprintf(`DROP SCHEMA %q CASCADE`, schema)).Error; err != nil {
+			tb.Errorf("drop schema: %v", err)
+		}
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
+
+// Open connects to a new schema with every migration applied
+func Open(tb testing.TB) *gorm.DB {
+	tb.Helper()
+
+	db := OpenEmpty(tb)
+	if err := NewMigrator(tb, db).Up(tb.Context()); err != nil {
+		tb.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// NewMigrator returns a migrator for the embedded migrations that runs against db.
+// It points database.DB at db for the rest of the test.
+func NewMigrator(tb testing.TB, db *gorm.DB) *database.Migrator {
+	tb.Helper()
+
+	previous := database.DB
+	database.DB = db
+	tb.Cleanup(func() { database.DB = previous })
+
+	migrator, err := database.NewMigrator()
+	if err != nil {
+		tb.Fatalf("create migrator: %v", err)
+	}
+	return migrator
+}
+
+// open connects with quiet logging so benchmarks are not drowned in output
+func open(tb testing.TB, dsn string) *gorm.DB {
+	tb.Helper()
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		tb.Fatalf("connect to test database: %v", err)
+	}
+	return db
+}
@@ -0,0 +1,44 @@
+package dbtest
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// QueryCounter counts the SQL statements run through a session made by CountQueries
+type QueryCounter struct {
+	logger.Interface
+	count atomic.Int64
+}
+
+// CountQueries returns a session of db that counts every statement it runs
+func CountQueries(db *gorm.DB) (*gorm.DB, *QueryCounter) {
+	counter := &QueryCounter{Interface: db.Logger}
+	return db.Session(&gorm.Session{Logger: counter}), counter
+}
+
+// LogMode keeps counting whatever the log level
+func (c *QueryCounter) LogMode(level logger.LogLevel) logger.Interface {
+	c.Interface = c.Interface.LogMode(level)
+	return c
+}
+
+// Trace is called by gorm once per statement
+func (c *QueryCounter) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	c.count.Add(1)
+	c.Interface.Trace(ctx, begin, fc, err)
+}
+
+// Count returns the statements run since the last Reset
+func (c *QueryCounter) Count() int64 {
+	return c.count.Load()
+}
+
+// Reset sets the count back to zero
+func (c *QueryCounter) Reset() {
+	c.count.Store(0)
+}
@@ -0,0 +1,357 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yakka-backend/internal/infrastructure/database/migrations"
+)
+
+// migrationLockID serialises concurrent migration runs (e.g. two pods starting at once)
+const migrationLockID = 727274201
+
+// noTransactionMarker on the first line of a migration file runs it outside a
+// transaction, for statements Postgres restricts inside one such as ALTER TYPE ... ADD VALUE
+const noTransactionMarker = "-- migrate:no-transaction"
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+
+	UpNoTransaction   bool
+	DownNoTransaction bool
+}
+
+// MigrationStatus reports whether a migration has been applied
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+// LoadMigrations reads the migrations from a filesystem, sorted by version
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, file := range files {
+		base := path.Base(file)
+		direction := ""
+		switch {
+		case strings.HasSuffix(base, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(base, ".down.sql"):
+			direction = "down"
+		default:
+			return nil, fmt.Errorf("migration %s must end in .up.sql or .down.sql", base)
+		}
+
+		versionPart, name, ok := strings.Cut(strings.TrimSuffix(base, "."+direction+".sql"), "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s must be named NNNN_description", base)
+		}
+		version, err := strconv.Atoi(versionPart)
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("migration %s has an invalid version", base)
+		}
+
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		} else if migration.Name != name {
+			return nil, fmt.Errorf("migration version %d is used by %s and %s", version, migration.Name, name)
+		}
+		noTransaction := strings.HasPrefix(strings.TrimSpace(string(content)), noTransactionMarker)
+		if direction == "up" {
+			migration.Up = string(content)
+			migration.UpNoTransaction = noTransaction
+		} else {
+			migration.Down = string(content)
+			migration.DownNoTransaction = noTransaction
+		}
+	}
+
+	list := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		list = append(list, *migration)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
+
+// Migrator applies migrations and records them in schema_migrations
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator for the embedded migrations
+func NewMigrator() (*Migrator, error) {
+	if DB == nil {
+		return nil, fmt.Errorf("database connection not established")
+	}
+
+	// Migrations run on the plain connection: the prepared statement cache of
+	// GORM cannot execute files with several statements.
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+
+	list, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	return &Migrator{db: sqlDB, migrations: list}, nil
+}
+
+// Up applies every pending migration
+func (m *Migrator) Up(ctx context.Context) error {
+	if len(m.migrations) == 0 {
+		return nil
+	}
+	return m.To(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down rolls back the latest applied migration
+func (m *Migrator) Down(ctx context.Context) error {
+	current, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	if current == 0 {
+		log.Println("ℹ️ No migrations to roll back")
+		return nil
+	}
+	if m.find(current) == nil {
+		return fmt.Errorf("applied migration %d is newer than this binary", current)
+	}
+
+	target := 0
+	for _, migration := range m.migrations {
+		if migration.Version < current {
+			target = migration.Version
+		}
+	}
+	return m.To(ctx, target)
+}
+
+// To migrates up or down until the given version is the latest applied one
+func (m *Migrator) To(ctx context.Context, target int) error {
+	if target != 0 && m.find(target) == nil {
+		return fmt.Errorf("unknown migration version %d", target)
+	}
+
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Roll back newest first, then apply oldest first
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; ok && migration.Version > target {
+			if err := m.run(ctx, migration, false); err != nil {
+				return err
+			}
+		}
+	}
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok && migration.Version <= target {
+			if err := m.run(ctx, migration, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Status lists every known migration with the time it was applied
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		entry := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			entry.AppliedAt = &appliedAt
+		}
+		status = append(status, entry)
+	}
+	return status, nil
+}
+
+// Version returns the latest applied version, 0 for an empty database
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return 0, err
+	}
+	var version sql.NullInt64
+	if err := m.db.QueryRowContext(ctx, `SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return int(version.Int64), nil
+}
+
+// migrationQuerier is what applying a migration needs from a transaction or a connection
+type migrationQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// run applies or rolls back one migration together with its schema_migrations row
+func (m *Migrator) run(ctx context.Context, migration Migration, up bool) error {
+	noTransaction := migration.UpNoTransaction
+	if !up {
+		noTransaction = migration.DownNoTransaction
+	}
+	if noTransaction {
+		return m.runWithoutTransaction(ctx, migration, up)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("failed to lock schema_migrations: %w", err)
+	}
+	if err := apply(ctx, tx, migration, up); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// runWithoutTransaction applies a migration marked with noTransactionMarker. The
+// advisory lock is held by one connection instead, and the statements should be
+// idempotent because a failure part way through is not rolled back.
+func (m *Migrator) runWithoutTransaction(ctx context.Context, migration Migration, up bool) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("failed to lock schema_migrations: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID); err != nil {
+			log.Printf("⚠️ Failed to unlock schema_migrations: %v", err)
+		}
+	}()
+
+	return apply(ctx, conn, migration, up)
+}
+
+// apply runs the migration and records it, unless another process already did while we waited for the lock
+func apply(ctx context.Context, q migrationQuerier, migration Migration, up bool) error {
+	label := fmt.Sprintf("%04d_%s", migration.Version, migration.Name)
+
+	var exists bool
+	if err := q.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)`, migration.Version).Scan(&exists); err != nil {
+		return err
+	}
+	if exists == up {
+		return nil
+	}
+
+	if up {
+		log.Printf("⬆️ Applying migration %s", label)
+		if _, err := q.ExecContext(ctx, migration.Up); err != nil {
+			return fmt.Errorf("migration %s failed: %w", label, err)
+		}
+		if _, err := q.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)`, migration.Version, migration.Name, time.Now()); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	log.Printf("⬇️ Rolling back migration %s", label)
+	if _, err := q.ExecContext(ctx, migration.Down); err != nil {
+		return fmt.Errorf("rollback of %s failed: %w", label, err)
+	}
+	if _, err := q.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ensureTable creates the schema_migrations table
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version bigint PRIMARY KEY,
+			name text NOT NULL,
+			applied_at timestamptz NOT NULL
+		)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+// applied returns the applied versions with the time they were applied
+func (m *Migrator) applied(ctx context.Context) (map[int]time.Time, error) {
+	rows, err := m.db.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// find returns the migration with the given version
+func (m *Migrator) find(version int) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/yakka-backend/internal/infrastructure/database/dbtest"
+)
+
+func TestMigrationsRoundTrip(t *testing.T) {
+	db := dbtest.OpenEmpty(t)
+	migrator := dbtest.NewMigrator(t, db)
+	ctx := t.Context()
+
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	latest := status[len(status)-1].Version
+
+	tables := func() int64 {
+		var count int64
+		err := db.Raw(`SELECT COUNT(*) FROM information_schema.tables
+			WHERE table_schema = current_schema() AND table_name <> 'schema_migrations'`).Scan(&count).Error
+		if err != nil {
+			t.Fatalf("count tables: %v", err)
+		}
+		return count
+	}
+
+	// Up, all the way down, and up again must leave the same schema
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if version, err := migrator.Version(ctx); err != nil || version != latest {
+		t.Fatalf("Version after Up = %d, %v; want %d", version, err, latest)
+	}
+	created := tables()
+	if created == 0 {
+		t.Fatal("Up created no tables")
+	}
+
+	// Step down one migration at a time so every down file runs on its own
+	for i := len(status) - 1; i >= 0; i-- {
+		if err := migrator.Down(ctx); err != nil {
+			t.Fatalf("Down from %04d_%s: %v", status[i].Version, status[i].Name, err)
+		}
+	}
+	if version, err := migrator.Version(ctx); err != nil || version != 0 {
+		t.Fatalf("Version after rolling back = %d, %v; want 0", version, err)
+	}
+	if left := tables(); left != 0 {
+		t.Errorf("%d tables left after rolling back every migration", left)
+	}
+
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up again: %v", err)
+	}
+	if again := tables(); again != created {
+		t.Errorf("second Up created %d tables, want %d", again, created)
+	}
+
+	// Running Up with nothing pending is a no-op
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up with nothing pending: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/yakka-backend/internal/infrastructure/database/migrations"
+)
+
+func TestLoadMigrationsOrdersByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0010_tenth.up.sql":   {Data: []byte("SELECT 10;")},
+		"0010_tenth.down.sql": {Data: []byte("SELECT -10;")},
+		"0002_second.up.sql":  {Data: []byte("SELECT 2;")},
+		"0002_second.down.sql": {
+			Data: []byte("SELECT -2;"),
+		},
+		"0001_first_one.up.sql":   {Data: []byte("SELECT 1;")},
+		"0001_first_one.down.sql": {Data: []byte("SELECT -1;")},
+	}
+
+	list, err := LoadMigrations(fsys)
+	if err != nil {
+		t.Fatalf("LoadMigrations: %v", err)
+	}
+
+	want := []struct {
+		version int
+		name    string
+		up      string
+	}{
+		{1, "first_one", "SELECT 1;"},
+		{2, "second", "SELECT 2;"},
+		{10, "tenth", "SELECT 10;"},
+	}
+	if len(list) != len(want) {
+		t.Fatalf("loaded %d migrations, want %d", len(list), len(want))
+	}
+	for i, w := range want {
+		if list[i].Version != w.version || list[i].Name != w.name || list[i].Up != w.up {
+			t.Errorf("migration %d = %d_%s %q, want %d_%s %q", i, list[i].Version, list[i].Name, list[i].Up, w.version, w.name, w.up)
+		}
+	}
+}
+
+func TestLoadMigrationsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:    "unknown suffix",
+			files:   map[string]string{"0001_init.sql": "SELECT 1;"},
+			wantErr: "must end in .up.sql or .down.sql",
+		},
+		{
+			name:    "missing description",
+			files:   map[string]string{"0001.up.sql": "SELECT 1;", "0001.down.sql": "SELECT 1;"},
+			wantErr: "must be named NNNN_description",
+		},
+		{
+			name:    "version is not a number",
+			files:   map[string]string{"first_init.up.sql": "SELECT 1;", "first_init.down.sql": "SELECT 1;"},
+			wantErr: "invalid version",
+		},
+		{
+			name:    "version zero",
+			files:   map[string]string{"0000_init.up.sql": "SELECT 1;", "0000_init.down.sql": "SELECT 1;"},
+			wantErr: "invalid version",
+		},
+		{
+			name: "version used twice",
+			files: map[string]string{
+				"0001_init.up.sql":    "SELECT 1;",
+				"0001_init.down.sql":  "SELECT 1;",
+				"0001_other.up.sql":   "SELECT 1;",
+				"0001_other.down.sql": "SELECT 1;",
+			},
+			wantErr: "migration version 1 is used by",
+		},
+		{
+			name:    "missing down file",
+			files:   map[string]string{"0001_init.up.sql": "SELECT 1;"},
+			wantErr: "needs both an up and a down file",
+		},
+		{
+			name:    "missing up file",
+			files:   map[string]string{"0001_init.down.sql": "SELECT 1;"},
+			wantErr: "needs both an up and a down file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{}
+			for name, content := range tt.files {
+				fsys[name] = &fstest.MapFile{Data: []byte(content)}
+			}
+			_, err := LoadMigrations(fsys)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("LoadMigrations error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadMigrationsNoTransactionMarker(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_enum.up.sql":   {Data: []byte("-- migrate:no-transaction\nALTER TYPE t ADD VALUE IF NOT EXISTS 'x';")},
+		"0001_enum.down.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	list, err := LoadMigrations(fsys)
+	if err != nil {
+		t.Fatalf("LoadMigrations: %v", err)
+	}
+	if !list[0].UpNoTransaction || list[0].DownNoTransaction {
+		t.Errorf("UpNoTransaction = %v, DownNoTransaction = %v, want true and false", list[0].UpNoTransaction, list[0].DownNoTransaction)
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	list, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		t.Fatalf("LoadMigrations: %v", err)
+	}
+	for i, migration := range list {
+		if migration.Version != i+1 {
+			t.Errorf("migration %s has version %d, want %d: versions must not leave gaps", migration.Name, migration.Version, i+1)
+		}
+		// Postgres does not let a transaction use an enum value it added
+		if strings.Contains(strings.ToUpper(migration.Up), "ADD VALUE") && !migration.UpNoTransaction {
+			t.Errorf("migration %04d_%s adds an enum value inside a transaction", migration.Version, migration.Name)
+		}
+	}
+}
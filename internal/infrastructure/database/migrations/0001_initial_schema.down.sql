@@ -0,0 +1,39 @@
+-- Drops the whole schema. Only useful to reset a development database.
+
+DROP TABLE IF EXISTS "labour_profile_qualifications";
+DROP TABLE IF EXISTS "qualifications";
+DROP TABLE IF EXISTS "qualifications_sport";
+DROP TABLE IF EXISTS "job_assignments";
+DROP TABLE IF EXISTS "job_applications";
+DROP TABLE IF EXISTS "job_job_requirements";
+DROP TABLE IF EXISTS "job_skills";
+DROP TABLE IF EXISTS "job_licenses";
+DROP TABLE IF EXISTS "jobs";
+DROP TABLE IF EXISTS "payment_constants";
+DROP TABLE IF EXISTS "job_types";
+DROP TABLE IF EXISTS "job_requirements";
+DROP TABLE IF EXISTS "experience_levels";
+DROP TABLE IF EXISTS "skill_subcategories";
+DROP TABLE IF EXISTS "skill_categories";
+DROP TABLE IF EXISTS "licenses";
+DROP TABLE IF EXISTS "user_licenses";
+DROP TABLE IF EXISTS "jobsites";
+DROP TABLE IF EXISTS "labour_profile_skills";
+DROP TABLE IF EXISTS "labour_profiles";
+DROP TABLE IF EXISTS "companies";
+DROP TABLE IF EXISTS "builder_profiles";
+DROP TABLE IF EXISTS "user_identities";
+DROP TABLE IF EXISTS "mfa_challenges";
+DROP TABLE IF EXISTS "mfa_recovery_codes";
+DROP TABLE IF EXISTS "user_mfa";
+DROP TABLE IF EXISTS "account_lockouts";
+DROP TABLE IF EXISTS "login_events";
+DROP TABLE IF EXISTS "phone_verifications";
+DROP TABLE IF EXISTS "password_resets";
+DROP TABLE IF EXISTS "email_verifications";
+DROP TABLE IF EXISTS "session_rotated_tokens";
+DROP TABLE IF EXISTS "sessions";
+DROP TABLE IF EXISTS "users";
+
+DROP TYPE IF EXISTS user_role;
+DROP TYPE IF EXISTS user_status;
@@ -0,0 +1,462 @@
+-- Baseline schema, equivalent to what GORM AutoMigrate produced before versioned
+-- migrations. Every statement is idempotent so databases created by AutoMigrate
+-- can adopt it without changes.
+
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+
+DO $$ BEGIN
+    CREATE TYPE user_status AS ENUM ('active', 'inactive', 'suspended', 'pending', 'banned');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+DO $$ BEGIN
+    CREATE TYPE user_role AS ENUM ('user', 'admin', 'builder', 'labour');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+CREATE TABLE IF NOT EXISTS "users" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "email" varchar(255) NOT NULL,
+    "phone" varchar(32),
+    "phone_verified_at" timestamptz,
+    "password_hash" text NOT NULL,
+    "first_name" varchar(120),
+    "last_name" varchar(120),
+    "address" text,
+    "photo" text,
+    "status" user_status NOT NULL,
+    "last_login_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "role" user_role NOT NULL,
+    "role_changed_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_email" ON "users" ("email");
+
+CREATE TABLE IF NOT EXISTS "sessions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "refresh_token_hash" text NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "user_agent" varchar(255),
+    "ip_address" varchar(45),
+    "revoked_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_sessions_created_at" ON "sessions" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_sessions_expires_at" ON "sessions" ("expires_at");
+CREATE INDEX IF NOT EXISTS "idx_sessions_user_id" ON "sessions" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "session_rotated_tokens" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "session_id" uuid NOT NULL,
+    "token_hash" text NOT NULL,
+    "rotated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_session_rotated_tokens_token_hash" ON "session_rotated_tokens" ("token_hash");
+CREATE INDEX IF NOT EXISTS "idx_session_rotated_tokens_session_id" ON "session_rotated_tokens" ("session_id");
+
+CREATE TABLE IF NOT EXISTS "email_verifications" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "token_hash" text NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "verified_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "password_resets" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "token_hash" text NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "phone_verifications" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "phone" varchar(32) NOT NULL,
+    "code_hash" text NOT NULL,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "expires_at" timestamptz NOT NULL,
+    "verified_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_phone_verifications_user_id" ON "phone_verifications" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "login_events" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "email" varchar(255) NOT NULL,
+    "user_id" uuid,
+    "ip_address" varchar(45) NOT NULL,
+    "user_agent" varchar(255),
+    "type" varchar(20) NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_login_events_ip_created" ON "login_events" ("ip_address","created_at");
+CREATE INDEX IF NOT EXISTS "idx_login_events_user_id" ON "login_events" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_login_events_email_created" ON "login_events" ("email","created_at");
+
+CREATE TABLE IF NOT EXISTS "account_lockouts" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "email" varchar(255) NOT NULL,
+    "locked_until" timestamptz NOT NULL,
+    "unlock_token_hash" text NOT NULL,
+    "unlocked_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_account_lockouts_unlock_token_hash" ON "account_lockouts" ("unlock_token_hash");
+CREATE INDEX IF NOT EXISTS "idx_account_lockouts_email" ON "account_lockouts" ("email");
+CREATE INDEX IF NOT EXISTS "idx_account_lockouts_user_id" ON "account_lockouts" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "user_mfa" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "secret_encrypted" text NOT NULL,
+    "last_used_step" bigint NOT NULL DEFAULT 0,
+    "enabled_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_mfa_user_id" ON "user_mfa" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "mfa_recovery_codes" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_code_hash" ON "mfa_recovery_codes" ("code_hash");
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_user_id" ON "mfa_recovery_codes" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "mfa_challenges" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "token_hash" varchar(64) NOT NULL,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "expires_at" timestamptz NOT NULL,
+    "consumed_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_mfa_challenges_token_hash" ON "mfa_challenges" ("token_hash");
+CREATE INDEX IF NOT EXISTS "idx_mfa_challenges_user_id" ON "mfa_challenges" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "user_identities" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "provider" varchar(32) NOT NULL,
+    "subject" varchar(255) NOT NULL,
+    "email" varchar(255),
+    "last_login_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_identities_provider_subject" ON "user_identities" ("provider","subject");
+CREATE INDEX IF NOT EXISTS "idx_user_identities_user_id" ON "user_identities" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "builder_profiles" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "company_id" uuid,
+    "display_name" varchar(255),
+    "location" varchar(255),
+    "bio" text,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_builder_profiles_company_id" ON "builder_profiles" ("company_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_builder_profiles_user_id" ON "builder_profiles" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "companies" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(255) NOT NULL,
+    "description" text,
+    "website" varchar(255),
+    "require_mfa" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_companies_name" ON "companies" ("name");
+
+CREATE TABLE IF NOT EXISTS "labour_profiles" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "location" varchar(255),
+    "bio" text,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_labour_profiles_user_id" ON "labour_profiles" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "labour_profile_skills" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "labour_profile_id" uuid NOT NULL,
+    "category_id" uuid NOT NULL,
+    "subcategory_id" uuid NOT NULL,
+    "experience_level_id" uuid NOT NULL,
+    "years_experience" decimal(4,1),
+    "is_primary" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_labour_profile_skills_experience_level_id" ON "labour_profile_skills" ("experience_level_id");
+CREATE INDEX IF NOT EXISTS "idx_labour_profile_skills_subcategory_id" ON "labour_profile_skills" ("subcategory_id");
+CREATE INDEX IF NOT EXISTS "idx_labour_profile_skills_category_id" ON "labour_profile_skills" ("category_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_labour_profile_subcategory" ON "labour_profile_skills" ("labour_profile_id","subcategory_id");
+CREATE INDEX IF NOT EXISTS "idx_labour_profile_skills_labour_profile_id" ON "labour_profile_skills" ("labour_profile_id");
+
+CREATE TABLE IF NOT EXISTS "jobsites" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "builder_id" uuid NOT NULL,
+    "address" text NOT NULL,
+    "city" varchar(120),
+    "suburb" varchar(120),
+    "description" text,
+    "latitude" decimal(10,8) NOT NULL,
+    "longitude" decimal(11,8) NOT NULL,
+    "phone" varchar(32),
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_jobsites_city" ON "jobsites" ("city");
+CREATE INDEX IF NOT EXISTS "idx_jobsites_builder_id" ON "jobsites" ("builder_id");
+
+CREATE TABLE IF NOT EXISTS "user_licenses" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "license_id" uuid NOT NULL,
+    "photo_url" text,
+    "issued_at" timestamptz,
+    "expires_at" timestamptz,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_licenses_license_id" ON "user_licenses" ("license_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_license" ON "user_licenses" ("user_id","license_id");
+CREATE INDEX IF NOT EXISTS "idx_user_licenses_user_id" ON "user_licenses" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "licenses" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(255) NOT NULL,
+    "description" text,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "skill_categories" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(255) NOT NULL,
+    "description" text,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_skill_categories_name" UNIQUE ("name")
+);
+CREATE INDEX IF NOT EXISTS "idx_skill_categories_deleted_at" ON "skill_categories" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "skill_subcategories" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "category_id" uuid NOT NULL,
+    "name" varchar(255) NOT NULL,
+    "description" text,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_skill_subcategories_deleted_at" ON "skill_subcategories" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "experience_levels" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "description" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_experience_levels_name" UNIQUE ("name")
+);
+CREATE INDEX IF NOT EXISTS "idx_experience_levels_deleted_at" ON "experience_levels" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "job_requirements" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "description" varchar(255),
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_job_requirements_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "job_types" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "description" varchar(255),
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_job_types_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "payment_constants" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "value" bigint NOT NULL,
+    "description" varchar(255),
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_payment_constants_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "jobs" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "builder_profile_id" uuid NOT NULL,
+    "jobsite_id" uuid NOT NULL,
+    "job_type_id" uuid NOT NULL,
+    "many_labours" bigint NOT NULL,
+    "ongoing_work" boolean NOT NULL DEFAULT false,
+    "wage_site_allowance" decimal(10,2),
+    "wage_leading_hand_allowance" decimal(10,2),
+    "wage_productivity_allowance" decimal(10,2),
+    "extras_overtime_rate" decimal(10,2),
+    "wage_hourly_rate" decimal(10,2),
+    "travel_allowance" decimal(10,2),
+    "gst" decimal(10,2),
+    "start_date_work" date,
+    "end_date_work" date,
+    "work_saturday" boolean NOT NULL DEFAULT false,
+    "work_sunday" boolean NOT NULL DEFAULT false,
+    "start_time" varchar(8),
+    "end_time" varchar(8),
+    "description" text,
+    "payment_day" date,
+    "requires_supervisor_signature" boolean NOT NULL DEFAULT false,
+    "supervisor_name" varchar(100),
+    "visibility" varchar(20) NOT NULL DEFAULT 'DRAFT',
+    "payment_type" varchar(20) NOT NULL DEFAULT 'WEEKLY',
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "job_licenses" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "job_id" uuid NOT NULL,
+    "license_id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "job_skills" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "job_id" uuid NOT NULL,
+    "skill_category_id" uuid,
+    "skill_subcategory_id" uuid,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "job_job_requirements" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "job_id" uuid NOT NULL,
+    "job_requirement_id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "job_applications" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "job_id" uuid NOT NULL,
+    "labour_user_id" uuid NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'APPLIED',
+    "cover_letter" text,
+    "expected_rate" decimal(12,2),
+    "resume_url" text,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "withdrawn_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "job_assignments" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "job_id" uuid NOT NULL,
+    "labour_user_id" uuid NOT NULL,
+    "application_id" uuid NOT NULL,
+    "start_date" date,
+    "end_date" date,
+    "status" varchar(20) NOT NULL DEFAULT 'ACTIVE',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_job_assignments_application_id" ON "job_assignments" ("application_id");
+
+CREATE TABLE IF NOT EXISTS "qualifications_sport" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(150) NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_qualifications_sport_name" ON "qualifications_sport" ("name");
+
+CREATE TABLE IF NOT EXISTS "qualifications" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "sport_id" uuid NOT NULL,
+    "title" varchar(255) NOT NULL,
+    "organization" varchar(150),
+    "country" varchar(100),
+    "status" varchar(50) DEFAULT 'active',
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_qualifications_sport_id" ON "qualifications" ("sport_id");
+
+CREATE TABLE IF NOT EXISTS "labour_profile_qualifications" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "labour_profile_id" uuid NOT NULL,
+    "qualification_id" uuid NOT NULL,
+    "date_obtained" date,
+    "expires_at" date,
+    "status" varchar(50) DEFAULT 'valid',
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_labour_profile_qualifications_qualification_id" ON "labour_profile_qualifications" ("qualification_id");
+CREATE INDEX IF NOT EXISTS "idx_labour_profile_qualifications_labour_profile_id" ON "labour_profile_qualifications" ("labour_profile_id");
+
+-- Columns added after the tables were first created by AutoMigrate
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "phone_verified_at" timestamptz;
+ALTER TABLE "companies" ADD COLUMN IF NOT EXISTS "require_mfa" boolean NOT NULL DEFAULT false;
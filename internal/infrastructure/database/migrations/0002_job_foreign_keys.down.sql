@@ -0,0 +1,32 @@
+DROP INDEX IF EXISTS "idx_job_assignments_labour_user_id";
+DROP INDEX IF EXISTS "idx_job_assignments_job_id";
+ALTER TABLE "job_assignments"
+    DROP CONSTRAINT IF EXISTS "fk_job_assignments_labour_user",
+    DROP CONSTRAINT IF EXISTS "fk_job_assignments_application",
+    DROP CONSTRAINT IF EXISTS "fk_job_assignments_job";
+
+DROP INDEX IF EXISTS "idx_job_applications_labour_user_id";
+DROP INDEX IF EXISTS "idx_job_applications_job_id";
+ALTER TABLE "job_applications"
+    DROP CONSTRAINT IF EXISTS "fk_job_applications_labour_user",
+    DROP CONSTRAINT IF EXISTS "fk_job_applications_job";
+
+DROP INDEX IF EXISTS "idx_job_job_requirements_job_id";
+DROP INDEX IF EXISTS "idx_job_skills_job_id";
+DROP INDEX IF EXISTS "idx_job_licenses_job_id";
+ALTER TABLE "job_job_requirements" DROP CONSTRAINT IF EXISTS "fk_job_job_requirements_job";
+ALTER TABLE "job_skills" DROP CONSTRAINT IF EXISTS "fk_job_skills_job";
+ALTER TABLE "job_licenses" DROP CONSTRAINT IF EXISTS "fk_job_licenses_job";
+
+DROP INDEX IF EXISTS "idx_jobs_jobsite_id";
+DROP INDEX IF EXISTS "idx_jobs_builder_profile_id";
+ALTER TABLE "jobs"
+    DROP CONSTRAINT IF EXISTS "fk_jobs_job_type",
+    DROP CONSTRAINT IF EXISTS "fk_jobs_jobsite",
+    DROP CONSTRAINT IF EXISTS "fk_jobs_builder_profile";
+
+ALTER TABLE "jobsites" DROP CONSTRAINT IF EXISTS "fk_jobsites_builder";
+
+ALTER TABLE "builder_profiles"
+    DROP CONSTRAINT IF EXISTS "fk_builder_profiles_company",
+    DROP CONSTRAINT IF EXISTS "fk_builder_profiles_user";
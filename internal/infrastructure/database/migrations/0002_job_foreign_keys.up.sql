@@ -0,0 +1,42 @@
+-- Real foreign keys between builders, jobsites, jobs, applications and assignments.
+-- AutoMigrate ran with DisableForeignKeyConstraintWhenMigrating, so until now nothing
+-- stopped rows from pointing at deleted parents. Orphaned rows make this migration
+-- fail and must be cleaned up by hand first.
+
+ALTER TABLE "builder_profiles"
+    ADD CONSTRAINT "fk_builder_profiles_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE,
+    ADD CONSTRAINT "fk_builder_profiles_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON DELETE SET NULL;
+
+-- jobsites.builder_id holds the builder's user ID, which is unique in builder_profiles
+ALTER TABLE "jobsites"
+    ADD CONSTRAINT "fk_jobsites_builder" FOREIGN KEY ("builder_id") REFERENCES "builder_profiles" ("user_id") ON DELETE CASCADE;
+
+ALTER TABLE "jobs"
+    ADD CONSTRAINT "fk_jobs_builder_profile" FOREIGN KEY ("builder_profile_id") REFERENCES "builder_profiles" ("id") ON DELETE RESTRICT,
+    ADD CONSTRAINT "fk_jobs_jobsite" FOREIGN KEY ("jobsite_id") REFERENCES "jobsites" ("id") ON DELETE RESTRICT,
+    ADD CONSTRAINT "fk_jobs_job_type" FOREIGN KEY ("job_type_id") REFERENCES "job_types" ("id") ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS "idx_jobs_builder_profile_id" ON "jobs" ("builder_profile_id");
+CREATE INDEX IF NOT EXISTS "idx_jobs_jobsite_id" ON "jobs" ("jobsite_id");
+
+ALTER TABLE "job_licenses"
+    ADD CONSTRAINT "fk_job_licenses_job" FOREIGN KEY ("job_id") REFERENCES "jobs" ("id") ON DELETE CASCADE;
+ALTER TABLE "job_skills"
+    ADD CONSTRAINT "fk_job_skills_job" FOREIGN KEY ("job_id") REFERENCES "jobs" ("id") ON DELETE CASCADE;
+ALTER TABLE "job_job_requirements"
+    ADD CONSTRAINT "fk_job_job_requirements_job" FOREIGN KEY ("job_id") REFERENCES "jobs" ("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "idx_job_licenses_job_id" ON "job_licenses" ("job_id");
+CREATE INDEX IF NOT EXISTS "idx_job_skills_job_id" ON "job_skills" ("job_id");
+CREATE INDEX IF NOT EXISTS "idx_job_job_requirements_job_id" ON "job_job_requirements" ("job_id");
+
+ALTER TABLE "job_applications"
+    ADD CONSTRAINT "fk_job_applications_job" FOREIGN KEY ("job_id") REFERENCES "jobs" ("id") ON DELETE CASCADE,
+    ADD CONSTRAINT "fk_job_applications_labour_user" FOREIGN KEY ("labour_user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "idx_job_applications_job_id" ON "job_applications" ("job_id");
+CREATE INDEX IF NOT EXISTS "idx_job_applications_labour_user_id" ON "job_applications" ("labour_user_id");
+
+ALTER TABLE "job_assignments"
+    ADD CONSTRAINT "fk_job_assignments_job" FOREIGN KEY ("job_id") REFERENCES "jobs" ("id") ON DELETE CASCADE,
+    ADD CONSTRAINT "fk_job_assignments_application" FOREIGN KEY ("application_id") REFERENCES "job_applications" ("id") ON DELETE CASCADE,
+    ADD CONSTRAINT "fk_job_assignments_labour_user" FOREIGN KEY ("labour_user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS "idx_job_assignments_job_id" ON "job_assignments" ("job_id");
+CREATE INDEX IF NOT EXISTS "idx_job_assignments_labour_user_id" ON "job_assignments" ("labour_user_id");
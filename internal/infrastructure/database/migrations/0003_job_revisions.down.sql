@@ -0,0 +1,3 @@
+ALTER TABLE "job_applications" DROP COLUMN IF EXISTS "job_changed_at";
+DROP TABLE IF EXISTS "job_revision_changes";
+DROP TABLE IF EXISTS "job_revisions";
@@ -0,0 +1,28 @@
+-- Edit history of jobs, and a flag on applications made before a material edit
+-- (pay, dates or head count) until the labourer confirms or withdraws.
+
+CREATE TABLE IF NOT EXISTS "job_revisions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "job_id" uuid NOT NULL,
+    "version" bigint NOT NULL,
+    "builder_profile_id" uuid NOT NULL,
+    "material" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_job_revisions_job" FOREIGN KEY ("job_id") REFERENCES "jobs" ("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_job_revisions_job_version" ON "job_revisions" ("job_id", "version");
+
+CREATE TABLE IF NOT EXISTS "job_revision_changes" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "revision_id" uuid NOT NULL,
+    "field" varchar(50) NOT NULL,
+    "old_value" text,
+    "new_value" text,
+    "material" boolean NOT NULL DEFAULT false,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_job_revision_changes_revision" FOREIGN KEY ("revision_id") REFERENCES "job_revisions" ("id") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_job_revision_changes_revision_id" ON "job_revision_changes" ("revision_id");
+
+ALTER TABLE "job_applications" ADD COLUMN IF NOT EXISTS "job_changed_at" timestamptz;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_jobs_status_end_date_work";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "status";
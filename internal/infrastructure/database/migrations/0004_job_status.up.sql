@@ -0,0 +1,12 @@
+-- Lifecycle status of a job, kept apart from visibility (who can see it).
+-- Jobs whose active assignments already cover the head count start as FILLED.
+
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "status" varchar(20) NOT NULL DEFAULT 'OPEN';
+
+UPDATE "jobs" SET "status" = 'FILLED'
+WHERE "many_labours" <= (
+    SELECT COUNT(*) FROM "job_assignments"
+    WHERE "job_assignments"."job_id" = "jobs"."id" AND "job_assignments"."status" = 'ACTIVE'
+);
+
+CREATE INDEX IF NOT EXISTS "idx_jobs_status_end_date_work" ON "jobs" ("status", "end_date_work");
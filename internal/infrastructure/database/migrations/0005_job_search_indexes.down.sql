@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS "idx_job_licenses_license_id";
+DROP INDEX IF EXISTS "idx_job_skills_skill_subcategory_id";
+DROP INDEX IF EXISTS "idx_job_skills_skill_category_id";
+DROP INDEX IF EXISTS "idx_jobsites_coordinates";
+DROP INDEX IF EXISTS "idx_jobs_start_date_work";
+DROP INDEX IF EXISTS "idx_jobs_job_type_id";
+DROP INDEX IF EXISTS "idx_jobs_search_pay";
+DROP INDEX IF EXISTS "idx_jobs_search_recent";
@@ -0,0 +1,13 @@
+-- Indexes behind the labour job search: one per sort order over open public jobs,
+-- the filter columns, and jobsite coordinates for the radius bounding box.
+
+CREATE INDEX IF NOT EXISTS "idx_jobs_search_recent" ON "jobs" ("created_at" DESC, "id" DESC)
+    WHERE "visibility" = 'PUBLIC' AND "status" = 'OPEN';
+CREATE INDEX IF NOT EXISTS "idx_jobs_search_pay" ON "jobs" ((COALESCE("wage_hourly_rate", 0)) DESC, "id" DESC)
+    WHERE "visibility" = 'PUBLIC' AND "status" = 'OPEN';
+CREATE INDEX IF NOT EXISTS "idx_jobs_job_type_id" ON "jobs" ("job_type_id");
+CREATE INDEX IF NOT EXISTS "idx_jobs_start_date_work" ON "jobs" ("start_date_work");
+CREATE INDEX IF NOT EXISTS "idx_jobsites_coordinates" ON "jobsites" ("latitude", "longitude");
+CREATE INDEX IF NOT EXISTS "idx_job_skills_skill_category_id" ON "job_skills" ("skill_category_id", "job_id");
+CREATE INDEX IF NOT EXISTS "idx_job_skills_skill_subcategory_id" ON "job_skills" ("skill_subcategory_id", "job_id");
+CREATE INDEX IF NOT EXISTS "idx_job_licenses_license_id" ON "job_licenses" ("license_id", "job_id");
@@ -0,0 +1 @@
+ALTER TABLE "job_licenses" DROP COLUMN IF EXISTS "required";
@@ -0,0 +1,4 @@
+-- Job licenses are either hard-required (applicants without them are turned away) or
+-- only preferred. Licenses already attached to jobs stay required.
+
+ALTER TABLE "job_licenses" ADD COLUMN IF NOT EXISTS "required" boolean NOT NULL DEFAULT true;
@@ -0,0 +1,29 @@
+-- Status history of job applications: who moved each application to which status, when and why.
+-- Existing applications get their creation and, when it changed since, their current status.
+
+CREATE TABLE IF NOT EXISTS "application_status_events" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "application_id" uuid NOT NULL,
+    "from_status" varchar(20),
+    "to_status" varchar(20) NOT NULL,
+    "actor" varchar(20) NOT NULL,
+    "actor_id" uuid,
+    "reason" text,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_application_status_events_application" FOREIGN KEY ("application_id") REFERENCES "job_applications" ("id") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_application_status_events_application_id" ON "application_status_events" ("application_id", "created_at");
+
+INSERT INTO "application_status_events" ("application_id", "from_status", "to_status", "actor", "actor_id", "created_at")
+SELECT "id", NULL, 'APPLIED', 'LABOUR', "labour_user_id", "created_at"
+FROM "job_applications";
+
+INSERT INTO "application_status_events" ("application_id", "from_status", "to_status", "actor", "actor_id", "reason", "created_at")
+SELECT "id", 'APPLIED', "status",
+       CASE WHEN "status" = 'WITHDRAWN' THEN 'LABOUR' ELSE 'BUILDER' END,
+       CASE WHEN "status" = 'WITHDRAWN' THEN "labour_user_id" END,
+       'recorded when the status history was introduced',
+       COALESCE("withdrawn_at", "updated_at")
+FROM "job_applications"
+WHERE "status" <> 'APPLIED';
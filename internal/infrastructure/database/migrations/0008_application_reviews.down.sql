@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "application_review_tags";
+DROP TABLE IF EXISTS "application_reviews";
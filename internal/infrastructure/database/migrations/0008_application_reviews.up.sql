@@ -0,0 +1,23 @@
+-- Builder-only review of applications: private notes, a 1 to 5 rating and free-form tags.
+-- Kept apart from job_applications so labour-facing queries never select them.
+
+CREATE TABLE IF NOT EXISTS "application_reviews" (
+    "application_id" uuid NOT NULL,
+    "builder_profile_id" uuid NOT NULL,
+    "notes" text,
+    "rating" smallint,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("application_id"),
+    CONSTRAINT "fk_application_reviews_application" FOREIGN KEY ("application_id") REFERENCES "job_applications" ("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_application_reviews_builder_profile" FOREIGN KEY ("builder_profile_id") REFERENCES "builder_profiles" ("id") ON DELETE CASCADE,
+    CONSTRAINT "chk_application_reviews_rating" CHECK ("rating" BETWEEN 1 AND 5)
+);
+
+CREATE TABLE IF NOT EXISTS "application_review_tags" (
+    "application_id" uuid NOT NULL,
+    "tag" varchar(30) NOT NULL,
+    PRIMARY KEY ("application_id", "tag"),
+    CONSTRAINT "fk_application_review_tags_review" FOREIGN KEY ("application_id") REFERENCES "application_reviews" ("application_id") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_application_review_tags_tag" ON "application_review_tags" ("tag");
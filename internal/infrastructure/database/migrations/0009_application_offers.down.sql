@@ -0,0 +1,12 @@
+-- Statuses introduced with offers fall back to the closest status that existed before
+UPDATE "job_applications" SET "status" = 'REVIEWED' WHERE "status" = 'OFFERED';
+UPDATE "job_applications" SET "status" = 'WITHDRAWN', "withdrawn_at" = COALESCE("withdrawn_at", "updated_at") WHERE "status" = 'DECLINED';
+UPDATE "job_applications" SET "status" = 'REJECTED' WHERE "status" = 'EXPIRED';
+
+DROP INDEX IF EXISTS "idx_job_applications_offer_expires_at";
+DROP INDEX IF EXISTS "idx_job_applications_pending_offers";
+ALTER TABLE "job_applications" DROP COLUMN IF EXISTS "offer_expires_at";
+ALTER TABLE "job_applications" DROP COLUMN IF EXISTS "offered_at";
+ALTER TABLE "job_applications" DROP COLUMN IF EXISTS "offer_rate";
+ALTER TABLE "job_applications" DROP COLUMN IF EXISTS "offer_end_date";
+ALTER TABLE "job_applications" DROP COLUMN IF EXISTS "offer_start_date";
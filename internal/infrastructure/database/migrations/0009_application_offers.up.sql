@@ -0,0 +1,13 @@
+-- Offers: the builder sends start and end dates, the final rate and a deadline, and the
+-- application waits in OFFERED until the labourer accepts or declines it or it expires.
+-- Only an accepted offer creates the assignment.
+
+ALTER TABLE "job_applications" ADD COLUMN IF NOT EXISTS "offer_start_date" date;
+ALTER TABLE "job_applications" ADD COLUMN IF NOT EXISTS "offer_end_date" date;
+ALTER TABLE "job_applications" ADD COLUMN IF NOT EXISTS "offer_rate" decimal(12,2);
+ALTER TABLE "job_applications" ADD COLUMN IF NOT EXISTS "offered_at" timestamptz;
+ALTER TABLE "job_applications" ADD COLUMN IF NOT EXISTS "offer_expires_at" timestamptz;
+
+-- Pending offers are counted against a job's places and swept once their deadline passes
+CREATE INDEX IF NOT EXISTS "idx_job_applications_pending_offers" ON "job_applications" ("job_id", "offer_expires_at") WHERE "status" = 'OFFERED';
+CREATE INDEX IF NOT EXISTS "idx_job_applications_offer_expires_at" ON "job_applications" ("offer_expires_at") WHERE "status" = 'OFFERED';
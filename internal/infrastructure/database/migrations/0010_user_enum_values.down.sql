@@ -0,0 +1,2 @@
+-- Postgres cannot drop enum values, and the initial schema creates them anyway
+SELECT 1;
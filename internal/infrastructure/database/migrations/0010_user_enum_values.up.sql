@@ -0,0 +1,7 @@
+-- migrate:no-transaction
+-- Older databases created the enums before these values existed. A value added
+-- inside a transaction cannot be used until it commits, so this runs on its own.
+
+ALTER TYPE user_status ADD VALUE IF NOT EXISTS 'banned';
+ALTER TYPE user_role ADD VALUE IF NOT EXISTS 'builder';
+ALTER TYPE user_role ADD VALUE IF NOT EXISTS 'labour';
@@ -0,0 +1 @@
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "reject_remaining_when_filled";
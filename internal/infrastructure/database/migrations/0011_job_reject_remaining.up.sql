@@ -0,0 +1,5 @@
+-- A builder can ask for the applicants still waiting for a decision to be rejected once the
+-- job is filled. Offers only hold places, so the wish is kept on the job until accepted offers
+-- take its last place.
+
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "reject_remaining_when_filled" boolean NOT NULL DEFAULT false;
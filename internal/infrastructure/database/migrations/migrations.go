@@ -0,0 +1,17 @@
+// Package migrations embeds the versioned SQL migrations of the schema.
+//
+// Each migration is a pair of files named NNNN_description.up.sql and
+// NNNN_description.down.sql. Versions are applied in ascending order and must
+// never be edited once released; add a new version instead.
+//
+// A file whose first line is "-- migrate:no-transaction" runs outside the
+// migration transaction. Use it for statements Postgres restricts inside a
+// transaction, such as ALTER TYPE ... ADD VALUE, and keep them idempotent.
+package migrations
+
+import "embed"
+
+// FS holds the migration files
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// UnitOfWork runs a function inside one database transaction. The function receives
+// repositories bound to that transaction, so either all of its writes are committed or,
+// when it returns an error, none of them are.
+type UnitOfWork[R any] interface {
+	Do(ctx context.Context, fn func(repos R) error) error
+}
+
+// unitOfWork implements UnitOfWork on top of gorm transactions
+type unitOfWork[R any] struct {
+	db   *gorm.DB
+	bind func(tx *gorm.DB) R
+}
+
+// NewUnitOfWork creates a unit of work. bind builds the repositories for a transaction,
+// usually by calling the regular repository constructors with tx.
+func NewUnitOfWork[R any](db *gorm.DB, bind func(tx *gorm.DB) R) UnitOfWork[R] {
+	return &unitOfWork[R]{db: db, bind: bind}
+}
+
+// Do runs fn in a transaction, committing when it returns nil and rolling back otherwise
+func (u *unitOfWork[R]) Do(ctx context.Context, fn func(repos R) error) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(u.bind(tx))
+	})
+}
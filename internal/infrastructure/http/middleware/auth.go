@@ -2,13 +2,15 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/yakka-backend/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/user/models"
 	"github.com/yakka-backend/internal/shared/response"
 )
 
@@ -18,18 +20,21 @@ type ContextKey string
 const (
 	UserIDKey           ContextKey = "user_id"
 	BuilderProfileIDKey ContextKey = "builder_profile_id"
+	SessionIDKey        ContextKey = "session_id"
 )
 
-// JWTSecret should be loaded from environment variables in production
-var JWTSecret = []byte("your-secret-key-change-in-production")
+// AccessTokenTTL is the lifetime of access tokens; clients renew them through /auth/refresh
+const AccessTokenTTL = time.Hour
 
 // Claims represents the JWT claims
 type Claims struct {
-	UserID string `json:"user_id"`
+	UserID    string `json:"user_id"`
+	SessionID string `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-// AuthMiddleware validates JWT tokens
+// AuthMiddleware validates the bearer token and puts the caller's Principal in the context.
+// Tokens of revoked sessions and users that are not active are rejected.
 func AuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Get Authorization header
@@ -60,169 +65,129 @@ func AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		log.Printf("🔐 JWT validated successfully for user: %s", claims.UserID)
-
-		// Add user ID to context
-		ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
-		log.Printf("🔍 AuthMiddleware - User ID set in context: %s", claims.UserID)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
-}
-
-// validateJWTToken validates and parses a JWT token
-func validateJWTToken(tokenString string) (*Claims, error) {
-	claims := &Claims{}
-
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return JWTSecret, nil
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if !token.Valid {
-		return nil, jwt.ErrTokenMalformed
-	}
-
-	return claims, nil
-}
-
-// GenerateJWTToken generates a new JWT token for a user
-func GenerateJWTToken(userID string) (string, error) {
-	claims := &Claims{
-		UserID: userID,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)), // 24 hours
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(JWTSecret)
-}
-
-// BuilderMiddleware validates JWT tokens and ensures user has builder role
-func BuilderMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// First validate JWT token
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			response.WriteError(w, http.StatusUnauthorized, "Authorization header required")
-			return
-		}
-
-		if !strings.HasPrefix(authHeader, "Bearer ") {
-			response.WriteError(w, http.StatusUnauthorized, "Invalid authorization format")
-			return
-		}
-
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == "" {
-			response.WriteError(w, http.StatusUnauthorized, "Token required")
+		userID, err := uuid.Parse(claims.UserID)
+		if err != nil {
+			response.WriteError(w, http.StatusUnauthorized, "Invalid token")
 			return
 		}
-
-		claims, err := validateJWTToken(tokenString)
+		sessionID, err := uuid.Parse(claims.SessionID)
 		if err != nil {
-			log.Printf("🔐 JWT validation failed: %v", err)
 			response.WriteError(w, http.StatusUnauthorized, "Invalid token")
 			return
 		}
 
-		// Check if user has builder role
-		userID := claims.UserID
-		var role string
-		err = database.DB.Raw("SELECT role FROM users WHERE id = ?", userID).Scan(&role).Error
+		// Resolve role, status, profiles and session state
+		principal, err := loadPrincipal(r.Context(), userID, sessionID)
 		if err != nil {
-			log.Printf("🔍 Failed to check user role: %v", err)
-			response.WriteError(w, http.StatusInternalServerError, "Failed to verify user role")
+			log.Printf("🔍 Failed to load principal for user %s: %v", userID, err)
+			response.WriteError(w, http.StatusInternalServerError, "Failed to verify user")
 			return
 		}
-
-		if role != "builder" {
-			log.Printf("🚫 Access denied: User %s has role %s, required: builder", userID, role)
-			response.WriteError(w, http.StatusForbidden, "Access denied: Builder role required")
+		if principal == nil {
+			log.Printf("🔐 Session %s of user %s is revoked or expired", sessionID, userID)
+			response.WriteError(w, http.StatusUnauthorized, "Session expired or revoked")
 			return
 		}
-
-		log.Printf("🔐 Builder access granted for user: %s", userID)
-
-		// Get builder profile ID for this user
-		var builderProfileID string
-		err = database.DB.Raw("SELECT id FROM builder_profiles WHERE user_id = ?", userID).Scan(&builderProfileID).Error
-		if err != nil {
-			log.Printf("🔍 Failed to get builder profile ID: %v", err)
-			response.WriteError(w, http.StatusInternalServerError, "Failed to get builder profile")
+		if principal.Status != models.UserStatusActive {
+			log.Printf("🚫 Access denied: User %s has status %s", userID, principal.Status)
+			response.WriteError(w, http.StatusForbidden, "Account is "+string(principal.Status))
 			return
 		}
 
-		if builderProfileID == "" {
-			log.Printf("🚫 No builder profile found for user: %s", userID)
-			response.WriteError(w, http.StatusForbidden, "Builder profile not found")
-			return
+		// Keep the individual keys for handlers that read them directly
+		ctx := context.WithValue(r.Context(), PrincipalKey, principal)
+		ctx = context.WithValue(ctx, UserIDKey, userID.String())
+		ctx = context.WithValue(ctx, SessionIDKey, sessionID.String())
+		if principal.BuilderProfileID != nil {
+			ctx = context.WithValue(ctx, BuilderProfileIDKey, principal.BuilderProfileID.String())
 		}
 
-		// Add user ID and builder profile ID to context
-		ctx := context.WithValue(r.Context(), UserIDKey, userID)
-		ctx = context.WithValue(ctx, BuilderProfileIDKey, builderProfileID)
-
-		log.Printf("🔍 Context values set - UserID: %s, BuilderProfileID: %s", userID, builderProfileID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-// LabourMiddleware validates JWT tokens and ensures user has labour role
-func LabourMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// First validate JWT token
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			response.WriteError(w, http.StatusUnauthorized, "Authorization header required")
-			return
-		}
+// RequireRole authenticates the request and only lets callers with one of the roles through.
+// Builders and labourers must also have the matching profile.
+func RequireRole(roles ...models.UserRole) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return AuthMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, _ := PrincipalFromContext(r.Context())
+			if !principal.HasRole(roles...) {
+				log.Printf("🚫 Access denied: User %s has role %s, required: %v", principal.UserID, principal.Role, roles)
+				response.WriteError(w, http.StatusForbidden, "Access denied: insufficient role")
+				return
+			}
+
+			switch principal.Role {
+			case models.UserRoleBuilder:
+				if principal.BuilderProfileID == nil {
+					response.WriteError(w, http.StatusForbidden, "Builder profile not found")
+					return
+				}
+				if principal.MFARequired && !principal.MFAEnabled {
+					response.WriteError(w, http.StatusForbidden, "Your company requires two-factor authentication. Set it up to continue.")
+					return
+				}
+			case models.UserRoleLabour:
+				if principal.LabourProfileID == nil {
+					response.WriteError(w, http.StatusForbidden, "Labour profile not found")
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		}))
+	}
+}
 
-		if !strings.HasPrefix(authHeader, "Bearer ") {
-			response.WriteError(w, http.StatusUnauthorized, "Invalid authorization format")
-			return
-		}
+// AdminMiddleware only lets administrators through
+func AdminMiddleware(next http.Handler) http.Handler {
+	return RequireRole(models.UserRoleAdmin)(next)
+}
 
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == "" {
-			response.WriteError(w, http.StatusUnauthorized, "Token required")
-			return
-		}
+// validateJWTToken validates and parses a JWT token
+func validateJWTToken(tokenString string) (*Claims, error) {
+	if jwtKeys == nil {
+		return nil, errors.New("JWT keys not loaded")
+	}
 
-		claims, err := validateJWTToken(tokenString)
-		if err != nil {
-			log.Printf("🔐 JWT validation failed: %v", err)
-			response.WriteError(w, http.StatusUnauthorized, "Invalid token")
-			return
-		}
+	claims := &Claims{}
 
-		// Check if user has labour role
-		userID := claims.UserID
-		var role string
-		err = database.DB.Raw("SELECT role FROM users WHERE id = ?", userID).Scan(&role).Error
-		if err != nil {
-			log.Printf("🔍 Failed to check user role: %v", err)
-			response.WriteError(w, http.StatusInternalServerError, "Failed to verify user role")
-			return
-		}
+	token, err := jwt.ParseWithClaims(tokenString, claims, jwtKeys.keyFunc,
+		jwt.WithValidMethods(jwtKeys.validMethods()),
+		jwt.WithIssuer(jwtKeys.issuer),
+	)
 
-		if role != "labour" {
-			log.Printf("🚫 Access denied: User %s has role %s, required: labour", userID, role)
-			response.WriteError(w, http.StatusForbidden, "Access denied: Labour role required")
-			return
-		}
+	if err != nil {
+		return nil, err
+	}
 
-		log.Printf("🔐 Labour access granted for user: %s", userID)
+	if !token.Valid {
+		return nil, jwt.ErrTokenMalformed
+	}
 
-		// Set context values for downstream handlers
-		ctx := context.WithValue(r.Context(), UserIDKey, userID)
-		log.Printf("🔍 Context values set - UserID: %s", userID)
+	return claims, nil
+}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+// GenerateJWTToken generates a new JWT token for a user session
+func GenerateJWTToken(userID, sessionID string) (string, error) {
+	if jwtKeys == nil {
+		return "", errors.New("JWT keys not loaded")
+	}
+
+	claims := &Claims{
+		UserID:    userID,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    jwtKeys.issuer,
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwtKeys.signingMethod, claims)
+	token.Header["kid"] = jwtKeys.signingKID
+	return token.SignedString(jwtKeys.signingKey)
 }
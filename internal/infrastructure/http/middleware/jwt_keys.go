@@ -0,0 +1,259 @@
+package middleware
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yakka-backend/internal/infrastructure/config"
+	"github.com/yakka-backend/internal/shared/response"
+)
+
+// jwtKey is a key usable to verify access tokens
+type jwtKey struct {
+	kid       string
+	method    jwt.SigningMethod
+	publicKey crypto.PublicKey
+}
+
+// jwtKeySet holds the active signing key and every key still accepted for verification
+type jwtKeySet struct {
+	signingKID    string
+	signingMethod jwt.SigningMethod
+	signingKey    crypto.Signer
+	issuer        string
+	verification  map[string]*jwtKey
+	order         []string
+}
+
+// jwtKeys is the key set used by GenerateJWTToken and validateJWTToken
+var jwtKeys *jwtKeySet
+
+// LoadJWTKeys loads the signing and verification keys from configuration.
+// Without a private key an ephemeral Ed25519 key is generated, so tokens do not survive restarts.
+func LoadJWTKeys(cfg config.JWTConfig) error {
+	var signer crypto.Signer
+	if cfg.PrivateKeyFile != "" {
+		key, err := readPrivateKey(cfg.PrivateKeyFile)
+		if err != nil {
+			return err
+		}
+		signer = key
+	} else {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return fmt.Errorf("failed to generate ephemeral JWT key: %w", err)
+		}
+		log.Printf("⚠️ JWT_PRIVATE_KEY_FILE not set, using an ephemeral Ed25519 signing key")
+		signer = key
+	}
+
+	method, err := signingMethodFor(signer.Public())
+	if err != nil {
+		return err
+	}
+
+	kid := cfg.KeyID
+	if kid == "" {
+		kid, err = keyThumbprint(signer.Public())
+		if err != nil {
+			return err
+		}
+	}
+
+	keys := &jwtKeySet{
+		signingKID:    kid,
+		signingMethod: method,
+		signingKey:    signer,
+		issuer:        cfg.Issuer,
+		verification:  make(map[string]*jwtKey),
+	}
+	keys.add(&jwtKey{kid: kid, method: method, publicKey: signer.Public()})
+
+	// Previous keys stay valid for verification until their tokens expire
+	for _, entry := range strings.Split(cfg.VerificationKeys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("invalid JWT_VERIFICATION_KEYS entry %q, expected kid=path", entry)
+		}
+		publicKey, err := readPublicKey(parts[1])
+		if err != nil {
+			return err
+		}
+		keyMethod, err := signingMethodFor(publicKey)
+		if err != nil {
+			return err
+		}
+		keys.add(&jwtKey{kid: parts[0], method: keyMethod, publicKey: publicKey})
+	}
+
+	jwtKeys = keys
+	log.Printf("🔐 JWT keys loaded: signing kid=%s alg=%s, %d verification key(s)", kid, method.Alg(), len(keys.order))
+	return nil
+}
+
+// add registers a verification key, ignoring duplicates of the same kid
+func (k *jwtKeySet) add(key *jwtKey) {
+	if _, exists := k.verification[key.kid]; exists {
+		return
+	}
+	k.verification[key.kid] = key
+	k.order = append(k.order, key.kid)
+}
+
+// keyFunc resolves the verification key from the token's kid header
+func (k *jwtKeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := k.verification[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+	}
+	return key.publicKey, nil
+}
+
+// validMethods lists the algorithms of all verification keys
+func (k *jwtKeySet) validMethods() []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, kid := range k.order {
+		alg := k.verification[kid].method.Alg()
+		if !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
+
+// JWK represents a public key in JSON Web Key format
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSet represents a JSON Web Key Set
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKSHandler serves the public verification keys at /.well-known/jwks.json
+func JWKSHandler(w http.ResponseWriter, r *http.Request) {
+	if jwtKeys == nil {
+		response.WriteError(w, http.StatusServiceUnavailable, "JWT keys not loaded")
+		return
+	}
+
+	set := JWKSet{Keys: make([]JWK, 0, len(jwtKeys.order))}
+	for _, kid := range jwtKeys.order {
+		key := jwtKeys.verification[kid]
+		jwk := JWK{Use: "sig", Alg: key.method.Alg(), Kid: key.kid}
+		switch pub := key.publicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	response.WriteJSON(w, http.StatusOK, set)
+}
+
+// signingMethodFor picks the JWT algorithm for a public key
+func signingMethodFor(publicKey crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported JWT key type %T, use RSA or Ed25519", publicKey)
+	}
+}
+
+// keyThumbprint derives a stable kid from the public key
+func keyThumbprint(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JWT public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// readPrivateKey reads a PKCS#8 (RSA or Ed25519) or PKCS#1 (RSA) PEM private key
+func readPrivateKey(path string) (crypto.Signer, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key in %s", path)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("failed to parse private key in %s", path)
+}
+
+// readPublicKey reads a PKIX PEM public key
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key in %s: %w", path, err)
+	}
+	return key, nil
+}
+
+// readPEM reads the first PEM block of a file
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+	return block, nil
+}
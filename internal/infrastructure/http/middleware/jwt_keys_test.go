@@ -0,0 +1,297 @@
+package middleware
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yakka-backend/internal/infrastructure/config"
+)
+
+const testIssuer = "yakka-test"
+
+// writePEM writes a PEM block to a file in dir and returns its path
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+	return path
+}
+
+func writePrivateKey(t *testing.T, dir, name string, key crypto.Signer) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal private key: %v", err)
+	}
+	return writePEM(t, dir, name, "PRIVATE KEY", der)
+}
+
+func writePublicKey(t *testing.T, dir, name string, key crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	return writePEM(t, dir, name, "PUBLIC KEY", der)
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate RSA key: %v", err)
+	}
+	return key
+}
+
+func newEd25519Key(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate Ed25519 key: %v", err)
+	}
+	return key
+}
+
+// loadTestJWTKeys loads keys for one test and drops them when it ends
+func loadTestJWTKeys(t *testing.T, cfg config.JWTConfig) {
+	t.Helper()
+	if err := LoadJWTKeys(cfg); err != nil {
+		t.Fatalf("LoadJWTKeys: %v", err)
+	}
+	t.Cleanup(func() { jwtKeys = nil })
+}
+
+// signTestToken signs an access token with any key, kid and method
+func signTestToken(t *testing.T, method jwt.SigningMethod, key crypto.Signer, kid string) string {
+	t.Helper()
+	now := time.Now()
+	token := jwt.NewWithClaims(method, &Claims{
+		UserID: "user-1",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    testIssuer,
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func TestLoadJWTKeys(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey := newRSAKey(t)
+	edKey := newEd25519Key(t)
+	pkcs1Path := writePEM(t, dir, "rsa_pkcs1.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+
+	edKID, err := keyThumbprint(edKey.Public())
+	if err != nil {
+		t.Fatalf("keyThumbprint: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     config.JWTConfig
+		wantAlg string
+		wantKID string
+	}{
+		{"RSA PKCS#8", config.JWTConfig{PrivateKeyFile: writePrivateKey(t, dir, "rsa.pem", rsaKey), KeyID: "rsa-1"}, "RS256", "rsa-1"},
+		{"RSA PKCS#1", config.JWTConfig{PrivateKeyFile: pkcs1Path, KeyID: "rsa-1"}, "RS256", "rsa-1"},
+		{"Ed25519 with derived kid", config.JWTConfig{PrivateKeyFile: writePrivateKey(t, dir, "ed.pem", edKey)}, "EdDSA", edKID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.Issuer = testIssuer
+			loadTestJWTKeys(t, tt.cfg)
+
+			if jwtKeys.signingMethod.Alg() != tt.wantAlg || jwtKeys.signingKID != tt.wantKID {
+				t.Fatalf("signing key = %s kid=%s, want %s kid=%s", jwtKeys.signingMethod.Alg(), jwtKeys.signingKID, tt.wantAlg, tt.wantKID)
+			}
+
+			token, err := GenerateJWTToken("user-1", "session-1")
+			if err != nil {
+				t.Fatalf("GenerateJWTToken: %v", err)
+			}
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &Claims{})
+			if err != nil {
+				t.Fatalf("ParseUnverified: %v", err)
+			}
+			if parsed.Header["kid"] != tt.wantKID || parsed.Header["alg"] != tt.wantAlg {
+				t.Errorf("header = %v, want kid=%s alg=%s", parsed.Header, tt.wantKID, tt.wantAlg)
+			}
+
+			claims, err := validateJWTToken(token)
+			if err != nil {
+				t.Fatalf("validateJWTToken: %v", err)
+			}
+			if claims.UserID != "user-1" || claims.SessionID != "session-1" {
+				t.Errorf("claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestLoadJWTKeysErrors(t *testing.T) {
+	dir := t.TempDir()
+	edPath := writePrivateKey(t, dir, "ed.pem", newEd25519Key(t))
+	notPEM := filepath.Join(dir, "key.txt")
+	if err := os.WriteFile(notPEM, []byte("not a key"), 0o600); err != nil {
+		t.Fatalf("write key.txt: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.JWTConfig
+	}{
+		{"missing private key file", config.JWTConfig{PrivateKeyFile: filepath.Join(dir, "missing.pem")}},
+		{"not PEM", config.JWTConfig{PrivateKeyFile: notPEM}},
+		{"verification key not PEM", config.JWTConfig{PrivateKeyFile: edPath, VerificationKeys: "old=" + notPEM}},
+		{"verification entry without a path", config.JWTConfig{PrivateKeyFile: edPath, VerificationKeys: "old="}},
+		{"verification entry without a kid", config.JWTConfig{PrivateKeyFile: edPath, VerificationKeys: "old.pem"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := LoadJWTKeys(tt.cfg); err == nil {
+				jwtKeys = nil
+				t.Fatal("LoadJWTKeys succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestJWTKeyID(t *testing.T) {
+	dir := t.TempDir()
+	edKey := newEd25519Key(t)
+	rsaKey := newRSAKey(t)
+	retiredKey := newEd25519Key(t)
+
+	loadTestJWTKeys(t, config.JWTConfig{
+		PrivateKeyFile:   writePrivateKey(t, dir, "current.pem", edKey),
+		KeyID:            "2026-10",
+		VerificationKeys: "2026-04=" + writePublicKey(t, dir, "retired.pub.pem", retiredKey.Public()) + ", 2026-01=" + writePublicKey(t, dir, "rsa.pub.pem", rsaKey.Public()),
+		Issuer:           testIssuer,
+	})
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"current key", signTestToken(t, jwt.SigningMethodEdDSA, edKey, "2026-10"), false},
+		{"retired key listed in JWT_VERIFICATION_KEYS", signTestToken(t, jwt.SigningMethodEdDSA, retiredKey, "2026-04"), false},
+		{"retired RSA key", signTestToken(t, jwt.SigningMethodRS256, rsaKey, "2026-01"), false},
+		{"missing kid", signTestToken(t, jwt.SigningMethodEdDSA, edKey, ""), true},
+		{"unknown kid", signTestToken(t, jwt.SigningMethodEdDSA, edKey, "2025-01"), true},
+		{"retired key under the current kid", signTestToken(t, jwt.SigningMethodEdDSA, retiredKey, "2026-10"), true},
+		{"algorithm of another key", signTestToken(t, jwt.SigningMethodRS256, rsaKey, "2026-10"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateJWTToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateJWTToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJWKSHandlerRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey := newRSAKey(t)
+	edKey := newEd25519Key(t)
+
+	loadTestJWTKeys(t, config.JWTConfig{
+		PrivateKeyFile:   writePrivateKey(t, dir, "current.pem", rsaKey),
+		KeyID:            "rsa-current",
+		VerificationKeys: "ed-retired=" + writePublicKey(t, dir, "ed.pub.pem", edKey.Public()),
+		Issuer:           testIssuer,
+	})
+
+	w := httptest.NewRecorder()
+	JWKSHandler(w, httptest.NewRequest("GET", "/.well-known/jwks.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	var set JWKSet
+	if err := json.Unmarshal(w.Body.Bytes(), &set); err != nil {
+		t.Fatalf("decode JWKS: %v", err)
+	}
+	if len(set.Keys) != 2 {
+		t.Fatalf("got %d keys, want 2", len(set.Keys))
+	}
+
+	signers := map[string]crypto.Signer{"rsa-current": rsaKey, "ed-retired": edKey}
+	for _, jwk := range set.Keys {
+		t.Run(jwk.Kid, func(t *testing.T) {
+			signer, ok := signers[jwk.Kid]
+			if !ok {
+				t.Fatalf("unexpected kid %q", jwk.Kid)
+			}
+			if jwk.Use != "sig" {
+				t.Errorf("use = %q, want sig", jwk.Use)
+			}
+
+			publicKey := publicKeyFromJWK(t, jwk)
+			if !publicKey.(interface{ Equal(crypto.PublicKey) bool }).Equal(signer.Public()) {
+				t.Fatal("JWK does not decode to the loaded public key")
+			}
+
+			// A client verifying with the published key accepts our tokens
+			method := jwt.GetSigningMethod(jwk.Alg)
+			token := signTestToken(t, method, signer, jwk.Kid)
+			if _, err := jwt.Parse(token, func(*jwt.Token) (interface{}, error) { return publicKey, nil }, jwt.WithValidMethods([]string{jwk.Alg})); err != nil {
+				t.Errorf("verify with published key: %v", err)
+			}
+		})
+	}
+}
+
+// publicKeyFromJWK decodes a JWK the way a client of the JWKS endpoint would
+func publicKeyFromJWK(t *testing.T, jwk JWK) crypto.PublicKey {
+	t.Helper()
+	decode := func(value string) []byte {
+		b, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil {
+			t.Fatalf("decode %q: %v", value, err)
+		}
+		return b
+	}
+	switch jwk.Kty {
+	case "RSA":
+		if jwk.Alg != "RS256" {
+			t.Errorf("alg = %q, want RS256", jwk.Alg)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(decode(jwk.N)), E: int(new(big.Int).SetBytes(decode(jwk.E)).Int64())}
+	case "OKP":
+		if jwk.Alg != "EdDSA" || jwk.Crv != "Ed25519" {
+			t.Errorf("alg = %q crv = %q, want EdDSA Ed25519", jwk.Alg, jwk.Crv)
+		}
+		return ed25519.PublicKey(decode(jwk.X))
+	default:
+		t.Fatalf("unexpected kty %q", jwk.Kty)
+		return nil
+	}
+}
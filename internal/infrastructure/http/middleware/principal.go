@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yakka-backend/internal/features/auth/user/models"
+	"github.com/yakka-backend/internal/infrastructure/database"
+)
+
+// PrincipalKey is the context key of the authenticated Principal
+const PrincipalKey ContextKey = "principal"
+
+// principalCacheTTL bounds how long role, status and session changes can take to apply
+const principalCacheTTL = 30 * time.Second
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	UserID           uuid.UUID
+	Role             models.UserRole
+	Status           models.UserStatus
+	BuilderProfileID *uuid.UUID
+	LabourProfileID  *uuid.UUID
+	SessionID        uuid.UUID
+	MFAEnabled       bool
+	MFARequired      bool // the builder's company requires two-factor authentication
+}
+
+// HasRole reports whether the principal has any of the given roles
+func (p *Principal) HasRole(roles ...models.UserRole) bool {
+	for _, role := range roles {
+		if p.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// PrincipalFromContext returns the principal set by AuthMiddleware
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(PrincipalKey).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// cachedPrincipal is a principal with its cache expiry
+type cachedPrincipal struct {
+	principal *Principal
+	expiresAt time.Time
+}
+
+// principalCache caches principals by session ID
+type principalCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[uuid.UUID]cachedPrincipal
+}
+
+var principals = &principalCache{
+	ttl:     principalCacheTTL,
+	now:     time.Now,
+	entries: make(map[uuid.UUID]cachedPrincipal),
+}
+
+// get returns a cached principal that has not expired
+func (c *principalCache) get(sessionID uuid.UUID) (*Principal, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[sessionID]
+	if !ok || c.now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.principal, true
+}
+
+// set stores a principal, dropping expired entries along the way
+func (c *principalCache) set(principal *Principal) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for sessionID, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, sessionID)
+		}
+	}
+	c.entries[principal.SessionID] = cachedPrincipal{principal: principal, expiresAt: now.Add(c.ttl)}
+}
+
+// invalidateUser drops every cached principal of a user
+func (c *principalCache) invalidateUser(userID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for sessionID, entry := range c.entries {
+		if entry.principal.UserID == userID {
+			delete(c.entries, sessionID)
+		}
+	}
+}
+
+// InvalidatePrincipal forces the next request of the user to reload role, status,
+// profiles and sessions. Call it after changing any of them.
+func InvalidatePrincipal(userID uuid.UUID) {
+	principals.invalidateUser(userID)
+}
+
+// InvalidateAllPrincipals empties the cache, for changes that affect many users at once
+func InvalidateAllPrincipals() {
+	principals.mu.Lock()
+	defer principals.mu.Unlock()
+	principals.entries = make(map[uuid.UUID]cachedPrincipal)
+}
+
+// principalRow is the result of the principal lookup query
+type principalRow struct {
+	Role             models.UserRole
+	Status           models.UserStatus
+	BuilderProfileID *uuid.UUID
+	LabourProfileID  *uuid.UUID
+	SessionActive    bool
+	MFAEnabled       bool
+	MFARequired      bool
+}
+
+// loadPrincipal resolves the principal for a user session, using the cache when possible.
+// It returns nil when the user does not exist or the session is revoked or expired.
+func loadPrincipal(ctx context.Context, userID, sessionID uuid.UUID) (*Principal, error) {
+	if principal, ok := principals.get(sessionID); ok && principal.UserID == userID {
+		return principal, nil
+	}
+
+	var rows []principalRow
+	err := database.DB.WithContext(ctx).Raw(`
+		SELECT u.role, u.status,
+			bp.id AS builder_profile_id,
+			lp.id AS labour_profile_id,
+			EXISTS (
+				SELECT 1 FROM sessions s
+				WHERE s.id = ? AND s.user_id = u.id AND s.revoked_at IS NULL AND s.expires_at > ?
+			) AS session_active,
+			EXISTS (
+				SELECT 1 FROM user_mfa m WHERE m.user_id = u.id AND m.enabled_at IS NOT NULL
+			) AS mfa_enabled,
+			COALESCE(c.require_mfa, FALSE) AS mfa_required
+		FROM users u
+		LEFT JOIN builder_profiles bp ON bp.user_id = u.id
+		LEFT JOIN companies c ON c.id = bp.company_id
+		LEFT JOIN labour_profiles lp ON lp.user_id = u.id
+		WHERE u.id = ?
+		LIMIT 1`, sessionID, time.Now(), userID).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || !rows[0].SessionActive {
+		return nil, nil
+	}
+
+	principal := &Principal{
+		UserID:           userID,
+		Role:             rows[0].Role,
+		Status:           rows[0].Status,
+		BuilderProfileID: rows[0].BuilderProfileID,
+		LabourProfileID:  rows[0].LabourProfileID,
+		SessionID:        sessionID,
+		MFAEnabled:       rows[0].MFAEnabled,
+		MFARequired:      rows[0].MFARequired,
+	}
+	principals.set(principal)
+	return principal, nil
+}
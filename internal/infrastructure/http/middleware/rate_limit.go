@@ -1,7 +1,10 @@
 package middleware
 
 import (
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 )
@@ -26,7 +29,7 @@ func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
 // RateLimitMiddleware applies rate limiting
 func (rl *RateLimiter) RateLimitMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		clientIP := getClientIP(r)
+		clientIP := ClientIP(r)
 		
 		rl.mu.Lock()
 		defer rl.mu.Unlock()
@@ -57,18 +60,79 @@ func (rl *RateLimiter) RateLimitMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-// getClientIP extracts the client IP from the request
-func getClientIP(r *http.Request) string {
-	// Check X-Forwarded-For header first
-	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
-		return xff
+// trustedProxies are the networks whose X-Forwarded-For and X-Real-IP headers ClientIP believes
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies configures the reverse proxies allowed to report the client IP.
+// Entries are single IPs or CIDRs; with none configured forwarding headers are ignored.
+func SetTrustedProxies(proxies []string) error {
+	networks := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		networks = append(networks, network)
 	}
-	
-	// Check X-Real-IP header
-	if xri := r.Header.Get("X-Real-IP"); xri != "" {
+	trustedProxies = networks
+	return nil
+}
+
+// ClientIP extracts the client IP from the request. Forwarding headers are only
+// believed when the connection comes from a trusted proxy, and X-Forwarded-For is
+// read right to left so a client cannot choose its own address by prepending entries.
+func ClientIP(r *http.Request) string {
+	remoteIP := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		remoteIP = host
+	}
+	if !isTrustedProxy(remoteIP) {
+		return remoteIP
+	}
+
+	// The right-most hop that is not one of our proxies is the client
+	if xff := r.Header.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+			if !isTrustedProxy(hop) {
+				return hop
+			}
+		}
+	}
+
+	if xri := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(xri) != nil {
 		return xri
 	}
-	
-	// Fallback to RemoteAddr
-	return r.RemoteAddr
+
+	return remoteIP
+}
+
+// isTrustedProxy reports whether the IP belongs to a configured trusted proxy
+func isTrustedProxy(value string) bool {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"}); err != nil {
+		t.Fatalf("SetTrustedProxies: %v", err)
+	}
+	t.Cleanup(func() { trustedProxies = nil })
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        string
+		xRealIP    string
+		want       string
+	}{
+		{"direct connection", "198.51.100.4:5000", "", "", "198.51.100.4"},
+		{"untrusted peer cannot spoof XFF", "198.51.100.4:5000", "1.2.3.4", "", "198.51.100.4"},
+		{"untrusted peer cannot spoof X-Real-IP", "198.51.100.4:5000", "", "1.2.3.4", "198.51.100.4"},
+		{"trusted proxy forwards the client", "10.0.0.5:443", "203.0.113.9", "", "203.0.113.9"},
+		{"prepended fake hop is ignored", "10.0.0.5:443", "1.2.3.4, 203.0.113.9", "", "203.0.113.9"},
+		{"chain of trusted proxies is skipped", "10.0.0.5:443", "1.2.3.4, 203.0.113.9, 192.0.2.1, 10.1.1.1", "", "203.0.113.9"},
+		{"trusted proxy with X-Real-IP", "192.0.2.1:443", "", "203.0.113.9", "203.0.113.9"},
+		{"trusted proxy without headers", "10.0.0.5:443", "", "", "10.0.0.5"},
+		{"garbage hop stops the walk", "10.0.0.5:443", "203.0.113.9, not-an-ip", "", "10.0.0.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/api/v1/auth/login", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				r.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if tt.xRealIP != "" {
+				r.Header.Set("X-Real-IP", tt.xRealIP)
+			}
+			if got := ClientIP(r); got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTrustedProxiesRejectsInvalidEntries(t *testing.T) {
+	t.Cleanup(func() { trustedProxies = nil })
+	for _, proxy := range []string{"not-an-ip", "10.0.0.0/99"} {
+		if err := SetTrustedProxies([]string{proxy}); err == nil {
+			t.Errorf("SetTrustedProxies(%q) succeeded, want an error", proxy)
+		}
+	}
+}
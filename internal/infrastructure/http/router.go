@@ -7,6 +7,7 @@ import (
 
 	"github.com/gorilla/mux"
 	auth_rest "github.com/yakka-backend/internal/features/auth/delivery/rest"
+	auth_user_models "github.com/yakka-backend/internal/features/auth/user/models"
 	builder_rest "github.com/yakka-backend/internal/features/builder_profiles/delivery/rest"
 	builder_db "github.com/yakka-backend/internal/features/builder_profiles/entity/database"
 	job_rest "github.com/yakka-backend/internal/features/jobs/delivery/rest"
@@ -36,6 +37,9 @@ type Router struct {
 	sessionHandler             *auth_rest.SessionHandler
 	passwordHandler            *auth_rest.PasswordHandler
 	emailHandler               *auth_rest.EmailHandler
+	adminUserHandler           *auth_rest.AdminUserHandler
+	mfaHandler                 *auth_rest.MFAHandler
+	phoneHandler               *auth_rest.PhoneHandler
 	labourProfileHandler       *labour_rest.LabourProfileHandler
 	builderProfileHandler      *builder_rest.BuilderProfileHandler
 	companyHandler             *builder_rest.CompanyHandler
@@ -59,6 +63,9 @@ func NewRouter(
 	sessionHandler *auth_rest.SessionHandler,
 	passwordHandler *auth_rest.PasswordHandler,
 	emailHandler *auth_rest.EmailHandler,
+	adminUserHandler *auth_rest.AdminUserHandler,
+	mfaHandler *auth_rest.MFAHandler,
+	phoneHandler *auth_rest.PhoneHandler,
 	labourProfileHandler *labour_rest.LabourProfileHandler,
 	builderProfileHandler *builder_rest.BuilderProfileHandler,
 	companyHandler *builder_rest.CompanyHandler,
@@ -80,6 +87,9 @@ func NewRouter(
 		sessionHandler:             sessionHandler,
 		passwordHandler:            passwordHandler,
 		emailHandler:               emailHandler,
+		adminUserHandler:           adminUserHandler,
+		mfaHandler:                 mfaHandler,
+		phoneHandler:               phoneHandler,
 		labourProfileHandler:       labourProfileHandler,
 		builderProfileHandler:      builderProfileHandler,
 		companyHandler:             companyHandler,
@@ -106,12 +116,22 @@ func (r *Router) SetupRoutes() http.Handler {
 	// Health check endpoint (public)
 	router.HandleFunc("/health", r.healthCheck).Methods("GET")
 
+	// Public keys for verifying access tokens (public)
+	router.HandleFunc("/.well-known/jwks.json", middleware.JWKSHandler).Methods("GET")
+
 	// API routes
 	api := router.PathPrefix("/api/v1").Subrouter()
 
 	// Public auth endpoints (no middleware)
 	api.HandleFunc("/auth/register", r.authHandler.Register).Methods("POST")
 	api.HandleFunc("/auth/login", r.authHandler.Login).Methods("POST")
+	api.HandleFunc("/auth/oidc/{provider}", r.authHandler.OIDCLogin).Methods("POST")
+	api.HandleFunc("/auth/mfa/verify", r.authHandler.VerifyMFA).Methods("POST")
+	api.HandleFunc("/auth/refresh", r.sessionHandler.RefreshToken).Methods("POST")
+	api.HandleFunc("/auth/unlock", r.authHandler.UnlockAccount).Methods("POST")
+	api.HandleFunc("/auth/password/reset", r.passwordHandler.RequestPasswordReset).Methods("POST")
+	api.HandleFunc("/auth/password/reset/confirm", r.passwordHandler.ResetPassword).Methods("POST")
+	api.HandleFunc("/auth/email/verify", r.emailHandler.VerifyEmail).Methods("POST")
 
 	// Company endpoints (require license)
 	api.Handle("/companies", middleware.LicenseMiddleware(http.HandlerFunc(r.companyHandler.CreateCompany))).Methods("POST")
@@ -133,31 +153,69 @@ func (r *Router) SetupRoutes() http.Handler {
 	api.Handle("/profiles/labour", middleware.AuthMiddleware(http.HandlerFunc(r.labourProfileHandler.CreateLabourProfile))).Methods("POST")
 	api.Handle("/profiles/builder", middleware.AuthMiddleware(http.HandlerFunc(r.builderProfileHandler.CreateBuilderProfile))).Methods("POST")
 	api.Handle("/auth/profile", middleware.AuthMiddleware(http.HandlerFunc(r.authHandler.GetProfile))).Methods("GET")
+	api.Handle("/auth/logout", middleware.AuthMiddleware(http.HandlerFunc(r.sessionHandler.Logout))).Methods("POST")
+	api.Handle("/auth/mfa", middleware.AuthMiddleware(http.HandlerFunc(r.mfaHandler.GetStatus))).Methods("GET")
+	api.Handle("/auth/mfa/setup", middleware.AuthMiddleware(http.HandlerFunc(r.mfaHandler.BeginSetup))).Methods("POST")
+	api.Handle("/auth/mfa/setup/confirm", middleware.AuthMiddleware(http.HandlerFunc(r.mfaHandler.ConfirmSetup))).Methods("POST")
+	api.Handle("/auth/mfa/disable", middleware.AuthMiddleware(http.HandlerFunc(r.mfaHandler.Disable))).Methods("POST")
+	api.Handle("/auth/mfa/recovery-codes", middleware.AuthMiddleware(http.HandlerFunc(r.mfaHandler.RegenerateRecoveryCodes))).Methods("POST")
+	api.Handle("/auth/phone/verification", middleware.AuthMiddleware(http.HandlerFunc(r.phoneHandler.RequestVerification))).Methods("POST")
+	api.Handle("/auth/phone/verify", middleware.AuthMiddleware(http.HandlerFunc(r.phoneHandler.Verify))).Methods("POST")
+	api.Handle("/auth/sessions", middleware.AuthMiddleware(http.HandlerFunc(r.sessionHandler.ListSessions))).Methods("GET")
+	api.Handle("/auth/sessions", middleware.AuthMiddleware(http.HandlerFunc(r.sessionHandler.RevokeAllSessions))).Methods("DELETE")
+	api.Handle("/auth/sessions/{id}", middleware.AuthMiddleware(http.HandlerFunc(r.sessionHandler.RevokeSession))).Methods("DELETE")
+
+	// Role guards
+	builderOnly := middleware.RequireRole(auth_user_models.UserRoleBuilder)
+	labourOnly := middleware.RequireRole(auth_user_models.UserRoleLabour)
 
 	// Builder endpoints (require builder role)
-	api.Handle("/builder/companies", middleware.BuilderMiddleware(http.HandlerFunc(r.companyHandler.AssignCompany))).Methods("POST")
-	api.Handle("/jobsites", middleware.BuilderMiddleware(http.HandlerFunc(r.jobsiteHandler.CreateJobsite))).Methods("POST")
-	api.Handle("/jobsites", middleware.BuilderMiddleware(http.HandlerFunc(r.jobsiteHandler.GetJobsitesByBuilder))).Methods("GET")
-	api.Handle("/jobsites/{id}", middleware.BuilderMiddleware(http.HandlerFunc(r.jobsiteHandler.GetJobsiteByID))).Methods("GET")
-	api.Handle("/jobsites/{id}", middleware.BuilderMiddleware(http.HandlerFunc(r.jobsiteHandler.UpdateJobsite))).Methods("PUT")
-	api.Handle("/jobsites/{id}", middleware.BuilderMiddleware(http.HandlerFunc(r.jobsiteHandler.DeleteJobsite))).Methods("DELETE")
+	api.Handle("/builder/companies", builderOnly(http.HandlerFunc(r.companyHandler.AssignCompany))).Methods("POST")
+	api.Handle("/builder/company/mfa-policy", builderOnly(http.HandlerFunc(r.companyHandler.UpdateMyCompanyMFAPolicy))).Methods("PUT")
+	api.Handle("/jobsites", builderOnly(http.HandlerFunc(r.jobsiteHandler.CreateJobsite))).Methods("POST")
+	api.Handle("/jobsites", builderOnly(http.HandlerFunc(r.jobsiteHandler.GetJobsitesByBuilder))).Methods("GET")
+	api.Handle("/jobsites/{id}", builderOnly(http.HandlerFunc(r.jobsiteHandler.GetJobsiteByID))).Methods("GET")
+	api.Handle("/jobsites/{id}", builderOnly(http.HandlerFunc(r.jobsiteHandler.UpdateJobsite))).Methods("PUT")
+	api.Handle("/jobsites/{id}", builderOnly(http.HandlerFunc(r.jobsiteHandler.DeleteJobsite))).Methods("DELETE")
 
 	// Job endpoints (require builder role)
-	api.Handle("/builder/jobs", middleware.BuilderMiddleware(http.HandlerFunc(r.jobHandler.CreateJob))).Methods("POST")
-	api.Handle("/builder/jobs", middleware.BuilderMiddleware(http.HandlerFunc(r.jobHandler.GetMyJobs))).Methods("GET")
-	api.Handle("/builder/jobs/{id}", middleware.BuilderMiddleware(http.HandlerFunc(r.jobHandler.GetBuilderJobDetail))).Methods("GET")
-	api.Handle("/builder/jobs/{id}/visibility", middleware.BuilderMiddleware(http.HandlerFunc(r.jobHandler.UpdateJobVisibility))).Methods("PUT")
-	api.Handle("/builder/applicants", middleware.BuilderMiddleware(http.HandlerFunc(r.jobHandler.GetBuilderApplicants))).Methods("GET")
-	api.Handle("/builder/applicants", middleware.BuilderMiddleware(http.HandlerFunc(r.jobHandler.ProcessApplicantDecision))).Methods("POST")
+	api.Handle("/builder/jobs", builderOnly(http.HandlerFunc(r.jobHandler.CreateJob))).Methods("POST")
+	api.Handle("/builder/jobs", builderOnly(http.HandlerFunc(r.jobHandler.GetMyJobs))).Methods("GET")
+	api.Handle("/builder/jobs/{id}", builderOnly(http.HandlerFunc(r.jobHandler.GetBuilderJobDetail))).Methods("GET")
+	api.Handle("/builder/jobs/{id}", builderOnly(http.HandlerFunc(r.jobHandler.UpdateJob))).Methods("PUT", "PATCH")
+	api.Handle("/builder/jobs/{id}", builderOnly(http.HandlerFunc(r.jobHandler.DeleteJob))).Methods("DELETE")
+	api.Handle("/builder/jobs/{id}/visibility", builderOnly(http.HandlerFunc(r.jobHandler.UpdateJobVisibility))).Methods("PUT")
+	api.Handle("/builder/jobs/{id}/status", builderOnly(http.HandlerFunc(r.jobHandler.UpdateJobStatus))).Methods("PUT")
+	api.Handle("/builder/jobs/{id}/applicants/decisions", builderOnly(http.HandlerFunc(r.jobHandler.BulkApplicantDecision))).Methods("POST")
+	api.Handle("/builder/applicants", builderOnly(http.HandlerFunc(r.jobHandler.GetBuilderApplicants))).Methods("GET")
+	api.Handle("/builder/applicants", builderOnly(http.HandlerFunc(r.jobHandler.ProcessApplicantDecision))).Methods("POST")
+	api.Handle("/builder/applicants/{id}/shortlist", builderOnly(http.HandlerFunc(r.jobHandler.ShortlistApplicant))).Methods("POST")
+	api.Handle("/builder/applicants/{id}/review", builderOnly(http.HandlerFunc(r.jobHandler.ReviewApplicant))).Methods("PUT")
 
 	// Labour endpoints (require labour role)
-	api.Handle("/labour/jobs", middleware.LabourMiddleware(http.HandlerFunc(r.jobHandler.GetLabourJobs))).Methods("GET")
-	api.Handle("/labour/jobs/{id}", middleware.LabourMiddleware(http.HandlerFunc(r.jobHandler.GetLabourJobDetail))).Methods("GET")
-	api.Handle("/labour/applicants", middleware.LabourMiddleware(http.HandlerFunc(r.jobHandler.GetLabourApplicants))).Methods("GET")
-	api.Handle("/labour/applicants", middleware.LabourMiddleware(http.HandlerFunc(r.jobHandler.ApplyToJob))).Methods("POST")
-	api.Handle("/labour/qualifications", middleware.LabourMiddleware(http.HandlerFunc(r.labourQualificationHandler.GetLabourQualifications))).Methods("GET")
-	api.Handle("/labour/qualifications", middleware.LabourMiddleware(http.HandlerFunc(r.labourQualificationHandler.CreateLabourQualifications))).Methods("POST")
-	api.Handle("/labour/qualifications", middleware.LabourMiddleware(http.HandlerFunc(r.labourQualificationHandler.UpdateLabourQualifications))).Methods("PUT")
+	api.Handle("/labour/jobs", labourOnly(http.HandlerFunc(r.jobHandler.GetLabourJobs))).Methods("GET")
+	api.Handle("/labour/jobs/recommended", labourOnly(http.HandlerFunc(r.jobHandler.GetRecommendedJobs))).Methods("GET")
+	api.Handle("/labour/jobs/{id}", labourOnly(http.HandlerFunc(r.jobHandler.GetLabourJobDetail))).Methods("GET")
+	api.Handle("/labour/applicants", labourOnly(http.HandlerFunc(r.jobHandler.GetLabourApplicants))).Methods("GET")
+	api.Handle("/labour/applicants", labourOnly(http.HandlerFunc(r.jobHandler.ApplyToJob))).Methods("POST")
+	api.Handle("/labour/applicants/{id}", labourOnly(http.HandlerFunc(r.jobHandler.GetLabourApplication))).Methods("GET")
+	api.Handle("/labour/applicants/{id}", labourOnly(http.HandlerFunc(r.jobHandler.UpdateLabourApplication))).Methods("PATCH")
+	api.Handle("/labour/applicants/{id}/withdraw", labourOnly(http.HandlerFunc(r.jobHandler.WithdrawLabourApplication))).Methods("POST")
+	api.Handle("/labour/applicants/{id}/offer/accept", labourOnly(http.HandlerFunc(r.jobHandler.AcceptOffer))).Methods("POST")
+	api.Handle("/labour/applicants/{id}/offer/decline", labourOnly(http.HandlerFunc(r.jobHandler.DeclineOffer))).Methods("POST")
+	api.Handle("/labour/applicants/{id}/job-changes", labourOnly(http.HandlerFunc(r.jobHandler.RespondToJobChanges))).Methods("POST")
+	api.Handle("/labour/qualifications", labourOnly(http.HandlerFunc(r.labourQualificationHandler.GetLabourQualifications))).Methods("GET")
+	api.Handle("/labour/qualifications", labourOnly(http.HandlerFunc(r.labourQualificationHandler.CreateLabourQualifications))).Methods("POST")
+	api.Handle("/labour/qualifications", labourOnly(http.HandlerFunc(r.labourQualificationHandler.UpdateLabourQualifications))).Methods("PUT")
+
+	// Admin endpoints (require admin role)
+	api.Handle("/admin/users", middleware.AdminMiddleware(http.HandlerFunc(r.adminUserHandler.SearchUsers))).Methods("GET")
+	api.Handle("/admin/users/{id}", middleware.AdminMiddleware(http.HandlerFunc(r.adminUserHandler.GetUser))).Methods("GET")
+	api.Handle("/admin/users/{id}/status", middleware.AdminMiddleware(http.HandlerFunc(r.adminUserHandler.UpdateUserStatus))).Methods("PATCH")
+	api.Handle("/admin/users/{id}/role", middleware.AdminMiddleware(http.HandlerFunc(r.adminUserHandler.UpdateUserRole))).Methods("PATCH")
+	api.Handle("/admin/users/{id}/password-reset", middleware.AdminMiddleware(http.HandlerFunc(r.adminUserHandler.ForcePasswordReset))).Methods("POST")
+	api.Handle("/admin/users/{id}/sessions", middleware.AdminMiddleware(http.HandlerFunc(r.adminUserHandler.RevokeUserSessions))).Methods("DELETE")
+	api.Handle("/admin/companies/{id}/mfa-policy", middleware.AdminMiddleware(http.HandlerFunc(r.companyHandler.UpdateCompanyMFAPolicy))).Methods("PUT")
 
 	//labour endpoints
 
@@ -165,12 +223,6 @@ func (r *Router) SetupRoutes() http.Handler {
 		no test
 			api.HandleFunc("/auth/profile", r.authHandler.UpdateProfile).Methods("PUT")
 			api.HandleFunc("/auth/password/change", r.authHandler.ChangePassword).Methods("POST")
-			api.HandleFunc("/auth/logout", r.sessionHandler.Logout).Methods("POST")
-
-			api.HandleFunc("/auth/refresh", r.sessionHandler.RefreshToken).Methods("POST")
-		   	api.HandleFunc("/auth/password/reset", r.passwordHandler.RequestPasswordReset).Methods("POST")
-		   	api.HandleFunc("/auth/password/reset/confirm", r.passwordHandler.ResetPassword).Methods("POST")
-		   	api.HandleFunc("/auth/email/verify", r.emailHandler.VerifyEmail).Methods("POST")
 	*/
 
 	// Apply middleware stack (basic middleware only)
@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Template names
+const (
+	TemplatePasswordReset = "password_reset"
+	TemplateVerifyEmail   = "verify_email"
+	TemplateWelcome       = "welcome"
+	TemplateAccountLocked = "account_locked"
+)
+
+// templateSubjects maps each template to its email subject
+var templateSubjects = map[string]string{
+	TemplatePasswordReset: "Reset your Yakka password",
+	TemplateVerifyEmail:   "Verify your Yakka email address",
+	TemplateWelcome:       "Welcome to Yakka",
+	TemplateAccountLocked: "Your Yakka account has been locked",
+}
+
+var (
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html.tmpl"))
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt.tmpl"))
+)
+
+// TemplateData holds the values available to email templates
+type TemplateData struct {
+	Name      string
+	ActionURL string
+	ExpiresIn string
+	AppURL    string
+}
+
+// Compose renders the named template into a message for the recipient
+func Compose(name, to string, data TemplateData) (Message, error) {
+	subject, ok := templateSubjects[name]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown email template: %s", name)
+	}
+
+	var html bytes.Buffer
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html.tmpl", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+
+	var text bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, name+".txt.tmpl", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+
+	return Message{
+		To:      to,
+		Subject: subject,
+		HTML:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
@@ -0,0 +1,102 @@
+// Package oidctest provides a stand-in OpenID Connect issuer for tests: a local
+// JWKS server and a signer for ID tokens it vouches for.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yakka-backend/internal/infrastructure/config"
+)
+
+const (
+	// IssuerURL is the iss of every token the stand-in signs
+	IssuerURL = "https://issuer.example.com"
+	// ClientID is the aud accepted by the provider config of the stand-in
+	ClientID = "yakka-test-client"
+
+	keyID = "test-key"
+)
+
+// Issuer serves a static JWKS and signs ID tokens with the matching key
+type Issuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+}
+
+// NewIssuer starts a stand-in issuer that is shut down when the test ends
+func NewIssuer(t testing.TB) *Issuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate issuer key: %v", err)
+	}
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("encode JWKS: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jwks)
+	}))
+	t.Cleanup(server.Close)
+
+	return &Issuer{server: server, key: key}
+}
+
+// ProviderConfig returns the provider configuration that trusts this issuer
+func (i *Issuer) ProviderConfig(name string) config.OIDCProviderConfig {
+	return config.OIDCProviderConfig{
+		Name:      name,
+		Issuers:   []string{IssuerURL},
+		ClientIDs: []string{ClientID},
+		JWKSURL:   i.server.URL,
+	}
+}
+
+// Claims returns valid claims for a subject, which tests then alter
+func Claims(subject, email, nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            IssuerURL,
+		"aud":            ClientID,
+		"sub":            subject,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"nonce":          nonce,
+		"email":          email,
+		"email_verified": true,
+	}
+}
+
+// Sign signs claims as an RS256 ID token from this issuer
+func (i *Issuer) Sign(t testing.TB, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		t.Fatalf("sign ID token: %v", err)
+	}
+	return signed
+}
@@ -0,0 +1,173 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yakka-backend/internal/infrastructure/config"
+)
+
+// ErrInvalidToken is returned when an ID token fails verification
+var ErrInvalidToken = errors.New("invalid ID token")
+
+// Identity is the verified subject of an ID token
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	FirstName     string
+	LastName      string
+	Picture       string
+}
+
+// Provider verifies ID tokens issued by one OpenID Connect provider
+type Provider interface {
+	Name() string
+	Verify(ctx context.Context, rawIDToken, nonce string) (*Identity, error)
+}
+
+// NewProviders creates a provider for every configured issuer, keyed by name
+func NewProviders(cfg config.OIDCConfig) map[string]Provider {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	providers := make(map[string]Provider, len(cfg.Providers))
+	for _, providerCfg := range cfg.Providers {
+		providers[providerCfg.Name] = &provider{
+			name:      providerCfg.Name,
+			issuers:   providerCfg.Issuers,
+			clientIDs: providerCfg.ClientIDs,
+			keys:      newRemoteKeySet(providerCfg.JWKSURL, client),
+		}
+	}
+	return providers
+}
+
+// idTokenClaims are the ID token claims we read
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string      `json:"nonce"`
+	Email         string      `json:"email"`
+	EmailVerified interface{} `json:"email_verified"` // bool for Google, "true"/"false" for Apple
+	GivenName     string      `json:"given_name"`
+	FamilyName    string      `json:"family_name"`
+	Picture       string      `json:"picture"`
+}
+
+// provider implements Provider against a remote JWKS
+type provider struct {
+	name      string
+	issuers   []string
+	clientIDs []string
+	keys      *remoteKeySet
+}
+
+// Name returns the provider name
+func (p *provider) Name() string {
+	return p.name
+}
+
+// Verify checks the signature, issuer, audience, expiry and nonce of an ID token
+func (p *provider) Verify(ctx context.Context, rawIDToken, nonce string) (*Identity, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims,
+		func(token *jwt.Token) (interface{}, error) {
+			kid, _ := token.Header["kid"].(string)
+			key, err := p.keys.key(ctx, kid)
+			if err != nil {
+				return nil, err
+			}
+			if !methodMatchesKey(token.Method, key) {
+				return nil, fmt.Errorf("signing method %s does not match key %s", token.Method.Alg(), kid)
+			}
+			return key, nil
+		},
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if !contains(p.issuers, claims.Issuer) {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, claims.Issuer)
+	}
+	if !p.audienceAllowed(claims.Audience) {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+	// The nonce is required so a captured ID token cannot be replayed without it
+	if nonce == "" || claims.Nonce == "" || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidToken)
+	}
+
+	return &Identity{
+		Provider:      p.name,
+		Subject:       claims.Subject,
+		Email:         strings.ToLower(strings.TrimSpace(claims.Email)),
+		EmailVerified: isTrue(claims.EmailVerified),
+		FirstName:     claims.GivenName,
+		LastName:      claims.FamilyName,
+		Picture:       claims.Picture,
+	}, nil
+}
+
+// audienceAllowed reports whether any audience is one of our client IDs
+func (p *provider) audienceAllowed(audience jwt.ClaimStrings) bool {
+	for _, aud := range audience {
+		if contains(p.clientIDs, aud) {
+			return true
+		}
+	}
+	return false
+}
+
+// methodMatchesKey prevents a token from choosing an algorithm its key was not made for
+func methodMatchesKey(method jwt.SigningMethod, key crypto.PublicKey) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return method.Alg() == "RS256"
+	case *ecdsa.PublicKey:
+		return method.Alg() == "ES256"
+	case ed25519.PublicKey:
+		return method.Alg() == "EdDSA"
+	default:
+		return false
+	}
+}
+
+// isTrue reads a boolean claim sent either as a JSON bool or a string
+func isTrue(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+// contains reports whether a list holds a value
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yakka-backend/internal/infrastructure/config"
+	"github.com/yakka-backend/internal/infrastructure/oidc"
+	"github.com/yakka-backend/internal/infrastructure/oidc/oidctest"
+)
+
+func TestProviderVerify(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	provider := oidc.NewProviders(config.OIDCConfig{
+		Providers: []config.OIDCProviderConfig{issuer.ProviderConfig("google")},
+	})["google"]
+
+	const nonce = "n-0S6_WzA2Mj"
+
+	tests := []struct {
+		name    string
+		claims  func(jwt.MapClaims)
+		nonce   string
+		wantErr bool
+	}{
+		{"valid token", func(jwt.MapClaims) {}, nonce, false},
+		{"audience of another client", func(c jwt.MapClaims) { c["aud"] = "someone-else" }, nonce, true},
+		{"unknown issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }, nonce, true},
+		{"expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }, nonce, true},
+		{"missing expiry", func(c jwt.MapClaims) { delete(c, "exp") }, nonce, true},
+		{"missing subject", func(c jwt.MapClaims) { delete(c, "sub") }, nonce, true},
+		{"nonce mismatch", func(c jwt.MapClaims) { c["nonce"] = "other-nonce" }, nonce, true},
+		{"token without nonce", func(c jwt.MapClaims) { delete(c, "nonce") }, nonce, true},
+		{"request without nonce", func(jwt.MapClaims) {}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := oidctest.Claims("subject-1", "Worker@Example.com", nonce)
+			tt.claims(claims)
+
+			identity, err := provider.Verify(context.Background(), issuer.Sign(t, claims), tt.nonce)
+			if tt.wantErr {
+				if !errors.Is(err, oidc.ErrInvalidToken) {
+					t.Fatalf("Verify error = %v, want ErrInvalidToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if identity.Subject != "subject-1" || identity.Email != "worker@example.com" || !identity.EmailVerified {
+				t.Errorf("identity = %+v", identity)
+			}
+		})
+	}
+}
+
+func TestProviderVerifyRejectsForeignSignature(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	other := oidctest.NewIssuer(t)
+	provider := oidc.NewProviders(config.OIDCConfig{
+		Providers: []config.OIDCProviderConfig{issuer.ProviderConfig("google")},
+	})["google"]
+
+	token := other.Sign(t, oidctest.Claims("subject-1", "worker@example.com", "nonce"))
+	if _, err := provider.Verify(context.Background(), token, "nonce"); !errors.Is(err, oidc.ErrInvalidToken) {
+		t.Fatalf("Verify error = %v, want ErrInvalidToken", err)
+	}
+}
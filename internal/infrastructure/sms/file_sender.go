@@ -0,0 +1,43 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileSender writes text messages as .txt files into an outbox directory.
+// Used in development and tests instead of a real SMS gateway.
+type FileSender struct {
+	dir string
+}
+
+// NewFileSender creates a new file sender, creating the outbox directory if needed
+func NewFileSender(dir string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create SMS outbox directory: %w", err)
+	}
+	return &FileSender{dir: dir}, nil
+}
+
+// Send writes the message to the outbox directory
+func (s *FileSender) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	recipient := strings.TrimPrefix(msg.To, "+")
+	name := fmt.Sprintf("%s_%s.txt", time.Now().Format("20060102T150405.000000000"), recipient)
+	path := filepath.Join(s.dir, name)
+	content := fmt.Sprintf("To: %s\n\n%s\n", msg.To, msg.Body)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		return fmt.Errorf("failed to write SMS: %w", err)
+	}
+
+	log.Printf("📱 SMS for %s written to %s", msg.To, path)
+	return nil
+}
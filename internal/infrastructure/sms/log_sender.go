@@ -0,0 +1,23 @@
+package sms
+
+import (
+	"context"
+	"log"
+)
+
+// LogSender prints text messages to the application log. Development only.
+type LogSender struct{}
+
+// NewLogSender creates a new log sender
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+// Send logs the message
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	log.Printf("📱 SMS to %s: %s", msg.To, msg.Body)
+	return nil
+}
@@ -0,0 +1,31 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yakka-backend/internal/infrastructure/config"
+)
+
+// Message represents an outgoing text message
+type Message struct {
+	To   string // E.164 phone number, e.g. +61412345678
+	Body string
+}
+
+// SMSSender defines the interface for sending text messages
+type SMSSender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New creates the SMS sender selected by the configuration
+func New(cfg config.SMSConfig) (SMSSender, error) {
+	switch cfg.Driver {
+	case "log":
+		return NewLogSender(), nil
+	case "file":
+		return NewFileSender(cfg.OutboxDir)
+	default:
+		return nil, fmt.Errorf("unknown SMS driver: %s", cfg.Driver)
+	}
+}
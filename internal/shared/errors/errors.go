@@ -31,12 +31,13 @@ func NewAppError(code int, message string, err error) *AppError {
 
 // Predefined errors
 var (
-	ErrNotFound     = NewAppError(http.StatusNotFound, "Resource not found", nil)
-	ErrBadRequest   = NewAppError(http.StatusBadRequest, "Bad request", nil)
-	ErrUnauthorized = NewAppError(http.StatusUnauthorized, "Unauthorized", nil)
-	ErrForbidden    = NewAppError(http.StatusForbidden, "Forbidden", nil)
-	ErrConflict     = NewAppError(http.StatusConflict, "Conflict", nil)
-	ErrInternal     = NewAppError(http.StatusInternalServerError, "Internal server error", nil)
+	ErrNotFound        = NewAppError(http.StatusNotFound, "Resource not found", nil)
+	ErrBadRequest      = NewAppError(http.StatusBadRequest, "Bad request", nil)
+	ErrUnauthorized    = NewAppError(http.StatusUnauthorized, "Unauthorized", nil)
+	ErrForbidden       = NewAppError(http.StatusForbidden, "Forbidden", nil)
+	ErrConflict        = NewAppError(http.StatusConflict, "Conflict", nil)
+	ErrTooManyRequests = NewAppError(http.StatusTooManyRequests, "Too many requests", nil)
+	ErrInternal        = NewAppError(http.StatusInternalServerError, "Internal server error", nil)
 )
 
 // Validation errors
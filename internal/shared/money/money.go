@@ -0,0 +1,71 @@
+// Package money does fixed-point arithmetic on amounts of money.
+//
+// Amounts are whole cents in an int64. Every multiplication by a rate, a percentage
+// or a fraction of an hour rounds once to the nearest cent, half away from zero,
+// so totals do not drift the way float64 sums do.
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+// Amount is an amount of money in cents
+type Amount int64
+
+// FromFloat converts a decimal amount, such as a decimal(10,2) column read into a float64, to cents
+func FromFloat(value float64) Amount {
+	return Amount(math.Round(value * 100))
+}
+
+// Float64 returns the amount in dollars, for fields that predate fixed-point amounts
+func (a Amount) Float64() float64 {
+	return float64(a) / 100
+}
+
+// Times multiplies the amount by a whole number
+func (a Amount) Times(n int64) Amount {
+	return a * Amount(n)
+}
+
+// MulDiv multiplies the amount by num/den, rounding to the nearest cent
+func (a Amount) MulDiv(num, den int64) Amount {
+	return Amount(divRound(int64(a)*num, den))
+}
+
+// Percent returns the given percentage of the amount, e.g. Percent(150) for time and a half
+func (a Amount) Percent(percent int64) Amount {
+	return a.MulDiv(percent, 100)
+}
+
+// ForMinutes returns what an hourly rate pays for the given number of minutes
+func (a Amount) ForMinutes(minutes int64) Amount {
+	return a.MulDiv(minutes, 60)
+}
+
+// String formats the amount in dollars with two decimals, e.g. "1234.50"
+func (a Amount) String() string {
+	sign := ""
+	cents := int64(a)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON writes the amount as a JSON number with exactly two decimals
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// divRound divides rounding half away from zero
+func divRound(n, d int64) int64 {
+	if d < 0 {
+		n, d = -n, -d
+	}
+	if n < 0 {
+		return -((-n + d/2) / d)
+	}
+	return (n + d/2) / d
+}
@@ -0,0 +1,73 @@
+package money
+
+import "testing"
+
+func TestDivRound(t *testing.T) {
+	tests := []struct {
+		n, d, want int64
+	}{
+		{0, 7, 0},
+		{4, 10, 0},
+		{5, 10, 1},
+		{15, 10, 2},
+		{-4, 10, 0},
+		{-5, 10, -1},
+		{-15, 10, -2},
+		{5, -10, -1},
+		{-5, -10, 1},
+		{-6, -10, 1},
+	}
+	for _, tt := range tests {
+		if got := divRound(tt.n, tt.d); got != tt.want {
+			t.Errorf("divRound(%d, %d) = %d, want %d", tt.n, tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestMulDiv(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Amount
+		num, den int64
+		want     Amount
+	}{
+		{"rounds half up", 3550, 150 * 150, 100 * 60, 13313},
+		{"rounds half away from zero on negatives", -3550, 150 * 150, 100 * 60, -13313},
+		{"negative denominator", 3550, 150 * 150, -100 * 60, -13313},
+		{"below half rounds toward zero", -1, 40, 100, 0},
+		{"exact", -2400, 52, 12, -10400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.MulDiv(tt.num, tt.den); got != tt.want {
+				t.Errorf("%d.MulDiv(%d, %d) = %d, want %d", tt.amount, tt.num, tt.den, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentAndForMinutes(t *testing.T) {
+	if got := Amount(-1).Percent(50); got != -1 {
+		t.Errorf("Percent = %d, want -1", got)
+	}
+	if got := Amount(225).ForMinutes(630); got != 2363 {
+		t.Errorf("ForMinutes = %d, want 2363", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		want   string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{123450, "1234.50"},
+		{-5, "-0.05"},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.String(); got != tt.want {
+			t.Errorf("Amount(%d).String() = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package utils
+
+// Truncate limits a string to max bytes
+func Truncate(value string, max int) string {
+	if len(value) > max {
+		return value[:max]
+	}
+	return value
+}
+
+// StringValue returns the value of a string pointer or an empty string
+func StringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
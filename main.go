@@ -1,16 +1,27 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	auth_rest "github.com/yakka-backend/internal/features/auth/delivery/rest"
 	auth_email_db "github.com/yakka-backend/internal/features/auth/email_verification/entity/database"
 	auth_email_usecase "github.com/yakka-backend/internal/features/auth/email_verification/usecase"
+	auth_throttle_db "github.com/yakka-backend/internal/features/auth/login_throttle/entity/database"
+	auth_throttle_usecase "github.com/yakka-backend/internal/features/auth/login_throttle/usecase"
+	auth_mfa_db "github.com/yakka-backend/internal/features/auth/mfa/entity/database"
+	auth_mfa_usecase "github.com/yakka-backend/internal/features/auth/mfa/usecase"
+	auth_oidc_db "github.com/yakka-backend/internal/features/auth/oidc/entity/database"
+	auth_oidc_usecase "github.com/yakka-backend/internal/features/auth/oidc/usecase"
 	auth_password_db "github.com/yakka-backend/internal/features/auth/password_reset/entity/database"
 	auth_password_usecase "github.com/yakka-backend/internal/features/auth/password_reset/usecase"
+	auth_phone_db "github.com/yakka-backend/internal/features/auth/phone_verification/entity/database"
+	auth_phone_usecase "github.com/yakka-backend/internal/features/auth/phone_verification/usecase"
 	auth_user_db "github.com/yakka-backend/internal/features/auth/user/entity/database"
 	auth_user_usecase "github.com/yakka-backend/internal/features/auth/user/usecase"
 	auth_session_db "github.com/yakka-backend/internal/features/auth/user_session/entity/database"
@@ -40,11 +51,15 @@ import (
 	"github.com/yakka-backend/internal/infrastructure/config"
 	"github.com/yakka-backend/internal/infrastructure/database"
 	httpRouter "github.com/yakka-backend/internal/infrastructure/http"
+	"github.com/yakka-backend/internal/infrastructure/http/middleware"
+	"github.com/yakka-backend/internal/infrastructure/mailer"
+	"github.com/yakka-backend/internal/infrastructure/oidc"
+	"github.com/yakka-backend/internal/infrastructure/sms"
 )
 
 func main() {
 	// Parse command line flags
-	migrateFlag := flag.Bool("migrate", false, "Run database migrations and exit")
+	migrateFlag := flag.String("migrate", "", "Run a migration command and exit: up, down, status or to N")
 	flag.Parse()
 
 	// Load configuration
@@ -59,20 +74,44 @@ func main() {
 	}
 	defer database.Close()
 
-	// If migrate flag is set, run migrations and exit
-	if *migrateFlag {
-		log.Println("🚀 Running database migrations...")
-		if err := database.Migrate(); err != nil {
+	// If migrate flag is set, run the migration command and exit
+	if *migrateFlag != "" {
+		if err := runMigrations(*migrateFlag, flag.Args()); err != nil {
 			log.Fatalf("Failed to migrate database: %v", err)
 		}
-		log.Println("✅ Database migration completed successfully!")
 		return
 	}
 
+	// Load JWT signing and verification keys
+	if err := middleware.LoadJWTKeys(cfg.JWT); err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+
+	// Only trust forwarding headers set by our own reverse proxies
+	if err := middleware.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("Failed to parse trusted proxies: %v", err)
+	}
+
+	// Initialize mailer
+	mail, err := mailer.New(cfg.Mail)
+	if err != nil {
+		log.Fatalf("Failed to initialize mailer: %v", err)
+	}
+
+	// Initialize SMS sender
+	smsSender, err := sms.New(cfg.SMS)
+	if err != nil {
+		log.Fatalf("Failed to initialize SMS sender: %v", err)
+	}
+
 	// Initialize repositories
 	authUserRepo := auth_user_db.NewUserRepository(database.DB)
 	authSessionRepo := auth_session_db.NewSessionRepository(database.DB)
 	authPasswordRepo := auth_password_db.NewPasswordResetRepository(database.DB)
+	authThrottleRepo := auth_throttle_db.NewLoginThrottleRepository(database.DB)
+	authMFARepo := auth_mfa_db.NewMFARepository(database.DB)
+	authIdentityRepo := auth_oidc_db.NewIdentityRepository(database.DB)
+	authPhoneRepo := auth_phone_db.NewPhoneVerificationRepository(database.DB)
 	authEmailRepo := auth_email_db.NewEmailVerificationRepository(database.DB)
 	builderRepo := builder_db.NewBuilderProfileRepository(database.DB)
 	companyRepo := builder_db.NewCompanyRepository(database.DB)
@@ -82,8 +121,16 @@ func main() {
 	// Initialize use cases
 	authUserUseCase := auth_user_usecase.NewAuthUsecase(authUserRepo, builderRepo, labourRepo)
 	authSessionUseCase := auth_session_usecase.NewSessionUsecase(authSessionRepo)
-	authPasswordUseCase := auth_password_usecase.NewPasswordResetUsecase(authPasswordRepo)
-	authEmailUseCase := auth_email_usecase.NewEmailVerificationUsecase(authEmailRepo, authUserRepo)
+	authPasswordUseCase := auth_password_usecase.NewPasswordResetUsecase(authPasswordRepo, authUserRepo, authSessionRepo, auth_password_db.NewPasswordResetUnitOfWork(database.DB), mail, cfg.Mail.AppBaseURL)
+	authThrottleUseCase := auth_throttle_usecase.NewLoginThrottleUsecase(authThrottleRepo, authUserRepo, mail, cfg.Mail.AppBaseURL, cfg.Login, time.Now)
+	authMFAUseCase, err := auth_mfa_usecase.NewMFAUsecase(authMFARepo, authUserRepo, builderRepo, cfg.MFA, time.Now)
+	if err != nil {
+		log.Fatalf("Failed to initialize MFA: %v", err)
+	}
+	authAdminUserUseCase := auth_user_usecase.NewAdminUserUsecase(authUserRepo, authSessionRepo, authPasswordUseCase)
+	authEmailUseCase := auth_email_usecase.NewEmailVerificationUsecase(authEmailRepo, authUserRepo, mail, cfg.Mail.AppBaseURL)
+	authPhoneUseCase := auth_phone_usecase.NewPhoneVerificationUsecase(authPhoneRepo, authUserRepo, smsSender)
+	authOIDCUseCase := auth_oidc_usecase.NewOIDCUsecase(oidc.NewProviders(cfg.OIDC), authIdentityRepo, authUserRepo, authSessionUseCase, authEmailUseCase)
 	labourSkillRepo := labour_db.NewLabourProfileSkillRepository(database.DB)
 	userLicenseRepo := auth_user_db.NewUserLicenseRepository(database.DB)
 	licenseRepo := license_db.NewLicenseRepository(database.DB)
@@ -99,27 +146,40 @@ func main() {
 	jobLicenseRepo := job_db.NewJobLicenseRepository(database.DB)
 	jobSkillRepo := job_db.NewJobSkillRepository(database.DB)
 	jobJobRequirementRepo := job_db.NewJobJobRequirementRepository(database.DB)
+	jobRevisionRepo := job_db.NewJobRevisionRepository(database.DB)
 
 	// Job Application repositories
 	jobApplicationRepo := job_application_db.NewJobApplicationRepository(database.DB)
+	applicationEventRepo := job_application_db.NewApplicationStatusEventRepository(database.DB)
+	applicationReviewRepo := job_application_db.NewApplicationReviewRepository(database.DB)
 
 	// Job Assignment repositories
 	jobAssignmentRepo := job_assignment_db.NewJobAssignmentRepository(database.DB)
 
-	labourProfileUseCase := labour_usecase.NewLabourProfileUsecase(labourRepo, labourSkillRepo, userLicenseRepo, authUserRepo, licenseRepo, skillCategoryRepo, skillSubcategoryRepo, experienceRepo)
-	builderProfileUseCase := builder_usecase.NewBuilderProfileUsecase(builderRepo, userLicenseRepo, authUserRepo, licenseRepo)
+	// Labour qualification repositories, used by job matching
+	labourQualificationRepo := qualification_db.NewLabourProfileQualificationRepository(database.DB)
+
+	labourProfileUseCase := labour_usecase.NewLabourProfileUsecase(labourRepo, labourSkillRepo, userLicenseRepo, authUserRepo, licenseRepo, skillCategoryRepo, skillSubcategoryRepo, experienceRepo, labour_db.NewLabourProfileUnitOfWork(database.DB))
+	builderProfileUseCase := builder_usecase.NewBuilderProfileUsecase(builderRepo, userLicenseRepo, authUserRepo, licenseRepo, builder_db.NewBuilderProfileUnitOfWork(database.DB))
 	companyUseCase := builder_usecase.NewCompanyUsecase(companyRepo, builderRepo)
 	jobsiteUseCase := jobsite_usecase.NewJobsiteUsecaseImpl(jobsiteRepo)
 	paymentConstantUseCase := payment_constant_usecase.NewPaymentConstantUsecase(paymentConstantRepo)
-	// jobApplicationUseCase := job_application_usecase.NewJobApplicationUsecase(jobApplicationRepo) // Available for future use
+	// jobApplicationUseCase := job_application_usecase.NewJobApplicationUsecase(jobApplicationRepo, applicationEventRepo, job_application_db.NewApplicationUnitOfWork(database.DB)) // Available for future use
 	// jobAssignmentUseCase := job_assignment_usecase.NewJobAssignmentUsecase(jobAssignmentRepo) // Available for future use
-	jobUseCase := job_usecase.NewJobUsecase(jobRepo, jobLicenseRepo, jobSkillRepo, jobJobRequirementRepo, jobRequirementRepo, builderRepo, jobsiteRepo, jobTypeRepo, jobApplicationRepo, jobAssignmentRepo, licenseRepo, skillCategoryRepo, skillSubcategoryRepo, authUserRepo)
+	jobUseCase := job_usecase.NewJobUsecase(jobRepo, jobLicenseRepo, jobSkillRepo, jobJobRequirementRepo, jobRevisionRepo, jobRequirementRepo, builderRepo, jobsiteRepo, jobTypeRepo, jobApplicationRepo, applicationEventRepo, applicationReviewRepo, jobAssignmentRepo, licenseRepo, skillCategoryRepo, skillSubcategoryRepo, authUserRepo, userLicenseRepo, labourRepo, labourSkillRepo, labourQualificationRepo, paymentConstantRepo, cfg.Eligibility, job_db.NewJobUnitOfWork(database.DB))
+
+	// Open jobs past their end date stop taking applications
+	go expireJobsPeriodically(jobUseCase, time.Hour)
+	go expireOffersPeriodically(jobUseCase, 5*time.Minute)
 
 	// Initialize handlers
-	authHandler := auth_rest.NewAuthHandler(authUserUseCase, authEmailUseCase, builderProfileUseCase, labourProfileUseCase)
+	authHandler := auth_rest.NewAuthHandler(authUserUseCase, authEmailUseCase, builderProfileUseCase, labourProfileUseCase, authSessionUseCase, authThrottleUseCase, authMFAUseCase, authOIDCUseCase)
 	sessionHandler := auth_rest.NewSessionHandler(authSessionUseCase)
 	passwordHandler := auth_rest.NewPasswordHandler(authPasswordUseCase)
 	emailHandler := auth_rest.NewEmailHandler(authEmailUseCase)
+	adminUserHandler := auth_rest.NewAdminUserHandler(authAdminUserUseCase)
+	mfaHandler := auth_rest.NewMFAHandler(authMFAUseCase)
+	phoneHandler := auth_rest.NewPhoneHandler(authPhoneUseCase)
 	labourProfileHandler := labour_rest.NewLabourProfileHandler(labourProfileUseCase)
 	builderProfileHandler := builder_rest.NewBuilderProfileHandler(builderProfileUseCase)
 	companyHandler := builder_rest.NewCompanyHandler(companyUseCase)
@@ -130,14 +190,13 @@ func main() {
 	qualificationHandler := qualification_rest.NewQualificationHandler(qualificationRepo)
 
 	// Initialize labour qualification repositories and handlers
-	labourQualificationRepo := qualification_db.NewLabourProfileQualificationRepository(database.DB)
 	labourQualificationHandler := qualification_rest.NewLabourQualificationHandler(labourQualificationRepo, qualificationRepo)
 
 	// jobApplicationHandler := job_application_rest.NewJobApplicationHandler(jobApplicationUseCase) // Available for future use
 	// jobAssignmentHandler := job_assignment_rest.NewJobAssignmentHandler(jobAssignmentUseCase) // Available for future use
 
 	// Initialize router
-	router := httpRouter.NewRouter(authHandler, sessionHandler, passwordHandler, emailHandler, labourProfileHandler, builderProfileHandler, companyHandler, jobsiteHandler, qualificationHandler, labourQualificationHandler, jobUseCase, builderRepo, jobsiteRepo, jobTypeRepo, licenseRepo, paymentConstantUseCase, jobRequirementRepo, skillCategoryRepo, skillSubcategoryRepo)
+	router := httpRouter.NewRouter(authHandler, sessionHandler, passwordHandler, emailHandler, adminUserHandler, mfaHandler, phoneHandler, labourProfileHandler, builderProfileHandler, companyHandler, jobsiteHandler, qualificationHandler, labourQualificationHandler, jobUseCase, builderRepo, jobsiteRepo, jobTypeRepo, licenseRepo, paymentConstantUseCase, jobRequirementRepo, skillCategoryRepo, skillSubcategoryRepo)
 	httpRouter := router.SetupRoutes()
 
 	// Start server
@@ -158,3 +217,82 @@ func main() {
 
 	log.Fatal(srv.ListenAndServe())
 }
+
+// expireJobsPeriodically sweeps overdue open jobs to EXPIRED on every tick
+func expireJobsPeriodically(jobUseCase job_usecase.JobUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		if _, err := jobUseCase.ExpireOverdueJobs(context.Background()); err != nil {
+			log.Printf("⚠️ Failed to expire overdue jobs: %v", err)
+		}
+	}
+}
+
+// expireOffersPeriodically sweeps offers past their deadline to EXPIRED on every tick
+func expireOffersPeriodically(jobUseCase job_usecase.JobUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		if _, err := jobUseCase.ExpireOverdueOffers(context.Background()); err != nil {
+			log.Printf("⚠️ Failed to expire overdue offers: %v", err)
+		}
+	}
+}
+
+// runMigrations executes a -migrate command: up, down, status or to N
+func runMigrations(command string, args []string) error {
+	ctx := context.Background()
+
+	migrator, err := database.NewMigrator()
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		log.Println("🚀 Applying pending migrations...")
+		if err := migrator.Up(ctx); err != nil {
+			return err
+		}
+	case "down":
+		if err := migrator.Down(ctx); err != nil {
+			return err
+		}
+	case "to":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: -migrate to <version>")
+		}
+		version, err := strconv.Atoi(args[0])
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid migration version %q", args[0])
+		}
+		if err := migrator.To(ctx, version); err != nil {
+			return err
+		}
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range status {
+			applied := "pending"
+			if migration.AppliedAt != nil {
+				applied = "applied " + migration.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d  %-40s %s\n", migration.Version, migration.Name, applied)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down, status or to N", command)
+	}
+
+	version, err := migrator.Version(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("✅ Database schema is at version %d", version)
+	return nil
+}